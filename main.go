@@ -1,71 +1,151 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"os/signal"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/felixge/fgprof"
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/jpillora/overseer"
 	"gopkg.in/alecthomas/kingpin.v2"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/allowlist"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	awsanalyzer "github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/aws"
+	githubanalyzer "github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/github"
+	gitlabanalyzer "github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/gitlab"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/daemon"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/entropy"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/output"
-	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/plugins"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/processors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/containers"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/ticketing"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tui"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/updater"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/version"
 )
 
+// The help of the --include-paths and --exclude-paths flags, which every
+// source that scans files has.
+const (
+	includePathsHelp = "Path to file with newline separated regexes for files to include in scan. Lines starting with glob: are glob patterns instead, e.g. glob: **/*.env."
+	excludePathsHelp = "Path to file with newline separated regexes for files to exclude in scan. Lines starting with glob: are glob patterns instead, e.g. glob: vendor/**."
+)
+
 var (
 	cli                 = kingpin.New("TruffleHog", "TruffleHog is a tool for finding credentials.")
 	cmd                 string
+	targets             [][]string
 	debug               = cli.Flag("debug", "Run in debug mode.").Bool()
 	trace               = cli.Flag("trace", "Run in trace mode.").Bool()
+	logFormat           = cli.Flag("log-format", "Format of the logs, on stderr: json or text. Defaults to json with --json, and text otherwise.").Enum("json", "text")
+	logLevel            = cli.Flag("log-level", "Verbosity of the logs: info, debug, trace, or a number where higher is more verbose. Overrides --debug and --trace.").String()
 	profile             = cli.Flag("profile", "Enables profiling and sets a pprof and fgprof server on :18066.").Bool()
+	otlpEndpoint        = cli.Flag("otlp-endpoint", "Export OpenTelemetry traces of the scan to this OTLP gRPC endpoint, e.g. localhost:4317, or http://localhost:4317 without TLS. The OTEL_EXPORTER_OTLP_* and OTEL_TRACES_SAMPLER environment variables configure the export further.").String()
 	jsonOut             = cli.Flag("json", "Output in JSON format.").Short('j').Bool()
 	jsonLegacy          = cli.Flag("json-legacy", "Use the pre-v3.0 JSON format. Only works with git, gitlab, and github sources.").Bool()
 	gitHubActionsFormat = cli.Flag("github-actions", "Output in GitHub Actions format.").Bool()
+	tuiMode             = cli.Flag("tui", "Show the progress and findings of the scan in an interactive terminal interface. Logs are discarded.").Bool()
 	concurrency         = cli.Flag("concurrency", "Number of concurrent workers.").Default(strconv.Itoa(runtime.NumCPU())).Int()
+	verificationConc    = cli.Flag("verification-concurrency", "Number of concurrent workers verifying results. Defaults to the value of --concurrency.").Int()
 	noVerification      = cli.Flag("no-verification", "Don't verify the results.").Bool()
+	offlineVerification = cli.Flag("offline", "Don't verify the results over the network. Instead, score them with structural checks and other local heuristics.").Bool()
+	verifyDatabases     = cli.Flag("verify-database-connections", "Verify database connection URIs by connecting to the hosts they contain. No queries are run.").Bool()
+	highEntropy         = cli.Flag("high-entropy", "Also report high entropy strings, like trufflehog v2 did. Results are never verified.").Bool()
+	highEntropyCharsets = cli.Flag("high-entropy-threshold", "Minimum Shannon entropy, in bits per character, of strings in a charset for --high-entropy. Only the given charsets are checked, and you can repeat this flag. Charsets are base64, base64url, hex and alphanumeric. Defaults to base64=4.5 and hex=3.0.").StringMap()
+	highEntropyMinLen   = cli.Flag("high-entropy-min-length", "Minimum length of strings checked by --high-entropy.").Default("20").Int()
+	highEntropyMaxLen   = cli.Flag("high-entropy-max-length", "Maximum length of strings checked by --high-entropy.").Default("128").Int()
+	highEntropyKeywords = cli.Flag("high-entropy-keyword", "Only report high entropy strings on lines containing this keyword. You can repeat this flag.").Strings()
 	onlyVerified        = cli.Flag("only-verified", "Only output verified results.").Bool()
 	filterUnverified    = cli.Flag("filter-unverified", "Only output first unverified result per chunk per detector if there are more than one results.").Bool()
-	configFilename      = cli.Flag("config", "Path to configuration file.").ExistingFile()
+	resultTypes         = cli.Flag("results", "Comma separated list of the types of results to output: verified, unverified, unknown (verification failed with an error), filtered_unverified (unverified results that look like false positives), and canary (known canary tokens, which are never verified). Every type is output by default.").Default(engine.DefaultResults).String()
+	configFilename      = cli.Flag("config", "Path to configuration file. It can set any flag, and the source to scan if no command is given.").ExistingFile()
+	configProfile       = cli.Flag("config-profile", "Name of the profile of the configuration file to use. Its settings override the rest of the file.").String()
 	// rules = cli.Flag("rules", "Path to file with custom rules.").String()
 	printAvgDetectorTime = cli.Flag("print-avg-detector-time", "Print the average time spent on each detector.").Bool()
 	noUpdate             = cli.Flag("no-update", "Don't check for updates.").Bool()
 	fail                 = cli.Flag("fail", "Exit with code 183 if results are found.").Bool()
+	failOn               = cli.Flag("fail-on", "Results that make --fail exit with code 183: any or verified.").Default("any").Enum("any", "verified")
 	verifiers            = cli.Flag("verifier", "Set custom verification endpoints.").StringMap()
 	archiveMaxSize       = cli.Flag("archive-max-size", "Maximum size of archive to scan. (Byte units eg. 512B, 2KB, 4MB)").Bytes()
 	archiveMaxDepth      = cli.Flag("archive-max-depth", "Maximum depth of archive to scan.").Int()
 	archiveTimeout       = cli.Flag("archive-timeout", "Maximum time to spend extracting an archive.").Duration()
-	includeDetectors     = cli.Flag("include-detectors", "Comma separated list of detector types to include. Protobuf name or IDs may be used, as well as ranges.").Default("all").String()
-	excludeDetectors     = cli.Flag("exclude-detectors", "Comma separated list of detector types to exclude. Protobuf name or IDs may be used, as well as ranges. IDs defined here take precedence over the include list.").String()
+	excludeLargerThan    = cli.Flag("exclude-larger-than", "Skip files larger than this size in every source that takes --include-paths and --exclude-paths. (Byte units eg. 512B, 2KB, 4MB)").Bytes()
+	includeDetectors     = cli.Flag("include-detectors", "Comma separated list of detector types to include. Protobuf name or IDs may be used, as well as ranges. Add a version to select one version of a detector, e.g. gitlab.v1.").Default("all").String()
+	excludeDetectors     = cli.Flag("exclude-detectors", "Comma separated list of detector types to exclude. Protobuf name or IDs may be used, as well as ranges. Add a version to select one version of a detector, e.g. gitlab.v1. IDs defined here take precedence over the include list.").String()
+	verificationRetries  = cli.Flag("verification-retries", "Number of times to retry a failed verification request.").Int()
+	verificationJitter   = cli.Flag("verification-retry-jitter", "Randomize the backoff between verification retries.").Default("true").Bool()
+	verificationRetryOn  = cli.Flag("verification-retry-status", "HTTP status code that triggers a verification retry. You can repeat this flag. Defaults to 429, 502, 503, and 504.").Ints()
+	verificationRate     = cli.Flag("verification-rate-limit", "Maximum verification requests per second to each host. Unlimited by default.").Float64()
+	verificationRates    = cli.Flag("verification-rate-limits", "Maximum verification requests per second for a specific host or detector. Example: --verification-rate-limits=api.github.com=5 --verification-rate-limits=gitlab=1").StringMap()
+	verificationHosts    = cli.Flag("verification-allowlist", "Only send verification requests to these hosts. Comma separated list of hosts, you can repeat this flag. Wildcards like *.example.com match example.com and its subdomains. All hosts are allowed by default.").Strings()
+	verificationDryRun   = cli.Flag("verification-dry-run", "Report the endpoints verification would contact without sending any requests.").Bool()
+	pluginsDir           = cli.Flag("plugins-dir", "Load the detector plugins in this directory. Every executable in it is started as a plugin, and .wasm files are run as sandboxed WASM detectors.").ExistingDir()
+	revoke               = cli.Flag("revoke", "Comma separated list of detector types whose verified secrets are revoked with the provider's API, like in --include-detectors. The github, gitlab and slack detectors can revoke secrets. Revoking can't be undone, so it has to be confirmed on the terminal or with --confirm-revoke.").String()
+	confirmRevoke        = cli.Flag("confirm-revoke", "Revoke the secrets of the --revoke detectors without asking for confirmation.").Bool()
+	jiraURL              = cli.Flag("jira-url", "Create an issue in Jira for each verified secret that doesn't have one yet, in the project of --ticket-project. Example: https://example.atlassian.net").String()
+	jiraUser             = cli.Flag("jira-user", "Email of the Jira Cloud account that creates the issues. Leave it empty to use a personal access token of Jira Data Center.").Envar("JIRA_USER").String()
+	jiraToken            = cli.Flag("jira-token", "API token or personal access token of Jira.").Envar("JIRA_TOKEN").String()
+	jiraIssueType        = cli.Flag("jira-issue-type", "Type of the Jira issues.").Default("Task").String()
+	githubIssues         = cli.Flag("github-issues", "Create a GitHub issue for each verified secret that doesn't have one yet, in the repository (owner/name) of --ticket-project.").Bool()
+	githubIssuesToken    = cli.Flag("github-issues-token", "GitHub token that creates the issues.").Envar("GITHUB_TOKEN").String()
+	githubIssuesEndpoint = cli.Flag("github-issues-endpoint", "GitHub API endpoint of the issues, for GitHub Enterprise.").Default(ticketing.DefaultGitHubEndpoint).String()
+	ticketProject        = cli.Flag("ticket-project", "Template of the Jira project key or GitHub repository of the tickets of --jira-url and --github-issues, executed with each finding. Example: SEC").String()
+	ticketLabels         = cli.Flag("ticket-label", "Template of a label of the tickets. You can repeat this flag. Example: {{.DetectorName}}").Strings()
+	ticketAssignee       = cli.Flag("ticket-assignee", "Template of the assignee of the tickets. Example: {{index .ExtraData \"owners\"}}").String()
+	noInlineIgnore       = cli.Flag("no-inline-ignore", "Report the secrets that trufflehog:ignore comments annotate in git repositories and files.").Bool()
+	noSampleHeuristics   = cli.Flag("no-sample-heuristics", "Verify the results that look like sample credentials, e.g. the example keys of providers' documentation or placeholders in tests, fixtures and docs. By default the detectors that verify their candidates separately skip them, and unverified samples are reported as filtered_unverified.").Bool()
+	allowlistPath        = cli.Flag("allowlist", "Path to the allowlist of false positives that the false-positive command maintains. Findings it has are suppressed, and their number is reported in the scan manifest.").String()
+	resultProcessorPaths = cli.Flag("result-processor", "Program that processes each result before it's output, e.g. to drop allowed secrets or add to their extra data. It reads the results as JSON lines on its standard input, and answers each with a line: null to drop the result, or a JSON object whose ExtraData and Redacted replace the result's. You can repeat this flag to run processors in order.").Strings()
 
 	gitScan             = cli.Command("git", "Find credentials in git repositories.")
 	gitScanURI          = gitScan.Arg("uri", "Git repository URL. https://, file://, or ssh:// schema expected.").Required().String()
-	gitScanIncludePaths = gitScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
-	gitScanExcludePaths = gitScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	gitScanIncludePaths = gitScan.Flag("include-paths", includePathsHelp).Short('i').String()
+	gitScanExcludePaths = gitScan.Flag("exclude-paths", excludePathsHelp).Short('x').String()
 	gitScanExcludeGlobs = gitScan.Flag("exclude-globs", "Comma separated list of globs to exclude in scan. This option filters at the `git log` level, resulting in faster scans.").String()
 	gitScanSinceCommit  = gitScan.Flag("since-commit", "Commit to start scan from.").String()
 	gitScanBranch       = gitScan.Flag("branch", "Branch to scan.").String()
 	gitScanMaxDepth     = gitScan.Flag("max-depth", "Maximum depth of commits to scan.").Int()
+	gitScanNotes        = gitScan.Flag("include-notes", "Include git notes in scan.").Bool()
+	gitScanStash        = gitScan.Flag("include-stash", "Include stash entries, with their untracked files, in scan.").Bool()
+	gitScanReflog       = gitScan.Flag("include-reflog", "Include commits that are only reachable from the reflogs, such as amended or force-pushed commits, in scan.").Bool()
+	gitScanSecretAge    = gitScan.Flag("secret-age", "Add to each finding the commit that first added its secret, how many days it has been exposed, and whether HEAD still has it, to tell live leaks from historical ones.").Bool()
+	gitScanOwners       = gitScan.Flag("owners", "Add to each finding the owners of its file from the CODEOWNERS file of the repository at HEAD, in the GitHub or GitLab syntax, to route alerts to the responsible team.").Bool()
+	gitSubmodules       = gitScan.Flag("recurse-submodules", "Clone and scan the submodules of the scanned repos.").Bool()
+	gitSubmoduleDepth   = gitScan.Flag("submodule-depth", "Maximum depth of nested submodules to scan.").Default("1").Int()
+	gitSubmoduleAllow   = gitScan.Flag("submodule-allow", "Glob of the submodule URLs that may be cloned. You can repeat this flag. Defaults to submodules on the same host as the repo. Example: https://github.com/org/*").Strings()
+	gitScanLFS          = gitScan.Flag("include-lfs", "Fetch and scan the Git LFS objects that LFS pointers point to. Requires git-lfs.").Bool()
+	gitScanStaged       = gitScan.Flag("staged", "Only scan the changes staged for commit, e.g. in a pre-commit hook. The repository must be local (file://).").Bool()
+	gitScanLFSMaxSize   = gitScan.Flag("lfs-max-size", "Maximum size of LFS objects to scan. Larger objects are skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("50MB").Bytes()
 	_                   = gitScan.Flag("allow", "No-op flag for backwards compat.").Bool()
 	_                   = gitScan.Flag("entropy", "No-op flag for backwards compat.").Bool()
 	_                   = gitScan.Flag("regex", "No-op flag for backwards compat.").Bool()
@@ -75,20 +155,49 @@ var (
 	githubScanRepos        = githubScan.Flag("repo", `GitHub repository to scan. You can repeat this flag. Example: "https://github.com/dustin-decker/secretsandstuff"`).Strings()
 	githubScanOrgs         = githubScan.Flag("org", `GitHub organization to scan. You can repeat this flag. Example: "trufflesecurity"`).Strings()
 	githubScanToken        = githubScan.Flag("token", "GitHub token. Can be provided with environment variable GITHUB_TOKEN.").Envar("GITHUB_TOKEN").String()
+	githubAppID            = githubScan.Flag("app-id", "GitHub App ID, to scan as a GitHub App instead of with a token. Can be provided with environment variable GITHUB_APP_ID.").Envar("GITHUB_APP_ID").String()
+	githubAppPrivateKey    = githubScan.Flag("app-private-key", "Path to the private key of the GitHub App. Can be provided with environment variable GITHUB_APP_PRIVATE_KEY.").Envar("GITHUB_APP_PRIVATE_KEY").String()
+	githubAppInstallation  = githubScan.Flag("app-installation-id", "Installation of the GitHub App to scan. Every installation is scanned by default.").String()
 	githubIncludeForks     = githubScan.Flag("include-forks", "Include forks in scan.").Bool()
 	githubIncludeMembers   = githubScan.Flag("include-members", "Include organization member repositories in scan.").Bool()
+	githubIncludeGists     = githubScan.Flag("include-gists", "Include the gists of organization members and of the authenticated user, including its secret gists, in scan.").Bool()
+	githubIncludeWikis     = githubScan.Flag("include-wikis", "Include repository wikis in scan.").Bool()
+	githubIncludeReleases  = githubScan.Flag("include-releases", "Include release notes and release assets in scan.").Bool()
+	githubIncludeActions   = githubScan.Flag("include-actions-logs", "Include the logs of Actions workflow runs in scan.").Bool()
+	githubActionsWindow    = githubScan.Flag("actions-logs-window", "Only scan the logs of workflow runs created within this window. Example: 72h").Default("168h").Duration()
+	githubIncludeComments  = githubScan.Flag("include-comments", "Include issues, pull requests, discussions and their comments in scan.").Bool()
+	githubGistUsers        = githubScan.Flag("gist-user", `GitHub user whose public gists to scan. You can repeat this flag. Example: "dustin-decker"`).Strings()
 	githubIncludeRepos     = githubScan.Flag("include-repos", `Repositories to include in an org scan. This can also be a glob pattern. You can repeat this flag. Must use Github repo full name. Example: "trufflesecurity/trufflehog", "trufflesecurity/t*"`).Strings()
 	githubExcludeRepos     = githubScan.Flag("exclude-repos", `Repositories to exclude in an org scan. This can also be a glob pattern. You can repeat this flag. Must use Github repo full name. Example: "trufflesecurity/driftwood", "trufflesecurity/d*"`).Strings()
-	githubScanIncludePaths = githubScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
-	githubScanExcludePaths = githubScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	githubSubmodules       = githubScan.Flag("recurse-submodules", "Clone and scan the submodules of the scanned repos.").Bool()
+	githubSubmoduleDepth   = githubScan.Flag("submodule-depth", "Maximum depth of nested submodules to scan.").Default("1").Int()
+	githubSubmoduleAllow   = githubScan.Flag("submodule-allow", "Glob of the submodule URLs that may be cloned. You can repeat this flag. Defaults to submodules on the same host as the repo. Example: https://github.com/org/*").Strings()
+	githubScanIncludePaths = githubScan.Flag("include-paths", includePathsHelp).Short('i').String()
+	githubScanExcludePaths = githubScan.Flag("exclude-paths", excludePathsHelp).Short('x').String()
 
 	gitlabScan = cli.Command("gitlab", "Find credentials in GitLab repositories.")
 	// TODO: Add more GitLab options
 	gitlabScanEndpoint     = gitlabScan.Flag("endpoint", "GitLab endpoint.").Default("https://gitlab.com").String()
 	gitlabScanRepos        = gitlabScan.Flag("repo", "GitLab repo url. You can repeat this flag. Leave empty to scan all repos accessible with provided credential. Example: https://gitlab.com/org/repo.git").Strings()
 	gitlabScanToken        = gitlabScan.Flag("token", "GitLab token. Can be provided with environment variable GITLAB_TOKEN.").Envar("GITLAB_TOKEN").Required().String()
-	gitlabScanIncludePaths = gitlabScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
-	gitlabScanExcludePaths = gitlabScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	gitlabScanSnippets     = gitlabScan.Flag("include-snippets", "Include the personal snippets of the token's user and the snippets of the scanned repos, with all of their versions, in scan.").Bool()
+	gitlabScanComments     = gitlabScan.Flag("include-comments", "Include merge request and issue descriptions and discussions in scan.").Bool()
+	gitlabCommentProjects  = gitlabScan.Flag("comment-project", "Glob of the project paths to scan the comments of. You can repeat this flag. Example: org/*").Strings()
+	gitlabScanCIVariables  = gitlabScan.Flag("include-ci-variables", "Include the CI/CD variables of the scanned repos and of their groups in scan. Requires the maintainer role.").Bool()
+	gitlabScanJobLogs      = gitlabScan.Flag("include-job-logs", "Include the logs of CI/CD jobs in scan.").Bool()
+	gitlabScanArtifacts    = gitlabScan.Flag("include-job-artifacts", "Include the artifacts of CI/CD jobs in scan.").Bool()
+	gitlabJobLogsWindow    = gitlabScan.Flag("job-logs-window", "Only scan the logs and artifacts of jobs created within this window. Example: 72h").Default("168h").Duration()
+	gitlabIncludeGroups    = gitlabScan.Flag("include-group", "Glob of the group paths to scan the projects of, including nested subgroups. You can repeat this flag. Example: org/team-*").Strings()
+	gitlabIgnoreGroups     = gitlabScan.Flag("ignore-group", "Glob of the group paths to leave out of the scan. You can repeat this flag. Example: org/archive*").Strings()
+	gitlabIncludeRepos     = gitlabScan.Flag("include-project", "Glob of the project paths to scan. You can repeat this flag. Example: org/*/service-*").Strings()
+	gitlabIgnoreRepos      = gitlabScan.Flag("ignore-project", "Glob of the project paths to leave out of the scan. You can repeat this flag. Example: org/*-mirror").Strings()
+	gitlabSkipArchived     = gitlabScan.Flag("skip-archived", "Leave archived projects out of the scan.").Bool()
+	gitlabSkipForks        = gitlabScan.Flag("skip-forks", "Leave forked projects out of the scan.").Bool()
+	gitlabSubmodules       = gitlabScan.Flag("recurse-submodules", "Clone and scan the submodules of the scanned repos.").Bool()
+	gitlabSubmoduleDepth   = gitlabScan.Flag("submodule-depth", "Maximum depth of nested submodules to scan.").Default("1").Int()
+	gitlabSubmoduleAllow   = gitlabScan.Flag("submodule-allow", "Glob of the submodule URLs that may be cloned. You can repeat this flag. Defaults to submodules on the same host as the repo. Example: https://github.com/org/*").Strings()
+	gitlabScanIncludePaths = gitlabScan.Flag("include-paths", includePathsHelp).Short('i').String()
+	gitlabScanExcludePaths = gitlabScan.Flag("exclude-paths", excludePathsHelp).Short('x').String()
 
 	filesystemScan  = cli.Command("filesystem", "Find credentials in a filesystem.")
 	filesystemPaths = filesystemScan.Arg("path", "Path to file or directory to scan.").Strings()
@@ -96,14 +205,31 @@ var (
 	filesystemDirectories = filesystemScan.Flag("directory", "Path to directory to scan. You can repeat this flag.").Strings()
 	// TODO: Add more filesystem scan options. Currently only supports scanning a list of directories.
 	// filesystemScanRecursive = filesystemScan.Flag("recursive", "Scan recursively.").Short('r').Bool()
-	filesystemScanIncludePaths = filesystemScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
-	filesystemScanExcludePaths = filesystemScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	filesystemScanIncludePaths = filesystemScan.Flag("include-paths", includePathsHelp).Short('i').String()
+	filesystemScanExcludePaths = filesystemScan.Flag("exclude-paths", excludePathsHelp).Short('x').String()
+	filesystemSymlinks         = filesystemScan.Flag("follow-symlinks", "Scan the directories that symlinks point to. Symlinks that would make the scan loop are skipped. Symlinks to files are always scanned.").Bool()
+	filesystemDevices          = filesystemScan.Flag("scan-devices", "Scan device files. Sockets and named pipes are always skipped.").Bool()
+	filesystemADS              = filesystemScan.Flag("scan-alternate-data-streams", "Scan the NTFS alternate data streams of files. Windows only.").Bool()
+	filesystemMaxSize          = filesystemScan.Flag("max-file-size", "Maximum number of bytes to read from each file. The rest of larger files isn't scanned. Defaults to no limit, and 10MB for device files.").Bytes()
+	filesystemWatch            = filesystemScan.Flag("watch", "After scanning the paths, keep watching them and scan files as they are created or modified, until interrupted.").Bool()
+	filesystemGitignore        = filesystemScan.Flag("respect-gitignore", "Skip files ignored by .gitignore files. Files ignored by .trufflehogignore files, which use the same syntax, are always skipped.").Bool()
 
 	s3Scan         = cli.Command("s3", "Find credentials in S3 buckets.")
 	s3ScanKey      = s3Scan.Flag("key", "S3 key used to authenticate. Can be provided with environment variable AWS_ACCESS_KEY_ID.").Envar("AWS_ACCESS_KEY_ID").String()
 	s3ScanSecret   = s3Scan.Flag("secret", "S3 secret used to authenticate. Can be provided with environment variable AWS_SECRET_ACCESS_KEY.").Envar("AWS_SECRET_ACCESS_KEY").String()
 	s3ScanCloudEnv = s3Scan.Flag("cloud-environment", "Use IAM credentials in cloud environment.").Bool()
 	s3ScanBuckets  = s3Scan.Flag("bucket", "Name of S3 bucket to scan. You can repeat this flag.").Strings()
+	s3ScanRoles    = s3Scan.Flag("role-arn", "ARN of an IAM role to assume. Roles are assumed in order, each with the credentials of the previous one. You can repeat this flag.").Strings()
+	s3ScanExtID    = s3Scan.Flag("external-id", "External ID to assume the roles with.").String()
+	s3BucketRoles  = s3Scan.Flag("bucket-role", "ARN of an IAM role to assume for a bucket, after the --role-arn roles. Example: --bucket-role=my-bucket=arn:aws:iam::123456789012:role/scanner").StringMap()
+	s3IncPrefixes  = s3Scan.Flag("include-prefix", "Glob of key prefixes to scan, e.g. logs/* to scan the keys under logs/. You can repeat this flag.").Strings()
+	s3ExcPrefixes  = s3Scan.Flag("exclude-prefix", "Glob of key prefixes to skip. You can repeat this flag.").Strings()
+	s3IncSuffixes  = s3Scan.Flag("include-suffix", "Only scan keys with this suffix, e.g. .env. You can repeat this flag.").Strings()
+	s3ExcSuffixes  = s3Scan.Flag("exclude-suffix", "Skip keys with this suffix. You can repeat this flag.").Strings()
+	s3MaxSize      = s3Scan.Flag("max-object-size", "Maximum size of objects to scan.").Default("250MB").Bytes()
+	s3Versions     = s3Scan.Flag("include-versions", "Scan non-current object versions in versioned buckets, including those of deleted objects.").Bool()
+	s3IncPaths     = s3Scan.Flag("include-paths", includePathsHelp).String()
+	s3ExcPaths     = s3Scan.Flag("exclude-paths", excludePathsHelp).String()
 
 	gcsScan           = cli.Command("gcs", "Find credentials in GCS buckets.")
 	gcsProjectID      = gcsScan.Flag("project-id", "GCS project ID used to authenticate. Can NOT be used with unauth scan. Can be provided with environment variable GOOGLE_CLOUD_PROJECT.").Envar("GOOGLE_CLOUD_PROJECT").String()
@@ -116,16 +242,85 @@ var (
 	gcsIncludeObjects = gcsScan.Flag("include-objects", "Objects to scan. Comma separated list of objects. you can repeat this flag. Globs are supported").Short('i').Strings()
 	gcsExcludeObjects = gcsScan.Flag("exclude-objects", "Objects to exclude from scan. Comma separated list of objects. You can repeat this flag. Globs are supported").Short('x').Strings()
 	gcsMaxObjectSize  = gcsScan.Flag("max-object-size", "Maximum size of objects to scan. Objects larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("10MB").Bytes()
+	gcsIncPrefixes    = gcsScan.Flag("include-prefix", "Only scan objects whose names start with this prefix. Only these objects are listed, which is faster than --include-objects for large buckets. You can repeat this flag.").Strings()
+	gcsExcPrefixes    = gcsScan.Flag("exclude-prefix", "Skip objects whose names start with this prefix. You can repeat this flag.").Strings()
+	gcsUserProject    = gcsScan.Flag("user-project", "Project billed for requests to requester pays buckets.").String()
+	gcsGenerations    = gcsScan.Flag("include-generations", "Scan noncurrent generations of objects in buckets with object versioning.").Bool()
+	gcsIncPaths       = gcsScan.Flag("include-paths", includePathsHelp).String()
+	gcsExcPaths       = gcsScan.Flag("exclude-paths", excludePathsHelp).String()
 
 	syslogScan     = cli.Command("syslog", "Scan syslog")
 	syslogAddress  = syslogScan.Flag("address", "Address and port to listen on for syslog. Example: 127.0.0.1:514").String()
-	syslogProtocol = syslogScan.Flag("protocol", "Protocol to listen on. udp or tcp").String()
+	syslogProtocol = syslogScan.Flag("protocol", "Protocol to listen on. udp, tcp or relp").String()
 	syslogTLSCert  = syslogScan.Flag("cert", "Path to TLS cert.").String()
 	syslogTLSKey   = syslogScan.Flag("key", "Path to TLS key.").String()
+	syslogClientCA = syslogScan.Flag("client-ca", "Path to the CA certificates that TLS clients must present a certificate signed by.").String()
 	syslogFormat   = syslogScan.Flag("format", "Log format. Can be rfc3164 or rfc5424").String()
+	syslogRate     = syslogScan.Flag("sender-rate-limit", "Maximum number of messages per second accepted from each sender. Messages over the limit are dropped, or refused over RELP. Defaults to no limit.").Float64()
+	syslogBurst    = syslogScan.Flag("sender-burst", "Maximum number of messages accepted at once from each sender, over the sender rate limit.").Default("100").Int()
 
 	circleCiScan      = cli.Command("circleci", "Scan CircleCI")
 	circleCiScanToken = circleCiScan.Flag("token", "CircleCI token. Can also be provided with environment variable").Envar("CIRCLECI_TOKEN").Required().String()
+	circleCiArtifacts = circleCiScan.Flag("include-artifacts", "Include the stored artifacts of builds in the scan.").Bool()
+	circleCiAuditEnv  = circleCiScan.Flag("audit-env-vars", "Log the names of the environment variables of the projects and of their organizations' contexts. Listing contexts requires an admin token.").Bool()
+	circleCiBranches  = circleCiScan.Flag("branch", "Only scan the builds of this branch. You can repeat this flag.").Strings()
+	circleCiWorkflows = circleCiScan.Flag("workflow", "Only scan the builds of this workflow. You can repeat this flag.").Strings()
+	circleCiSince     = circleCiScan.Flag("since", "Only scan the builds that started at or after this time. Example: 2023-01-31 or 2023-01-31T15:04:05Z").String()
+	circleCiUntil     = circleCiScan.Flag("until", "Only scan the builds that started at or before this time. Example: 2023-01-31 or 2023-01-31T15:04:05Z").String()
+	circleCiIncPaths  = circleCiScan.Flag("include-paths", includePathsHelp+" Applies to the artifacts of builds.").String()
+	circleCiExcPaths  = circleCiScan.Flag("exclude-paths", excludePathsHelp+" Applies to the artifacts of builds.").String()
+
+	containersScan     = cli.Command("containers", "Scan the containers of a Docker daemon: the files written to their writable layer, their mounted volumes, and their environment variables.")
+	containersEndpoint = containersScan.Flag("endpoint", "Address of the Docker Engine API. Can be provided with environment variable DOCKER_HOST.").Envar("DOCKER_HOST").Default(containers.DefaultEndpoint).String()
+	containersIDs      = containersScan.Flag("container", "ID or name of a container to scan. You can repeat this flag. Defaults to all running containers.").Strings()
+	containersStopped  = containersScan.Flag("include-stopped", "Scan stopped containers, in addition to the running ones.").Bool()
+	containersNoVols   = containersScan.Flag("skip-volumes", "Don't scan the volumes and bind mounts of the containers.").Bool()
+	containersNoEnv    = containersScan.Flag("skip-env", "Don't scan the environment variables of the containers.").Bool()
+	containersIncPaths = containersScan.Flag("include-paths", includePathsHelp).String()
+	containersExcPaths = containersScan.Flag("exclude-paths", excludePathsHelp).String()
+
+	pluginScan    = cli.Command("plugin", "Scan a source served by an external plugin, like an internal system of your organization.")
+	pluginPath    = pluginScan.Arg("path", "Path of the plugin's executable, built with plugins.ServeSource.").Required().ExistingFile()
+	pluginOptions = pluginScan.Flag("option", "Option of the plugin, as key=value. You can repeat this flag.").StringMap()
+
+	preReceive                 = cli.Command("pre-receive", "Scan the commits of a push from a git pre-receive hook, and reject the push if verified secrets are found. The ref updates are read from standard input and the repository is the current directory.")
+	preReceiveRejectUnverified = preReceive.Flag("reject-unverified", "Also reject pushes with unverified results.").Bool()
+
+	falsePositiveCmd      = cli.Command("false-positive", "Mark findings as false positives in the allowlist of --allowlist, so that later scans suppress them.")
+	falsePositiveFindings = falsePositiveCmd.Arg("findings", "Path to a feedback file with the findings that are false positives, in the JSON format (--json), e.g. the lines of a scan's output that aren't leaks.").ExistingFile()
+	falsePositivePatterns = falsePositiveCmd.Flag("pattern", "Regular expression matching secrets that are false positives, like generated test data. You can repeat this flag.").Strings()
+	falsePositiveDetector = falsePositiveCmd.Flag("detector", "Only apply the --pattern expressions to the findings of this detector, e.g. aws.").String()
+	falsePositiveReason   = falsePositiveCmd.Flag("reason", "Why the findings are false positives, recorded in the allowlist.").String()
+
+	reverify         = cli.Command("reverify", "Verify the findings of a previous scan again without rescanning the sources.")
+	reverifyFindings = reverify.Arg("findings", "Path to a file with findings in the JSON format (--json).").Required().ExistingFile()
+
+	detectorsCmd    = cli.Command("detectors", "List the detectors that a scan with the same flags would run, with their keywords and the endpoints they verify results against.")
+	detectorsFilter = detectorsCmd.Arg("name", "Only list the detectors whose name contains this.").String()
+
+	testDetector     = cli.Command("test-detector", "Run one detector against a file or standard input, and print its matches and verification results.")
+	testDetectorName = testDetector.Arg("detector", "Name of the detector, like in --include-detectors, e.g. gitlab.v2, or the name of a custom detector of the configuration file.").Required().String()
+	testDetectorFile = testDetector.Arg("file", "File to scan. Standard input is scanned if no file is given.").ExistingFile()
+
+	daemonCmd       = cli.Command("daemon", "Run the scans of the schedules of the configuration file on their cron schedules, and send the results that weren't sent before to the sinks.")
+	daemonListen    = daemonCmd.Flag("listen", "Address of the /healthz and /metrics endpoints. They aren't served if it's empty.").Default("localhost:8080").String()
+	daemonStateDir  = daemonCmd.Flag("state-dir", "Directory where the state of each schedule, like the results that were sent, is kept.").Default("trufflehog-state").String()
+	daemonWebhooks  = daemonCmd.Flag("sink-webhook", "URL to POST the new results of each scan to, as JSON. You can repeat this flag.").Strings()
+	daemonSinkFiles = daemonCmd.Flag("sink-file", "File to append the new results of each scan to, as JSON lines. You can repeat this flag.").Strings()
+
+	analyzeCmd        = cli.Command("analyze", "Report what a secret gives access to, like who it authenticates as and what it's allowed to do. The report is printed as JSON with --json.")
+	analyzeAWS        = analyzeCmd.Command("aws", "Analyze an AWS access key: its identity, when it was last used, the IAM policies of its user or role, and the actions they allow by service.")
+	analyzeAWSKeyID   = analyzeAWS.Flag("key-id", "ID of the access key. Can be provided with environment variable AWS_ACCESS_KEY_ID.").Envar("AWS_ACCESS_KEY_ID").Required().String()
+	analyzeAWSSecret  = analyzeAWS.Flag("secret", "Secret of the access key. Can be provided with environment variable AWS_SECRET_ACCESS_KEY.").Envar("AWS_SECRET_ACCESS_KEY").Required().String()
+	analyzeAWSSession = analyzeAWS.Flag("session-token", "Session token of temporary credentials. Can be provided with environment variable AWS_SESSION_TOKEN.").Envar("AWS_SESSION_TOKEN").String()
+
+	analyzeGitLab      = analyzeCmd.Command("gitlab", "Analyze a GitLab personal, project or group access token: its scopes, its user, and the projects it can access, with their visibility and whether it can push to them, administer them, or read their CI variables.")
+	analyzeGitLabToken = analyzeGitLab.Flag("token", "GitLab token. Can be provided with environment variable GITLAB_TOKEN.").Envar("GITLAB_TOKEN").Required().String()
+	analyzeGitLabURLs  = analyzeGitLab.Flag("url", "URL of the GitLab instance of the token, for self-managed instances. You can repeat this flag, and the token is analyzed against the first instance that accepts it. Defaults to the URLs of --verifier gitlab=..., and https://gitlab.com.").Strings()
+
+	analyzeGitHub         = analyzeCmd.Command("github", "Analyze a GitHub token: its type, its scopes or fine-grained permissions, its user and rate limit, and the organizations and repositories it can access, with whether it's authorized for their SSO.")
+	analyzeGitHubToken    = analyzeGitHub.Flag("token", "GitHub token. Can be provided with environment variable GITHUB_TOKEN.").Envar("GITHUB_TOKEN").Required().String()
+	analyzeGitHubEndpoint = analyzeGitHub.Flag("endpoint", "GitHub API endpoint, like https://HOST/api/v3 for GitHub Enterprise Server.").Default(githubanalyzer.DefaultEndpoint).String()
 )
 
 func init() {
@@ -138,9 +333,48 @@ func init() {
 	}
 
 	cli.Version("trufflehog " + version.BuildVersion)
-	cmd = kingpin.MustParse(cli.Parse(os.Args[1:]))
+	args := os.Args[1:]
+	runs := [][]string{args}
+	if filename := flagValue(args, "config"); filename != "" {
+		var err error
+		if runs, err = config.ReadCLIArgs(cli, filename, flagValue(args, "config-profile"), args); err != nil {
+			cli.Fatalf("invalid configuration file %s: %v", filename, err)
+		}
+	} else if flagValue(args, "config-profile") != "" {
+		cli.Fatalf("--config-profile requires --config")
+	}
+	// The targets after the first are parsed before it, so that their errors
+	// are reported before scanning, and the flags are those of the first
+	// target until it's started.
+	for _, args := range append(append([][]string{}, runs[1:]...), runs[0]) {
+		cmd = kingpin.MustParse(cli.Parse(args))
+		if len(runs) > 1 && (cmd == detectorsCmd.FullCommand() || cmd == testDetector.FullCommand() || cmd == preReceive.FullCommand() || cmd == daemonCmd.FullCommand() || cmd == falsePositiveCmd.FullCommand() || isAnalyze(cmd)) {
+			cli.Fatalf("the %s command can't be one of the targets of the configuration file", cmd)
+		}
+	}
+	targets = runs
+	if cmd == daemonCmd.FullCommand() && *configFilename == "" {
+		cli.Fatalf("the daemon command requires --config, whose schedules it runs")
+	}
+	if cmd == falsePositiveCmd.FullCommand() {
+		if *allowlistPath == "" {
+			cli.Fatalf("the false-positive command requires --allowlist, which it adds the false positives to")
+		}
+		if *falsePositiveFindings == "" && len(*falsePositivePatterns) == 0 {
+			cli.Fatalf("the false-positive command requires a findings file or --pattern")
+		}
+	}
+	if *tuiMode && (*jsonOut || *jsonLegacy || *gitHubActionsFormat) {
+		cli.Fatalf("--tui can't be used with --json, --json-legacy or --github-actions")
+	}
 
 	switch {
+	case *logLevel != "":
+		level, err := log.ParseLevel(*logLevel)
+		if err != nil {
+			cli.Fatalf("%s", err)
+		}
+		log.SetLevel(level)
 	case *trace:
 		log.SetLevel(5)
 	case *debug:
@@ -150,11 +384,16 @@ func init() {
 
 func main() {
 	// setup logger
-	logFormat := log.WithConsoleSink
-	if *jsonOut {
-		logFormat = log.WithJSONSink
+	logSink := log.WithConsoleSink
+	if *logFormat == "json" || (*logFormat == "" && *jsonOut) {
+		logSink = log.WithJSONSink
+	}
+	// Logs would be drawn over the terminal interface.
+	var logWriter io.Writer = os.Stderr
+	if *tuiMode {
+		logWriter = io.Discard
 	}
-	logger, sync := log.New("trufflehog", logFormat(os.Stderr))
+	logger, sync := log.New("trufflehog", logSink(logWriter))
 	// make it the default logger for contexts
 	context.SetDefaultLogger(logger)
 	defer func() { _ = sync() }()
@@ -197,6 +436,7 @@ func run(state overseer.State) {
 	// When setting a base commit, chunks must be scanned in order.
 	if *gitScanSinceCommit != "" {
 		*concurrency = 1
+		*verificationConc = 1
 	}
 
 	if *profile {
@@ -211,6 +451,42 @@ func run(state overseer.State) {
 		}()
 	}
 
+	flushTraces := func() {}
+	if *otlpEndpoint != "" {
+		shutdown, err := tracing.Setup(ctx, *otlpEndpoint, version.BuildVersion)
+		if err != nil {
+			logFatal(err, "error setting up tracing")
+		}
+		var once sync.Once
+		flushTraces = func() {
+			once.Do(func() {
+				if err := shutdown(ctx); err != nil {
+					logger.Error(err, "could not export the traces")
+				}
+			})
+		}
+		defer flushTraces()
+	}
+
+	if cmd == daemonCmd.FullCommand() {
+		if err := runDaemon(ctx); err != nil {
+			logFatal(err, "error running the daemon")
+		}
+		return
+	}
+	if isAnalyze(cmd) {
+		if err := runAnalyze(ctx); err != nil {
+			logFatal(err, "error analyzing the secret")
+		}
+		return
+	}
+	if cmd == falsePositiveCmd.FullCommand() {
+		if err := runFalsePositive(ctx); err != nil {
+			logFatal(err, "error updating the allowlist")
+		}
+		return
+	}
+
 	conf := &config.Config{}
 	if *configFilename != "" {
 		var err error
@@ -232,80 +508,425 @@ func run(state overseer.State) {
 		handlers.SetArchiveMaxTimeout(*archiveTimeout)
 	}
 
-	// Build include and exclude detector filter sets.
-	var includeDetectorTypes, excludeDetectorTypes map[detectorspb.DetectorType]config.DetectorID
-	{
-		includeList, err := config.ParseDetectors(*includeDetectors)
+	if *verificationRetries > 0 {
+		retryPolicy := common.DefaultRetryPolicy
+		retryPolicy.MaxAttempts = *verificationRetries + 1
+		retryPolicy.Jitter = *verificationJitter
+		if len(*verificationRetryOn) > 0 {
+			retryPolicy.RetryOnStatus = *verificationRetryOn
+		}
+		common.SetVerificationRetryPolicy(retryPolicy)
+	}
+
+	if *verificationRate > 0 {
+		common.SetVerificationRateLimit(common.RateLimit{Rate: *verificationRate, Burst: 1})
+	}
+	for key, value := range *verificationRates {
+		limit, err := strconv.ParseFloat(value, 64)
+		if err != nil || limit <= 0 {
+			logFatal(fmt.Errorf("invalid rate limit %q", value), "invalid verification rate limit", "key", key)
+		}
+		common.SetVerificationRateLimitFor(key, common.RateLimit{Rate: limit, Burst: 1})
+	}
+	if len(*verificationHosts) > 0 {
+		common.SetVerificationAllowlist(commaSeperatedToSlice(*verificationHosts))
+	}
+	common.SetVerificationDryRun(*verificationDryRun)
+
+	var entropyDetectors []detectors.Detector
+	if *highEntropy {
+		charsets, err := entropy.ParseCharsets(*highEntropyCharsets)
 		if err != nil {
-			// Exit if there was an error to inform the user of the misconfiguration.
-			logFatal(err, "invalid include list detector configuration")
+			logFatal(err, "invalid high entropy threshold")
 		}
-		excludeList, err := config.ParseDetectors(*excludeDetectors)
+		entropyDetectors = append(entropyDetectors, entropy.New(
+			entropy.WithCharsets(charsets...),
+			entropy.WithLength(*highEntropyMinLen, *highEntropyMaxLen),
+			entropy.WithKeywords(*highEntropyKeywords...),
+		))
+	}
+
+	// Build include and exclude detector filters.
+	includeList, err := config.ParseDetectors(*includeDetectors)
+	if err != nil {
+		// Exit if there was an error to inform the user of the misconfiguration.
+		logFatal(err, "invalid include list detector configuration")
+	}
+	excludeList, err := config.ParseDetectors(*excludeDetectors)
+	if err != nil {
+		// Exit if there was an error to inform the user of the misconfiguration.
+		logFatal(err, "invalid exclude list detector configuration")
+	}
+	var revokeList []config.DetectorID
+	if *revoke != "" {
+		revokeList, err = config.ParseDetectors(*revoke)
 		if err != nil {
-			// Exit if there was an error to inform the user of the misconfiguration.
-			logFatal(err, "invalid exclude list detector configuration")
-		}
-		includeDetectorTypes = detectorTypeToMap(includeList)
-		excludeDetectorTypes = detectorTypeToMap(excludeList)
-	}
-	includeFilter := func(d detectors.Detector) bool {
-		id, ok := includeDetectorTypes[d.Type()]
-		if id.Version == 0 {
-			return ok
-		}
-		versionD, ok := d.(detectors.Versioner)
-		if !ok {
-			// Error: version provided but not a detectors.Versioner
-			logFatal(
-				fmt.Errorf("version provided but detector does not have a version"),
-				"invalid include list detector configuration",
-				"detector", id,
-			)
-		}
-		return versionD.Version() == id.Version
-	}
-	excludeFilter := func(d detectors.Detector) bool {
-		id, ok := excludeDetectorTypes[d.Type()]
-		if id.Version == 0 {
-			return !ok
-		}
-		versionD, ok := d.(detectors.Versioner)
-		if !ok {
-			// Error: version provided but not a detectors.Versioner
-			logFatal(
-				fmt.Errorf("version provided but detector does not have a version"),
-				"invalid exclude list detector configuration",
-				"detector", id,
-			)
-		}
-		return versionD.Version() != id.Version
-	}
-
-	e := engine.Start(ctx,
+			logFatal(err, "invalid revoke list detector configuration")
+		}
+	}
+	for _, list := range [][]config.DetectorID{includeList, excludeList, revokeList} {
+		if err := config.CheckVersions(list, engine.DefaultDetectors()); err != nil {
+			logFatal(err, "invalid detector version")
+		}
+	}
+
+	var pluginDetectors []detectors.Detector
+	if *pluginsDir != "" {
+		pluginDetectors, err = plugins.Load(ctx, *pluginsDir)
+		if err != nil {
+			logFatal(err, "could not load detector plugins")
+		}
+		defer plugins.Shutdown()
+	}
+
+	results, err := engine.ParseResults(*resultTypes)
+	if err != nil {
+		logFatal(err, "invalid --results")
+	}
+	if *onlyVerified {
+		results = engine.Results{Verified: true}
+	}
+
+	verify := !*noVerification && !*offlineVerification
+	detectorSettings := conf.DetectorSettings
+	if !verify {
+		// Settings can't turn on verification when it's off for the scan.
+		for i := range detectorSettings {
+			detectorSettings[i].Verify = nil
+		}
+	}
+	engineOptions := []engine.EngineOption{
 		engine.WithConcurrency(*concurrency),
+		engine.WithVerificationConcurrency(*verificationConc),
 		engine.WithDecoders(decoders.DefaultDecoders()...),
-		engine.WithDetectors(!*noVerification, engine.DefaultDetectors()...),
-		engine.WithDetectors(!*noVerification, engine.CustomDetectors(ctx, urls)...),
-		engine.WithDetectors(!*noVerification, conf.Detectors...),
-		engine.WithFilterDetectors(includeFilter),
-		engine.WithFilterDetectors(excludeFilter),
+		engine.WithDetectors(verify, engine.CustomDetectors(ctx, urls)...),
+		engine.WithDetectors(verify, conf.Detectors...),
+		engine.WithDetectors(verify, pluginDetectors...),
+		engine.WithDetectors(false, entropyDetectors...),
+		engine.WithFilterDetectors(config.IncludeFilter(includeList)),
+		engine.WithFilterDetectors(config.ExcludeFilter(excludeList)),
+		engine.WithDetectorSettings(detectorSettings...),
 		engine.WithFilterUnverified(*filterUnverified),
+		engine.WithResults(results),
+		engine.WithOfflineVerification(*offlineVerification),
+		engine.WithLiveVerification(*verifyDatabases),
+		engine.WithIgnoreRules(conf.IgnoreRules...),
+		engine.WithInlineIgnores(!*noInlineIgnore),
+		engine.WithSampleHeuristics(!*noSampleHeuristics),
+	}
+	if len(revokeList) > 0 {
+		engineOptions = append(engineOptions, engine.WithRevocation(config.IncludeFilter(revokeList)))
+	}
+	if cmd == detectorsCmd.FullCommand() {
+		if err := printDetectors(engine.Detectors(ctx, engineOptions...), *detectorsFilter); err != nil {
+			logFatal(err, "error printing detectors")
+		}
+		return
+	}
+	if cmd == testDetector.FullCommand() {
+		candidates := engine.CustomDetectors(ctx, urls)
+		candidates = append(candidates, conf.Detectors...)
+		candidates = append(candidates, pluginDetectors...)
+		candidates = append(candidates, entropyDetectors...)
+		if err := runTestDetector(ctx, candidates, *testDetectorName, *testDetectorFile, verify); err != nil {
+			logFatal(err, "error testing detector")
+		}
+		return
+	}
+	if len(revokeList) > 0 {
+		if !verify {
+			logFatal(fmt.Errorf("--revoke needs verification"), "invalid revoke configuration")
+		}
+		if err := confirmRevocation(revokeList); err != nil {
+			logFatal(err, "revocation was not confirmed")
+		}
+	}
+	ticketer, err := newTicketer()
+	if err != nil {
+		logFatal(err, "invalid ticketing configuration")
+	}
+	// Allowed findings are suppressed before they're sent to the processors.
+	var allowed *allowlist.Allowlist
+	if *allowlistPath != "" {
+		allowed, err = allowlist.Load(*allowlistPath)
+		if err != nil {
+			logFatal(err, "could not load the allowlist")
+		}
+		engineOptions = append(engineOptions, engine.WithResultProcessors(allowed.Process))
+	}
+	for _, path := range *resultProcessorPaths {
+		processor, err := processors.Start(path)
+		if err != nil {
+			logFatal(err, "could not start result processor")
+		}
+		defer func() {
+			if err := processor.Close(); err != nil {
+				logger.Error(err, "result processor failed", "processor", path)
+			}
+		}()
+		engineOptions = append(engineOptions, engine.WithResultProcessors(processor.Process))
+	}
+	manifest := output.Manifest{
+		RunID:         uuid.NewString(),
+		StartTime:     time.Now().UTC(),
+		Version:       version.BuildVersion,
+		DetectorsHash: engine.DetectorsHash(ctx, engineOptions...),
+	}
+	for _, args := range targets {
+		manifest.Sources = append(manifest.Sources, manifestSource(args))
+	}
+	logger = logger.WithValues("run_id", manifest.RunID)
+	ctx = context.WithLogger(ctx, logger)
+	e := engine.Start(ctx, engineOptions...)
+
+	cleanup := scan(ctx, e)
+	defer cleanup()
+	// The other targets of the configuration file are scanned with the same
+	// engine, so their results are deduplicated and reported together.
+	for _, args := range targets[1:] {
+		cmd = kingpin.MustParse(cli.Parse(args))
+		cleanup := scan(ctx, e)
+		defer cleanup()
+	}
+	// asynchronously wait for scanning to finish and cleanup
+	go e.Finish(ctx)
+
+	if !*jsonLegacy && !*jsonOut && !*tuiMode && cmd != preReceive.FullCommand() {
+		fmt.Fprintf(os.Stderr, "🐷🔑🐷  TruffleHog. Unearth your secrets. 🐷🔑🐷\n\n")
+	}
+
+	foundResults, foundVerified := false, false
+	// rejected are the results that reject a push in the pre-receive command.
+	var rejected []detectors.ResultWithMetadata
+	if *tuiMode {
+		found, verified, err := tui.Run(ctx, tui.Config{
+			Results:      e.ResultsChan(),
+			OnlyVerified: *onlyVerified,
+			Progress: func() tui.Progress {
+				return tui.Progress{
+					ChunksScanned:       e.ChunksScanned(),
+					BytesScanned:        e.BytesScanned(),
+					VerificationBacklog: e.VerificationBacklog(),
+					SourceChunks:        e.SourceChunks(),
+				}
+			},
+		})
+		if err != nil {
+			// Logs are discarded with --tui.
+			fmt.Fprintf(os.Stderr, "error running the terminal interface: %v\n", err)
+			os.Exit(1)
+		}
+		// Quitting the interface ends the scan.
+		foundResults, foundVerified = found > 0, verified > 0
+	} else {
+		// NOTE: this loop will terminate when the results channel is closed in
+		// e.Finish()
+		for r := range e.ResultsChan() {
+			if *onlyVerified && !r.Verified {
+				continue
+			}
+			foundResults = true
+			foundVerified = foundVerified || r.Verified
+			if cmd == preReceive.FullCommand() {
+				if r.Verified || *preReceiveRejectUnverified {
+					rejected = append(rejected, r)
+				}
+				continue
+			}
+
+			var err error
+			switch {
+			case *jsonLegacy:
+				err = output.PrintLegacyJSON(ctx, &r)
+			case *jsonOut:
+				err = output.PrintJSON(&r)
+			case *gitHubActionsFormat:
+				err = output.PrintGitHubActionsOutput(&r)
+			default:
+				err = output.PrintPlainOutput(&r)
+			}
+			if err != nil {
+				logFatal(err, "error printing results")
+			}
+			if ticketer != nil {
+				if url, err := ticketer.File(ctx, &r); err != nil {
+					logger.Error(err, "could not file a ticket", "detector", r.DetectorType.String())
+				} else if url != "" {
+					logger.Info("filed a ticket", "url", url, "detector", r.DetectorType.String())
+				}
+			}
+		}
+	}
+	// The scan may exit below, which skips deferred calls.
+	flushTraces()
+	manifest.EndTime = time.Now().UTC()
+	manifest.Suppressed = map[string]int{}
+	if allowed != nil {
+		manifest.Suppressed["allowlist"] = allowed.Suppressed()
+		if n := allowed.Suppressed(); n > 0 {
+			logger.Info("suppressed the findings of the allowlist", "count", n)
+		}
+	}
+	if len(conf.IgnoreRules) > 0 {
+		manifest.Suppressed["ignore rules"] = int(e.IgnoredResults())
+	}
+	if n := e.InlineIgnoredResults(); n > 0 {
+		manifest.Suppressed["inline comments"] = int(n)
+	}
+	if err := printManifest(manifest); err != nil {
+		logFatal(err, "error printing the scan manifest")
+	}
+	logger.V(2).Info("finished scanning",
+		"chunks", e.ChunksScanned(),
+		"bytes", e.BytesScanned(),
 	)
+	if n := e.UnverifiedSamples(); n > 0 {
+		logger.V(2).Info("skipped verifying results that look like sample credentials", "count", n)
+	}
+	if blocked := common.BlockedVerificationRequests(); blocked > 0 {
+		logger.Info("blocked verification requests to hosts outside the allowlist", "count", blocked)
+	}
+	if n := e.UnreverifiedFindings(); n > 0 {
+		logger.Info("could not reverify findings", "count", n)
+	}
+
+	if *printAvgDetectorTime {
+		printAverageDetectorTime(e)
+	}
+
+	if *verificationDryRun {
+		printVerificationPlan()
+	}
+
+	if len(rejected) > 0 {
+		// git shows the output of the hook to the pusher.
+		output.PrintPushRejection(os.Stderr, rejected)
+		plugins.Shutdown()
+		os.Exit(1)
+	}
+
+	if *fail && (foundVerified || (foundResults && *failOn == "any")) {
+		logger.V(2).Info("exiting with code 183 because results were found", "fail-on", *failOn)
+		plugins.Shutdown()
+		os.Exit(183)
+	}
+}
+
+// confirmRevocation asks the user on the terminal to confirm revoking the
+// verified secrets of the detectors, unless --confirm-revoke is set.
+func confirmRevocation(ids []config.DetectorID) error {
+	if *confirmRevoke {
+		return nil
+	}
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return fmt.Errorf("--revoke needs --confirm-revoke when it's not run on a terminal")
+	}
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, id.String())
+	}
+	fmt.Fprintf(os.Stderr, "The verified secrets found by %s will be revoked, which can't be undone. Type \"revoke\" to continue: ", strings.Join(names, ", "))
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if strings.TrimSpace(answer) != "revoke" {
+		return fmt.Errorf("the answer was %q", strings.TrimSpace(answer))
+	}
+	return nil
+}
+
+// newTicketer returns the ticketer of --jira-url or --github-issues, or nil if
+// neither is set.
+func newTicketer() (*ticketing.Ticketer, error) {
+	var tracker ticketing.Tracker
+	switch {
+	case *jiraURL != "" && *githubIssues:
+		return nil, fmt.Errorf("--jira-url and --github-issues can't be used together")
+	case *jiraURL != "":
+		tracker = &ticketing.Jira{URL: *jiraURL, User: *jiraUser, Token: *jiraToken, IssueType: *jiraIssueType}
+	case *githubIssues:
+		tracker = &ticketing.GitHubIssues{Endpoint: *githubIssuesEndpoint, Token: *githubIssuesToken}
+	default:
+		return nil, nil
+	}
+	return ticketing.New(tracker, ticketing.Templates{
+		Project:  *ticketProject,
+		Labels:   *ticketLabels,
+		Assignee: *ticketAssignee,
+	})
+}
+
+// manifestSource describes the sources that a target selects, from its
+// arguments.
+func manifestSource(args []string) output.ManifestSource {
+	var source output.ManifestSource
+	parsed, err := cli.ParseContext(args)
+	if err != nil {
+		// The arguments were parsed already.
+		return source
+	}
+	for _, element := range parsed.Elements {
+		switch clause := element.Clause.(type) {
+		case *kingpin.CmdClause:
+			source.Command = clause.FullCommand()
+		case *kingpin.FlagClause:
+			if element.Value != nil {
+				source.AddFlag(clause.Model().Name, *element.Value)
+			}
+		case *kingpin.ArgClause:
+			if element.Value != nil {
+				source.AddArg(*element.Value)
+			}
+		}
+	}
+	return source
+}
+
+// printManifest prints the manifest of the scan after its results, in the
+// output format of the results.
+func printManifest(manifest output.Manifest) error {
+	switch {
+	case cmd == preReceive.FullCommand():
+		// Only the rejection is shown to the pusher.
+		return nil
+	case *jsonLegacy, *jsonOut:
+		return output.PrintJSONManifest(os.Stdout, manifest)
+	case *gitHubActionsFormat:
+		return output.PrintGitHubActionsManifest(os.Stdout, manifest)
+	default:
+		return output.PrintPlainManifest(os.Stdout, manifest)
+	}
+}
+
+// scan starts scanning the source of the selected command with e. The returned
+// function cleans up after the scan, once it's finished.
+func scan(ctx context.Context, e *engine.Engine) (cleanup func()) {
+	logFatal := logFatalFunc(ctx.Logger())
+	cleanup = func() {}
 
 	var repoPath string
 	var remote bool
 	switch cmd {
 	case gitScan.FullCommand():
-		filter, err := common.FilterFromFiles(*gitScanIncludePaths, *gitScanExcludePaths)
+		filter, err := pathFilter(*gitScanIncludePaths, *gitScanExcludePaths)
 		if err != nil {
 			logFatal(err, "could not create filter")
 		}
+		if *gitScanStaged && !strings.HasPrefix(*gitScanURI, "file://") {
+			logFatal(fmt.Errorf("invalid config"), "--staged requires a local repository (file://).")
+		}
 		repoPath, remote, err = git.PrepareRepoSinceCommit(ctx, *gitScanURI, *gitScanSinceCommit)
 		if err != nil || repoPath == "" {
 			logFatal(err, "error preparing git repo for scanning")
 		}
 		if remote {
-			defer os.RemoveAll(repoPath)
+			cleanup = func() { os.RemoveAll(repoPath) }
+			if *gitScanNotes {
+				if err := git.FetchNotes(ctx, repoPath); err != nil {
+					ctx.Logger().V(1).Info("could not fetch git notes", "error", err)
+				}
+			}
 		}
 		excludedGlobs := []string{}
 		if *gitScanExcludeGlobs != "" {
@@ -313,57 +934,101 @@ func run(state overseer.State) {
 		}
 
 		cfg := sources.GitConfig{
-			RepoPath:     repoPath,
-			HeadRef:      *gitScanBranch,
-			BaseRef:      *gitScanSinceCommit,
-			MaxDepth:     *gitScanMaxDepth,
-			Filter:       filter,
-			ExcludeGlobs: excludedGlobs,
+			RepoPath:           repoPath,
+			HeadRef:            *gitScanBranch,
+			BaseRef:            *gitScanSinceCommit,
+			MaxDepth:           *gitScanMaxDepth,
+			Filter:             filter,
+			ExcludeGlobs:       excludedGlobs,
+			IncludeNotes:       *gitScanNotes,
+			IncludeStash:       *gitScanStash,
+			IncludeReflog:      *gitScanReflog,
+			IncludeLFS:         *gitScanLFS,
+			MaxLFSObjectSize:   int64(*gitScanLFSMaxSize),
+			SubmoduleDepth:     submoduleDepth(*gitSubmodules, *gitSubmoduleDepth),
+			SubmoduleAllowlist: *gitSubmoduleAllow,
+			StagedOnly:         *gitScanStaged,
+			SecretAge:          *gitScanSecretAge,
+			Owners:             *gitScanOwners,
 		}
 		if err = e.ScanGit(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan Git.")
 		}
 	case githubScan.FullCommand():
-		filter, err := common.FilterFromFiles(*githubScanIncludePaths, *githubScanExcludePaths)
+		filter, err := pathFilter(*githubScanIncludePaths, *githubScanExcludePaths)
 		if err != nil {
 			logFatal(err, "could not create filter")
 		}
-		if len(*githubScanOrgs) == 0 && len(*githubScanRepos) == 0 {
-			logFatal(fmt.Errorf("invalid config"), "You must specify at least one organization or repository.")
+		if len(*githubScanOrgs) == 0 && len(*githubScanRepos) == 0 && len(*githubGistUsers) == 0 && *githubAppID == "" {
+			logFatal(fmt.Errorf("invalid config"), "You must specify at least one organization, repository or gist user, or a GitHub App.")
+		}
+		var appPrivateKey []byte
+		if *githubAppID != "" {
+			appPrivateKey, err = os.ReadFile(*githubAppPrivateKey)
+			if err != nil {
+				logFatal(err, "could not read GitHub App private key")
+			}
 		}
 
 		cfg := sources.GithubConfig{
-			Endpoint:       *githubScanEndpoint,
-			Token:          *githubScanToken,
-			IncludeForks:   *githubIncludeForks,
-			IncludeMembers: *githubIncludeMembers,
-			Concurrency:    *concurrency,
-			ExcludeRepos:   *githubExcludeRepos,
-			IncludeRepos:   *githubIncludeRepos,
-			Repos:          *githubScanRepos,
-			Orgs:           *githubScanOrgs,
-			Filter:         filter,
+			Endpoint:           *githubScanEndpoint,
+			Token:              *githubScanToken,
+			AppID:              *githubAppID,
+			AppPrivateKey:      string(appPrivateKey),
+			AppInstallationID:  *githubAppInstallation,
+			IncludeForks:       *githubIncludeForks,
+			IncludeMembers:     *githubIncludeMembers,
+			IncludeGists:       *githubIncludeGists,
+			IncludeComments:    *githubIncludeComments,
+			IncludeWikis:       *githubIncludeWikis,
+			IncludeReleases:    *githubIncludeReleases,
+			IncludeActionsLogs: *githubIncludeActions,
+			ActionsLogsWindow:  *githubActionsWindow,
+			Concurrency:        *concurrency,
+			ExcludeRepos:       *githubExcludeRepos,
+			IncludeRepos:       *githubIncludeRepos,
+			Repos:              *githubScanRepos,
+			Orgs:               *githubScanOrgs,
+			GistUsers:          *githubGistUsers,
+			SubmoduleDepth:     submoduleDepth(*githubSubmodules, *githubSubmoduleDepth),
+			SubmoduleAllowlist: *githubSubmoduleAllow,
+			Filter:             filter,
 		}
 		if err := e.ScanGitHub(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan Github.")
 		}
 	case gitlabScan.FullCommand():
-		filter, err := common.FilterFromFiles(*gitlabScanIncludePaths, *gitlabScanExcludePaths)
+		filter, err := pathFilter(*gitlabScanIncludePaths, *gitlabScanExcludePaths)
 		if err != nil {
 			logFatal(err, "could not create filter")
 		}
 
 		cfg := sources.GitlabConfig{
-			Endpoint: *gitlabScanEndpoint,
-			Token:    *gitlabScanToken,
-			Repos:    *gitlabScanRepos,
-			Filter:   filter,
+			Endpoint:            *gitlabScanEndpoint,
+			Token:               *gitlabScanToken,
+			Repos:               *gitlabScanRepos,
+			IncludeSnippets:     *gitlabScanSnippets,
+			IncludeComments:     *gitlabScanComments,
+			CommentProjects:     *gitlabCommentProjects,
+			IncludeCIVariables:  *gitlabScanCIVariables,
+			IncludeJobLogs:      *gitlabScanJobLogs,
+			IncludeJobArtifacts: *gitlabScanArtifacts,
+			JobLogsWindow:       *gitlabJobLogsWindow,
+			IncludeGroups:       *gitlabIncludeGroups,
+			IgnoreGroups:        *gitlabIgnoreGroups,
+			IncludeRepos:        *gitlabIncludeRepos,
+			IgnoreRepos:         *gitlabIgnoreRepos,
+			SkipArchived:        *gitlabSkipArchived,
+			SkipForks:           *gitlabSkipForks,
+			SubmoduleDepth:      submoduleDepth(*gitlabSubmodules, *gitlabSubmoduleDepth),
+			SubmoduleAllowlist:  *gitlabSubmoduleAllow,
+			Filter:              filter,
 		}
 		if err := e.ScanGitLab(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan GitLab.")
 		}
 	case filesystemScan.FullCommand():
-		filter, err := common.FilterFromFiles(*filesystemScanIncludePaths, *filesystemScanExcludePaths)
+		filter, err := pathFilter(*filesystemScanIncludePaths, *filesystemScanExcludePaths)
 		if err != nil {
 			logFatal(err, "could not create filter")
 		}
@@ -374,18 +1039,39 @@ func run(state overseer.State) {
 		paths = append(paths, *filesystemPaths...)
 		paths = append(paths, *filesystemDirectories...)
 		cfg := sources.FilesystemConfig{
-			Paths:  paths,
-			Filter: filter,
+			Paths:            paths,
+			Filter:           filter,
+			RespectGitignore: *filesystemGitignore,
+			Watch:            *filesystemWatch,
+
+			FollowSymlinks:           *filesystemSymlinks,
+			ScanDevices:              *filesystemDevices,
+			ScanAlternateDataStreams: *filesystemADS,
+			MaxFileSize:              int64(*filesystemMaxSize),
 		}
 		if err = e.ScanFileSystem(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan filesystem")
 		}
 	case s3Scan.FullCommand():
+		filter, err := pathFilter(*s3IncPaths, *s3ExcPaths)
+		if err != nil {
+			logFatal(err, "could not create filter")
+		}
 		cfg := sources.S3Config{
-			Key:       *s3ScanKey,
-			Secret:    *s3ScanSecret,
-			Buckets:   *s3ScanBuckets,
-			CloudCred: *s3ScanCloudEnv,
+			Key:             *s3ScanKey,
+			Secret:          *s3ScanSecret,
+			Buckets:         *s3ScanBuckets,
+			CloudCred:       *s3ScanCloudEnv,
+			Roles:           *s3ScanRoles,
+			ExternalID:      *s3ScanExtID,
+			BucketRoles:     *s3BucketRoles,
+			IncludePrefixes: *s3IncPrefixes,
+			ExcludePrefixes: *s3ExcPrefixes,
+			IncludeSuffixes: *s3IncSuffixes,
+			ExcludeSuffixes: *s3ExcSuffixes,
+			MaxObjectSize:   int64(*s3MaxSize),
+			IncludeVersions: *s3Versions,
+			Filter:          filter,
 		}
 		if err := e.ScanS3(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan S3.")
@@ -398,15 +1084,71 @@ func run(state overseer.State) {
 			CertPath:    *syslogTLSCert,
 			KeyPath:     *syslogTLSKey,
 			Concurrency: *concurrency,
+
+			ClientCAPath:    *syslogClientCA,
+			SenderRateLimit: *syslogRate,
+			SenderBurst:     *syslogBurst,
 		}
 		if err := e.ScanSyslog(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan syslog.")
 		}
+	case containersScan.FullCommand():
+		filter, err := pathFilter(*containersIncPaths, *containersExcPaths)
+		if err != nil {
+			logFatal(err, "could not create filter")
+		}
+		cfg := sources.ContainersConfig{
+			Endpoint:       *containersEndpoint,
+			Containers:     *containersIDs,
+			IncludeStopped: *containersStopped,
+			SkipVolumes:    *containersNoVols,
+			SkipEnv:        *containersNoEnv,
+			Filter:         filter,
+		}
+		if err := e.ScanContainers(ctx, cfg); err != nil {
+			logFatal(err, "Failed to scan containers.")
+		}
+	case pluginScan.FullCommand():
+		source, err := plugins.StartSource(*pluginPath)
+		if err != nil {
+			logFatal(err, "could not start the source plugin")
+		}
+		cleanup = plugins.Shutdown
+		conn, err := plugins.SourceConnection(*pluginOptions)
+		if err != nil {
+			logFatal(err, "invalid --option")
+		}
+		if err := source.Init(ctx, "trufflehog - plugin", 0, int64(source.Type()), true, conn, *concurrency); err != nil {
+			logFatal(err, "could not initialize the source plugin")
+		}
+		e.ScanSource(ctx, source)
 	case circleCiScan.FullCommand():
-		if err := e.ScanCircleCI(ctx, *circleCiScanToken); err != nil {
+		filter, err := pathFilter(*circleCiIncPaths, *circleCiExcPaths)
+		if err != nil {
+			logFatal(err, "could not create filter")
+		}
+		cfg := sources.CircleCIConfig{
+			Token:            *circleCiScanToken,
+			IncludeArtifacts: *circleCiArtifacts,
+			AuditEnvVars:     *circleCiAuditEnv,
+			Branches:         *circleCiBranches,
+			Workflows:        *circleCiWorkflows,
+			Filter:           filter,
+		}
+		if cfg.Since, err = parseTimeFlag(*circleCiSince); err != nil {
+			logFatal(err, "Invalid --since time.")
+		}
+		if cfg.Until, err = parseTimeFlag(*circleCiUntil); err != nil {
+			logFatal(err, "Invalid --until time.")
+		}
+		if err := e.ScanCircleCI(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan CircleCI.")
 		}
 	case gcsScan.FullCommand():
+		filter, err := pathFilter(*gcsIncPaths, *gcsExcPaths)
+		if err != nil {
+			logFatal(err, "could not create filter")
+		}
 		cfg := sources.GCSConfig{
 			ProjectID:      *gcsProjectID,
 			CloudCred:      *gcsCloudEnv,
@@ -419,55 +1161,44 @@ func run(state overseer.State) {
 			ExcludeObjects: commaSeperatedToSlice(*gcsExcludeObjects),
 			Concurrency:    *concurrency,
 			MaxObjectSize:  int64(*gcsMaxObjectSize),
+
+			IncludePrefixes:    *gcsIncPrefixes,
+			ExcludePrefixes:    *gcsExcPrefixes,
+			UserProject:        *gcsUserProject,
+			IncludeGenerations: *gcsGenerations,
+			Filter:             filter,
 		}
 		if err := e.ScanGCS(ctx, cfg); err != nil {
 			logFatal(err, "Failed to scan GCS.")
 		}
-	}
-	// asynchronously wait for scanning to finish and cleanup
-	go e.Finish(ctx)
-
-	if !*jsonLegacy && !*jsonOut {
-		fmt.Fprintf(os.Stderr, "🐷🔑🐷  TruffleHog. Unearth your secrets. 🐷🔑🐷\n\n")
-	}
-
-	// NOTE: this loop will terminate when the results channel is closed in
-	// e.Finish()
-	foundResults := false
-	for r := range e.ResultsChan() {
-		if *onlyVerified && !r.Verified {
-			continue
+	case reverify.FullCommand():
+		f, err := os.Open(*reverifyFindings)
+		if err != nil {
+			logFatal(err, "could not open findings file")
 		}
-		foundResults = true
-
-		var err error
-		switch {
-		case *jsonLegacy:
-			err = output.PrintLegacyJSON(ctx, &r)
-		case *jsonOut:
-			err = output.PrintJSON(&r)
-		case *gitHubActionsFormat:
-			err = output.PrintGitHubActionsOutput(&r)
-		default:
-			err = output.PrintPlainOutput(&r)
+		findings, err := output.ReadJSON(f)
+		f.Close()
+		if err != nil {
+			logFatal(err, "could not read findings file")
 		}
+		e.Reverify(ctx, findings)
+	case preReceive.FullCommand():
+		revisions, err := git.PushedRevisions(os.Stdin)
 		if err != nil {
-			logFatal(err, "error printing results")
+			logFatal(err, "could not read the ref updates of the push")
+		}
+		// A push that only deletes refs adds nothing to scan.
+		if len(revisions) > 0 {
+			cfg := sources.GitConfig{
+				RepoPath:        ".",
+				PushedRevisions: revisions,
+			}
+			if err := e.ScanGit(ctx, cfg); err != nil {
+				logFatal(err, "Failed to scan the push.")
+			}
 		}
 	}
-	logger.V(2).Info("finished scanning",
-		"chunks", e.ChunksScanned(),
-		"bytes", e.BytesScanned(),
-	)
-
-	if *printAvgDetectorTime {
-		printAverageDetectorTime(e)
-	}
-
-	if foundResults && *fail {
-		logger.V(2).Info("exiting with code 183 because results were found")
-		os.Exit(183)
-	}
+	return cleanup
 }
 
 func commaSeperatedToSlice(s []string) []string {
@@ -484,6 +1215,57 @@ func commaSeperatedToSlice(s []string) []string {
 	return result
 }
 
+// parseTimeFlag parses a time given as an RFC 3339 timestamp or a date. An
+// empty flag is the zero time.
+func parseTimeFlag(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// submoduleDepth returns how many levels of submodules to scan, or zero if
+// submodules aren't scanned.
+func submoduleDepth(recurse bool, depth int) int {
+	if !recurse {
+		return 0
+	}
+	return depth
+}
+
+// flagValue returns the value that args give the flag called name, or an empty
+// string if they don't set it. It's needed for --config and --config-profile,
+// which select the flags that the configuration file adds before the command
+// line is parsed.
+func flagValue(args []string, name string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--"+name+"="); ok {
+			return value
+		}
+		if arg == "--"+name && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// pathFilter creates the filter of the files to scan from the include and
+// exclude rule files, and the global size limit.
+func pathFilter(includePaths, excludePaths string) (*common.Filter, error) {
+	filter, err := common.FilterFromFiles(includePaths, excludePaths)
+	if err != nil {
+		return nil, err
+	}
+	filter.SetMaxSize(int64(*excludeLargerThan))
+	return filter, nil
+}
+
 func printAverageDetectorTime(e *engine.Engine) {
 	fmt.Fprintln(os.Stderr, "Average detector time is the measurement of average time spent on each detector when results are returned.")
 	for detectorName, durations := range e.DetectorAvgTime() {
@@ -496,6 +1278,254 @@ func printAverageDetectorTime(e *engine.Engine) {
 	}
 }
 
+// printDetectors prints the detectors whose name contains filter.
+func printDetectors(infos []engine.DetectorInfo, filter string) error {
+	filter = strings.ToLower(filter)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	if !*jsonOut {
+		fmt.Fprintln(w, "NAME\tID\tVERSION\tVERIFIES\tKEYWORDS\tENDPOINTS")
+	}
+	for _, info := range infos {
+		if !strings.Contains(strings.ToLower(info.Name), filter) {
+			continue
+		}
+		if *jsonOut {
+			out, err := json.Marshal(map[string]any{
+				"name":      info.Name,
+				"id":        int32(info.Type),
+				"version":   info.Version,
+				"verifies":  info.Verify,
+				"keywords":  info.Keywords,
+				"endpoints": info.Endpoints,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			continue
+		}
+		endpoints := strings.Join(info.Endpoints, ",")
+		switch {
+		case info.Endpoints == nil:
+			endpoints = "-"
+		case len(info.Endpoints) == 0:
+			endpoints = "none"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%t\t%s\t%s\n", info.Name, info.Type, info.Version, info.Verify, strings.Join(info.Keywords, ","), endpoints)
+	}
+	return w.Flush()
+}
+
+// runDaemon runs the scans of the schedules of the configuration file until
+// it's interrupted. Each scan runs in a new trufflehog process with the same
+// configuration file, which sets the global flags of the scans.
+func runDaemon(ctx context.Context) error {
+	schedules, err := config.ReadSchedules(cli, *configFilename)
+	if err != nil {
+		return err
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args := []string{"--config=" + *configFilename, "--json", "--no-update"}
+	if *configProfile != "" {
+		args = append(args, "--config-profile="+*configProfile)
+	}
+
+	jobs := make([]daemon.Job, 0, len(schedules))
+	for _, s := range schedules {
+		cron, err := daemon.ParseCron(s.Cron)
+		if err != nil {
+			return fmt.Errorf("schedule %s: %w", s.Name, err)
+		}
+		jobs = append(jobs, daemon.Job{
+			Name: s.Name,
+			Cron: cron,
+			Args: append(append([]string{}, args...), s.Args...),
+		})
+	}
+	var sinks []daemon.Sink
+	for _, url := range *daemonWebhooks {
+		sinks = append(sinks, &daemon.WebhookSink{URL: url})
+	}
+	for _, path := range *daemonSinkFiles {
+		sinks = append(sinks, &daemon.FileSink{Path: path})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		ctx.Logger().Info("stopping the daemon")
+		cancel()
+	}()
+
+	return daemon.Run(ctx, daemon.Config{
+		Command:  executable,
+		Jobs:     jobs,
+		StateDir: *daemonStateDir,
+		Sinks:    sinks,
+		Listen:   *daemonListen,
+	})
+}
+
+// runTestDetector runs the detectors named name against a file, or standard
+// input, and prints their matches and results.
+func runTestDetector(ctx context.Context, candidates []detectors.Detector, name, file string, verify bool) error {
+	selected, err := selectDetectors(candidates, name)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	if file != "" {
+		data, err = os.ReadFile(file)
+	} else {
+		data, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, detector := range selected {
+		fmt.Printf("Detector: %s\n", detectorName(detector))
+		if !containsKeyword(data, detector.Keywords()) {
+			fmt.Printf("The input contains none of the keywords %s, so scans skip it.\n", strings.Join(detector.Keywords(), ", "))
+		}
+
+		// Custom detectors report the capture groups of their regexes.
+		if matcher, ok := detector.(interface {
+			Matches([]byte) ([]map[string][]string, error)
+		}); ok {
+			matches, err := matcher.Matches(data)
+			if err != nil {
+				return err
+			}
+			for i, match := range matches {
+				fmt.Printf("Match %d:\n", i+1)
+				for _, regex := range sortedKeys(match) {
+					fmt.Printf("  %s: %q\n", regex, match[regex])
+				}
+			}
+		}
+
+		results, err := detector.FromData(ctx, verify, data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", detectorName(detector), err)
+		}
+		for i, r := range results {
+			status := "unverified"
+			if r.Canary {
+				status = "canary"
+			} else if r.Verified {
+				status = "verified"
+			} else if !verify {
+				status = "not verified"
+			}
+			fmt.Printf("Result %d: %s\n", i+1, status)
+			fmt.Printf("  Raw: %s\n", r.Raw)
+			if len(r.RawV2) > 0 {
+				fmt.Printf("  RawV2: %s\n", r.RawV2)
+			}
+			if r.Redacted != "" {
+				fmt.Printf("  Redacted: %s\n", r.Redacted)
+			}
+			for _, k := range sortedKeys(r.ExtraData) {
+				fmt.Printf("  %s: %s\n", k, r.ExtraData[k])
+			}
+		}
+		fmt.Printf("%d results\n\n", len(results))
+	}
+	return nil
+}
+
+// selectDetectors returns the custom detectors named name, or else the
+// detectors that name selects in --include-detectors.
+func selectDetectors(candidates []detectors.Detector, name string) ([]detectors.Detector, error) {
+	var selected []detectors.Detector
+	for _, detector := range candidates {
+		if named, ok := detector.(interface{ GetName() string }); ok && strings.EqualFold(named.GetName(), name) {
+			selected = append(selected, detector)
+		}
+	}
+	if len(selected) > 0 {
+		return selected, nil
+	}
+
+	ids, err := config.ParseDetectors(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) != 1 {
+		return nil, fmt.Errorf("expected the name of one detector, got %q", name)
+	}
+	for _, detector := range candidates {
+		if ids[0].Matches(detector) {
+			selected = append(selected, detector)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no detector named %q", name)
+	}
+	return selected, nil
+}
+
+func detectorName(detector detectors.Detector) string {
+	if named, ok := detector.(interface{ GetName() string }); ok && named.GetName() != "" {
+		return named.GetName()
+	}
+	id := config.DetectorID{ID: detector.Type()}
+	if versioner, ok := detector.(detectors.Versioner); ok {
+		id.Version = versioner.Version()
+	}
+	return id.String()
+}
+
+// containsKeyword reports whether data contains one of the keywords, like the
+// engine checks before running a detector.
+func containsKeyword(data []byte, keywords []string) bool {
+	if len(keywords) == 0 {
+		return true
+	}
+	lower := bytes.ToLower(data)
+	for _, kw := range keywords {
+		if bytes.Contains(lower, bytes.ToLower([]byte(kw))) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printVerificationPlan() {
+	plan := common.VerificationPlan()
+	requests := make([]common.PlannedRequest, 0, len(plan))
+	for r := range plan {
+		requests = append(requests, r)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].Detector != requests[j].Detector {
+			return requests[i].Detector < requests[j].Detector
+		}
+		return requests[i].Endpoint < requests[j].Endpoint
+	})
+
+	fmt.Fprintln(os.Stderr, "Verification dry run. No requests were sent. Endpoints that would have been contacted:")
+	for _, r := range requests {
+		fmt.Fprintf(os.Stderr, "%s: %s %s (%d requests)\n", r.Detector, r.Method, r.Endpoint, plan[r])
+	}
+}
+
 func splitVerifierURLs(verifierURLs map[string]string) map[string][]string {
 	verifiers := make(map[string][]string, len(verifierURLs))
 	for k, v := range verifierURLs {
@@ -522,10 +1552,85 @@ func logFatalFunc(logger logr.Logger) func(error, string, ...any) {
 	}
 }
 
-func detectorTypeToMap(detectors []config.DetectorID) map[detectorspb.DetectorType]config.DetectorID {
-	output := make(map[detectorspb.DetectorType]config.DetectorID, len(detectors))
-	for _, d := range detectors {
-		output[d.ID] = d
+// runFalsePositive adds the findings of the feedback file and the patterns of
+// the false-positive command to the allowlist.
+func runFalsePositive(ctx context.Context) error {
+	list, err := allowlist.Load(*allowlistPath)
+	if err != nil {
+		return err
+	}
+	added := 0
+	if *falsePositiveFindings != "" {
+		f, err := os.Open(*falsePositiveFindings)
+		if err != nil {
+			return fmt.Errorf("could not open the feedback file: %w", err)
+		}
+		findings, err := output.ReadJSON(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("could not read the feedback file: %w", err)
+		}
+		for _, finding := range findings {
+			ok, err := list.MarkFalsePositive(finding.Result, *falsePositiveReason)
+			if err != nil {
+				return err
+			}
+			if ok {
+				added++
+			}
+		}
+	}
+	for _, pattern := range *falsePositivePatterns {
+		ok, err := list.Add(allowlist.Entry{
+			Pattern:  pattern,
+			Detector: *falsePositiveDetector,
+			Reason:   *falsePositiveReason,
+			Added:    time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+		if ok {
+			added++
+		}
+	}
+	if err := list.Save(*allowlistPath); err != nil {
+		return err
+	}
+	ctx.Logger().Info("updated the allowlist", "added", added, "entries", len(list.Entries()))
+	return nil
+}
+
+// isAnalyze reports whether a command is one of the analyze commands.
+func isAnalyze(command string) bool {
+	return strings.HasPrefix(command, analyzeCmd.FullCommand()+" ")
+}
+
+// runAnalyze analyzes the secret given to the selected analyze command.
+func runAnalyze(ctx context.Context) error {
+	var report *analyzer.Report
+	var err error
+	switch cmd {
+	case analyzeAWS.FullCommand():
+		report, err = awsanalyzer.Analyze(ctx, *analyzeAWSKeyID, *analyzeAWSSecret, *analyzeAWSSession)
+	case analyzeGitLab.FullCommand():
+		// Like the detector, the token is tried against the instances of
+		// --verifier and gitlab.com.
+		urls := *analyzeGitLabURLs
+		if len(urls) == 0 {
+			urls = append(splitVerifierURLs(*verifiers)["gitlab"], gitlabanalyzer.DefaultURL)
+		}
+		report, err = gitlabanalyzer.Analyze(ctx, *analyzeGitLabToken, urls)
+	case analyzeGitHub.FullCommand():
+		report, err = githubanalyzer.Analyze(ctx, *analyzeGitHubToken, *analyzeGitHubEndpoint)
+	default:
+		return fmt.Errorf("unknown analyzer %q", cmd)
+	}
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		return analyzer.PrintJSON(os.Stdout, report)
 	}
-	return output
+	return analyzer.PrintText(os.Stdout, report)
 }
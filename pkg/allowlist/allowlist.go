@@ -0,0 +1,196 @@
+// Package allowlist keeps the findings that were marked as false positives,
+// so that later scans suppress them. Findings are allowed by the fingerprint
+// of their secret, or by a pattern for false positives that change from one
+// scan to the next, like generated test data.
+package allowlist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/ticketing"
+)
+
+// Entry allows the findings of a false positive.
+type Entry struct {
+	// Fingerprint identifies the secret of the allowed findings, as
+	// ticketing.Fingerprint does.
+	Fingerprint string `json:",omitempty"`
+	// Pattern is a regular expression that matches the secrets of the
+	// allowed findings.
+	Pattern string `json:",omitempty"`
+	// Detector restricts the entry to the findings of a detector, by name.
+	Detector string `json:",omitempty"`
+	// Redacted is the redacted secret of the finding that was marked, so
+	// that entries can be told apart without the secret.
+	Redacted string `json:",omitempty"`
+	Reason   string `json:",omitempty"`
+	Added    time.Time
+}
+
+// Allowlist is a set of entries. It's safe for concurrent use.
+type Allowlist struct {
+	mu           sync.RWMutex
+	entries      []Entry
+	fingerprints map[string]Entry
+	patterns     []pattern
+
+	suppressed uint64
+}
+
+type pattern struct {
+	re       *regexp.Regexp
+	detector string
+}
+
+// file is the format of persisted allowlists.
+type file struct {
+	Entries []Entry
+}
+
+// New returns an empty allowlist.
+func New() *Allowlist {
+	return &Allowlist{fingerprints: map[string]Entry{}}
+}
+
+// Load reads the allowlist persisted at path with Save. A missing file is an
+// empty allowlist.
+func Load(path string) (*Allowlist, error) {
+	a := New()
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return a, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read the allowlist: %w", err)
+	}
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("could not parse the allowlist %s: %w", path, err)
+	}
+	for _, entry := range f.Entries {
+		if _, err := a.Add(entry); err != nil {
+			return nil, fmt.Errorf("invalid entry in the allowlist %s: %w", path, err)
+		}
+	}
+	return a, nil
+}
+
+// Save persists the allowlist at path. It writes a temporary file first, so
+// that a crash doesn't leave it half written.
+func (a *Allowlist) Save(path string) error {
+	a.mu.RLock()
+	data, err := json.MarshalIndent(file{Entries: a.entries}, "", "  ")
+	a.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("could not write the allowlist: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Add adds an entry, which needs a fingerprint or a pattern. It reports
+// whether the entry was added, which it isn't if the allowlist has it
+// already.
+func (a *Allowlist) Add(entry Entry) (bool, error) {
+	if entry.Fingerprint == "" && entry.Pattern == "" {
+		return false, errors.New("an entry needs a fingerprint or a pattern")
+	}
+	var re *regexp.Regexp
+	if entry.Pattern != "" {
+		var err error
+		if re, err = regexp.Compile(entry.Pattern); err != nil {
+			return false, fmt.Errorf("invalid pattern %q: %w", entry.Pattern, err)
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, existing := range a.entries {
+		if existing.Fingerprint == entry.Fingerprint && existing.Pattern == entry.Pattern && strings.EqualFold(existing.Detector, entry.Detector) {
+			return false, nil
+		}
+	}
+	a.entries = append(a.entries, entry)
+	if entry.Fingerprint != "" {
+		a.fingerprints[entry.Fingerprint] = entry
+	} else {
+		a.patterns = append(a.patterns, pattern{re: re, detector: entry.Detector})
+	}
+	return true, nil
+}
+
+// MarkFalsePositive adds the secret of a finding to the allowlist, and
+// reports whether it wasn't in it yet.
+func (a *Allowlist) MarkFalsePositive(r detectors.Result, reason string) (bool, error) {
+	return a.Add(Entry{
+		Fingerprint: ticketing.Fingerprint(r),
+		Detector:    detectorName(r),
+		Redacted:    r.Redacted,
+		Reason:      reason,
+		Added:       time.Now().UTC(),
+	})
+}
+
+// Entries returns the entries of the allowlist, in the order they were
+// added.
+func (a *Allowlist) Entries() []Entry {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return append([]Entry(nil), a.entries...)
+}
+
+// Allowed reports whether the allowlist has an entry for the finding.
+func (a *Allowlist) Allowed(r detectors.Result) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if _, ok := a.fingerprints[ticketing.Fingerprint(r)]; ok {
+		return true
+	}
+	name := detectorName(r)
+	for _, p := range a.patterns {
+		if p.detector != "" && !strings.EqualFold(p.detector, name) {
+			continue
+		}
+		if p.re.Match(r.Raw) || (len(r.RawV2) > 0 && p.re.Match(r.RawV2)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Process is an engine.ResultProcessor that drops the findings the allowlist
+// has, and counts them.
+func (a *Allowlist) Process(_ context.Context, r *detectors.ResultWithMetadata) bool {
+	if a.Allowed(r.Result) {
+		atomic.AddUint64(&a.suppressed, 1)
+		return false
+	}
+	return true
+}
+
+// Suppressed returns the number of findings Process dropped.
+func (a *Allowlist) Suppressed() int {
+	return int(atomic.LoadUint64(&a.suppressed))
+}
+
+// detectorName is the name of the detector of a result, which custom
+// detectors set.
+func detectorName(r detectors.Result) string {
+	if r.DetectorName != "" {
+		return r.DetectorName
+	}
+	return r.DetectorType.String()
+}
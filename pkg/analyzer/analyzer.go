@@ -0,0 +1,121 @@
+// Package analyzer reports what a secret gives access to, e.g. who it
+// authenticates as and what it's allowed to do, so that responders can gauge
+// the impact of a leak.
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Report is the result of the analysis of a secret.
+type Report struct {
+	// Analyzer is the name of the analyzer, e.g. AWS.
+	Analyzer string
+	// Identity describes who the secret authenticates as.
+	Identity []Field
+	// Permissions summarize what the secret is allowed to do.
+	Permissions []Permission `json:",omitempty"`
+	// Resources are the resources that the secret can access, or that grant
+	// it permissions, like policies.
+	Resources []Resource `json:",omitempty"`
+	// Notes are what the analysis couldn't find out, e.g. because the secret
+	// isn't allowed to list its own permissions.
+	Notes []string `json:",omitempty"`
+}
+
+// Field is a named value.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Permission is what a secret is allowed to do in a scope, e.g. an AWS
+// service or a token scope.
+type Permission struct {
+	Scope   string
+	Allowed []string `json:",omitempty"`
+	Denied  []string `json:",omitempty"`
+}
+
+// Resource is a resource that a secret can access.
+type Resource struct {
+	// Kind is the kind of the resource, e.g. project or policy.
+	Kind string
+	Name string
+	// Access is what the secret can do with the resource, e.g. read or push.
+	Access     []string `json:",omitempty"`
+	Attributes []Field  `json:",omitempty"`
+}
+
+// AddIdentity adds a field to the identity, if its value isn't empty.
+func (r *Report) AddIdentity(name, value string) {
+	if value != "" {
+		r.Identity = append(r.Identity, Field{Name: name, Value: value})
+	}
+}
+
+// AddNote adds a note about what the analysis couldn't find out.
+func (r *Report) AddNote(format string, args ...any) {
+	r.Notes = append(r.Notes, fmt.Sprintf(format, args...))
+}
+
+// PrintJSON writes the report as a line of JSON.
+func PrintJSON(w io.Writer, r *Report) error {
+	out, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("could not marshal report: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// PrintText writes the report for humans.
+func PrintText(w io.Writer, r *Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\n\n", r.Analyzer)
+
+	fmt.Fprintln(tw, "Identity:")
+	for _, f := range r.Identity {
+		fmt.Fprintf(tw, "  %s:\t%s\n", f.Name, f.Value)
+	}
+
+	if len(r.Permissions) > 0 {
+		fmt.Fprintln(tw, "\nPermissions:")
+		for _, p := range r.Permissions {
+			var access []string
+			if len(p.Allowed) > 0 {
+				access = append(access, "allowed: "+strings.Join(p.Allowed, ", "))
+			}
+			if len(p.Denied) > 0 {
+				access = append(access, "denied: "+strings.Join(p.Denied, ", "))
+			}
+			fmt.Fprintf(tw, "  %s\t%s\n", p.Scope, strings.Join(access, "; "))
+		}
+	}
+
+	if len(r.Resources) > 0 {
+		fmt.Fprintln(tw, "\nResources:")
+		for _, res := range r.Resources {
+			var details []string
+			if len(res.Access) > 0 {
+				details = append(details, strings.Join(res.Access, ", "))
+			}
+			for _, f := range res.Attributes {
+				details = append(details, f.Name+": "+f.Value)
+			}
+			fmt.Fprintf(tw, "  %s %s\t%s\n", res.Kind, res.Name, strings.Join(details, "; "))
+		}
+	}
+
+	if len(r.Notes) > 0 {
+		fmt.Fprintln(tw, "\nNotes:")
+		for _, note := range r.Notes {
+			fmt.Fprintf(tw, "  - %s\n", note)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,34 @@
+package analyzer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintText(t *testing.T) {
+	report := &Report{Analyzer: "Example"}
+	report.AddIdentity("User", "alice")
+	report.AddIdentity("Email", "")
+	report.Permissions = []Permission{{Scope: "repo", Allowed: []string{"read", "write"}, Denied: []string{"admin"}}}
+	report.Resources = []Resource{{Kind: "project", Name: "org/app", Access: []string{"push"}, Attributes: []Field{{Name: "Visibility", Value: "private"}}}}
+	report.AddNote("could not list %s", "groups")
+
+	var buf bytes.Buffer
+	assert.NoError(t, PrintText(&buf, report))
+	assert.Equal(t, `Example
+
+Identity:
+  User:  alice
+
+Permissions:
+  repo  allowed: read, write; denied: admin
+
+Resources:
+  project org/app  push; Visibility: private
+
+Notes:
+  - could not list groups
+`, buf.String())
+}
@@ -0,0 +1,317 @@
+// Package aws analyzes AWS access keys.
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// stsAPI is the part of the STS API that the analyzer uses.
+type stsAPI interface {
+	GetCallerIdentityWithContext(aws.Context, *sts.GetCallerIdentityInput, ...request.Option) (*sts.GetCallerIdentityOutput, error)
+}
+
+// iamAPI is the part of the IAM API that the analyzer uses.
+type iamAPI interface {
+	GetAccessKeyLastUsedWithContext(aws.Context, *iam.GetAccessKeyLastUsedInput, ...request.Option) (*iam.GetAccessKeyLastUsedOutput, error)
+	ListGroupsForUserPagesWithContext(aws.Context, *iam.ListGroupsForUserInput, func(*iam.ListGroupsForUserOutput, bool) bool, ...request.Option) error
+	ListAttachedUserPoliciesPagesWithContext(aws.Context, *iam.ListAttachedUserPoliciesInput, func(*iam.ListAttachedUserPoliciesOutput, bool) bool, ...request.Option) error
+	ListAttachedGroupPoliciesPagesWithContext(aws.Context, *iam.ListAttachedGroupPoliciesInput, func(*iam.ListAttachedGroupPoliciesOutput, bool) bool, ...request.Option) error
+	ListAttachedRolePoliciesPagesWithContext(aws.Context, *iam.ListAttachedRolePoliciesInput, func(*iam.ListAttachedRolePoliciesOutput, bool) bool, ...request.Option) error
+	ListUserPoliciesPagesWithContext(aws.Context, *iam.ListUserPoliciesInput, func(*iam.ListUserPoliciesOutput, bool) bool, ...request.Option) error
+	ListGroupPoliciesPagesWithContext(aws.Context, *iam.ListGroupPoliciesInput, func(*iam.ListGroupPoliciesOutput, bool) bool, ...request.Option) error
+	ListRolePoliciesPagesWithContext(aws.Context, *iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool, ...request.Option) error
+	GetUserPolicyWithContext(aws.Context, *iam.GetUserPolicyInput, ...request.Option) (*iam.GetUserPolicyOutput, error)
+	GetGroupPolicyWithContext(aws.Context, *iam.GetGroupPolicyInput, ...request.Option) (*iam.GetGroupPolicyOutput, error)
+	GetRolePolicyWithContext(aws.Context, *iam.GetRolePolicyInput, ...request.Option) (*iam.GetRolePolicyOutput, error)
+	GetPolicyWithContext(aws.Context, *iam.GetPolicyInput, ...request.Option) (*iam.GetPolicyOutput, error)
+	GetPolicyVersionWithContext(aws.Context, *iam.GetPolicyVersionInput, ...request.Option) (*iam.GetPolicyVersionOutput, error)
+}
+
+// Analyze reports the identity of an AWS access key, when it was last used,
+// the IAM policies of its user or role, and the actions they allow by
+// service. The session token is only needed for temporary credentials.
+func Analyze(ctx context.Context, id, secret, sessionToken string) (*analyzer.Report, error) {
+	sess, err := session.NewSession(&aws.Config{
+		// IAM and the global STS endpoint are in us-east-1.
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials(id, secret, sessionToken),
+		HTTPClient:  common.SaneHttpClient(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return analyze(ctx, sts.New(sess), iam.New(sess), id)
+}
+
+// analysis collects the report of a key.
+type analysis struct {
+	iam    iamAPI
+	report *analyzer.Report
+	// documents are the policy documents that apply to the key.
+	documents []policyDocument
+}
+
+func analyze(ctx context.Context, stsClient stsAPI, iamClient iamAPI, id string) (*analyzer.Report, error) {
+	a := &analysis{iam: iamClient, report: &analyzer.Report{Analyzer: "AWS"}}
+	a.report.AddIdentity("Access Key ID", id)
+
+	// The last use is looked up first, since the other requests use the key.
+	lastUsed, lastUsedErr := a.lastUsed(ctx, id)
+
+	identity, err := stsClient.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get the identity of the key: %w", err)
+	}
+	arn := aws.StringValue(identity.Arn)
+	kind, name := principalOf(arn)
+	a.report.AddIdentity("Account", aws.StringValue(identity.Account))
+	a.report.AddIdentity("ARN", arn)
+	a.report.AddIdentity("User ID", aws.StringValue(identity.UserId))
+	a.report.AddIdentity("Principal", kind)
+	a.report.Identity = append(a.report.Identity, lastUsed...)
+	if lastUsedErr != nil {
+		a.report.AddNote("could not get when the key was last used: %s", errorCode(lastUsedErr))
+	}
+
+	switch kind {
+	case "user":
+		a.userPolicies(ctx, name)
+	case "role":
+		a.rolePolicies(ctx, name)
+		a.report.AddNote("the session policies of the role session aren't known, and can only restrict its permissions")
+	case "root":
+		a.report.AddNote("the key belongs to the root user of the account, which can do anything in it")
+	default:
+		a.report.AddNote("the policies of %s principals can't be listed", kind)
+	}
+
+	permissions, admin := summarize(a.documents)
+	a.report.Permissions = permissions
+	if admin {
+		a.report.AddNote("a policy allows every action on every resource: the key has administrator access")
+	}
+	a.report.AddNote("permission boundaries, service control policies and resource policies aren't taken into account")
+	return a.report, nil
+}
+
+// principalOf returns the kind of principal of an ARN returned by
+// GetCallerIdentity and the name of its user or role.
+func principalOf(arn string) (kind, name string) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		return "unknown", ""
+	}
+	resource := parts[5]
+	switch {
+	case resource == "root":
+		return "root", ""
+	case strings.HasPrefix(resource, "user/"):
+		// Users can have a path, which isn't part of their name.
+		return "user", resource[strings.LastIndex(resource, "/")+1:]
+	case strings.HasPrefix(resource, "assumed-role/"):
+		fields := strings.Split(resource, "/")
+		return "role", fields[1]
+	case strings.HasPrefix(resource, "federated-user/"):
+		return "federated user", strings.TrimPrefix(resource, "federated-user/")
+	default:
+		return "unknown", ""
+	}
+}
+
+// lastUsed returns when, where and for which service the key was last used.
+func (a *analysis) lastUsed(ctx context.Context, id string) ([]analyzer.Field, error) {
+	if strings.HasPrefix(id, "ASIA") {
+		// Temporary credentials don't have a last use.
+		return nil, nil
+	}
+	out, err := a.iam.GetAccessKeyLastUsedWithContext(ctx, &iam.GetAccessKeyLastUsedInput{AccessKeyId: aws.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	used := out.AccessKeyLastUsed
+	if used == nil || used.LastUsedDate == nil {
+		return []analyzer.Field{{Name: "Last Used", Value: "never"}}, nil
+	}
+	return []analyzer.Field{
+		{Name: "Last Used", Value: used.LastUsedDate.UTC().Format(time.RFC3339)},
+		{Name: "Last Used Service", Value: aws.StringValue(used.ServiceName)},
+		{Name: "Last Used Region", Value: aws.StringValue(used.Region)},
+	}, nil
+}
+
+// userPolicies adds the policies of a user and of its groups.
+func (a *analysis) userPolicies(ctx context.Context, user string) {
+	source := "user " + user
+	err := a.iam.ListAttachedUserPoliciesPagesWithContext(ctx, &iam.ListAttachedUserPoliciesInput{UserName: aws.String(user)},
+		func(page *iam.ListAttachedUserPoliciesOutput, _ bool) bool {
+			for _, p := range page.AttachedPolicies {
+				a.managedPolicy(ctx, p, source)
+			}
+			return true
+		})
+	a.noteListError(err, "the policies attached to "+source)
+
+	err = a.iam.ListUserPoliciesPagesWithContext(ctx, &iam.ListUserPoliciesInput{UserName: aws.String(user)},
+		func(page *iam.ListUserPoliciesOutput, _ bool) bool {
+			for _, name := range page.PolicyNames {
+				out, err := a.iam.GetUserPolicyWithContext(ctx, &iam.GetUserPolicyInput{UserName: aws.String(user), PolicyName: name})
+				var document string
+				if err == nil {
+					document = aws.StringValue(out.PolicyDocument)
+				}
+				a.inlinePolicy(aws.StringValue(name), source, document, err)
+			}
+			return true
+		})
+	a.noteListError(err, "the inline policies of "+source)
+
+	var groups []string
+	err = a.iam.ListGroupsForUserPagesWithContext(ctx, &iam.ListGroupsForUserInput{UserName: aws.String(user)},
+		func(page *iam.ListGroupsForUserOutput, _ bool) bool {
+			for _, g := range page.Groups {
+				groups = append(groups, aws.StringValue(g.GroupName))
+			}
+			return true
+		})
+	a.noteListError(err, "the groups of "+source)
+	for _, group := range groups {
+		a.groupPolicies(ctx, group)
+	}
+}
+
+// groupPolicies adds the policies of a group.
+func (a *analysis) groupPolicies(ctx context.Context, group string) {
+	source := "group " + group
+	err := a.iam.ListAttachedGroupPoliciesPagesWithContext(ctx, &iam.ListAttachedGroupPoliciesInput{GroupName: aws.String(group)},
+		func(page *iam.ListAttachedGroupPoliciesOutput, _ bool) bool {
+			for _, p := range page.AttachedPolicies {
+				a.managedPolicy(ctx, p, source)
+			}
+			return true
+		})
+	a.noteListError(err, "the policies attached to "+source)
+
+	err = a.iam.ListGroupPoliciesPagesWithContext(ctx, &iam.ListGroupPoliciesInput{GroupName: aws.String(group)},
+		func(page *iam.ListGroupPoliciesOutput, _ bool) bool {
+			for _, name := range page.PolicyNames {
+				out, err := a.iam.GetGroupPolicyWithContext(ctx, &iam.GetGroupPolicyInput{GroupName: aws.String(group), PolicyName: name})
+				var document string
+				if err == nil {
+					document = aws.StringValue(out.PolicyDocument)
+				}
+				a.inlinePolicy(aws.StringValue(name), source, document, err)
+			}
+			return true
+		})
+	a.noteListError(err, "the inline policies of "+source)
+}
+
+// rolePolicies adds the policies of a role.
+func (a *analysis) rolePolicies(ctx context.Context, role string) {
+	source := "role " + role
+	err := a.iam.ListAttachedRolePoliciesPagesWithContext(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(role)},
+		func(page *iam.ListAttachedRolePoliciesOutput, _ bool) bool {
+			for _, p := range page.AttachedPolicies {
+				a.managedPolicy(ctx, p, source)
+			}
+			return true
+		})
+	a.noteListError(err, "the policies attached to "+source)
+
+	err = a.iam.ListRolePoliciesPagesWithContext(ctx, &iam.ListRolePoliciesInput{RoleName: aws.String(role)},
+		func(page *iam.ListRolePoliciesOutput, _ bool) bool {
+			for _, name := range page.PolicyNames {
+				out, err := a.iam.GetRolePolicyWithContext(ctx, &iam.GetRolePolicyInput{RoleName: aws.String(role), PolicyName: name})
+				var document string
+				if err == nil {
+					document = aws.StringValue(out.PolicyDocument)
+				}
+				a.inlinePolicy(aws.StringValue(name), source, document, err)
+			}
+			return true
+		})
+	a.noteListError(err, "the inline policies of "+source)
+}
+
+// managedPolicy adds a managed policy attached to a user, group or role, with
+// the document of its default version.
+func (a *analysis) managedPolicy(ctx context.Context, attached *iam.AttachedPolicy, source string) {
+	name := aws.StringValue(attached.PolicyName)
+	a.report.Resources = append(a.report.Resources, analyzer.Resource{
+		Kind: "managed policy",
+		Name: name,
+		Attributes: []analyzer.Field{
+			{Name: "ARN", Value: aws.StringValue(attached.PolicyArn)},
+			{Name: "Attached To", Value: source},
+		},
+	})
+
+	policy, err := a.iam.GetPolicyWithContext(ctx, &iam.GetPolicyInput{PolicyArn: attached.PolicyArn})
+	if err != nil {
+		a.report.AddNote("could not get the managed policy %s: %s", name, errorCode(err))
+		return
+	}
+	version, err := a.iam.GetPolicyVersionWithContext(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: attached.PolicyArn,
+		VersionId: policy.Policy.DefaultVersionId,
+	})
+	if err != nil {
+		a.report.AddNote("could not get the document of the managed policy %s: %s", name, errorCode(err))
+		return
+	}
+	a.addDocument(name, aws.StringValue(version.PolicyVersion.Document))
+}
+
+// inlinePolicy adds an inline policy of a user, group or role.
+func (a *analysis) inlinePolicy(name, source, document string, err error) {
+	a.report.Resources = append(a.report.Resources, analyzer.Resource{
+		Kind:       "inline policy",
+		Name:       name,
+		Attributes: []analyzer.Field{{Name: "Attached To", Value: source}},
+	})
+	if err != nil {
+		a.report.AddNote("could not get the inline policy %s of %s: %s", name, source, errorCode(err))
+		return
+	}
+	a.addDocument(name, document)
+}
+
+func (a *analysis) addDocument(name, document string) {
+	doc, err := parsePolicy(document)
+	if err != nil {
+		a.report.AddNote("policy %s: %v", name, err)
+		return
+	}
+	a.documents = append(a.documents, doc)
+}
+
+func (a *analysis) noteListError(err error, what string) {
+	if err != nil {
+		a.report.AddNote("could not list %s: %s", what, errorCode(err))
+	}
+}
+
+// errorCode returns the code of AWS errors, like AccessDenied, which is
+// clearer than their full message.
+func errorCode(err error) string {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+	return err.Error()
+}
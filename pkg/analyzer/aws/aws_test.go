@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+type fakeSTS struct{ arn string }
+
+func (f fakeSTS) GetCallerIdentityWithContext(aws.Context, *sts.GetCallerIdentityInput, ...request.Option) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+		Arn:     aws.String(f.arn),
+		UserId:  aws.String("AIDAEXAMPLEUSERID"),
+	}, nil
+}
+
+// fakeIAM serves a user with a managed policy and a group with an inline
+// policy. The methods it doesn't override panic.
+type fakeIAM struct {
+	iamAPI
+}
+
+func (fakeIAM) GetAccessKeyLastUsedWithContext(aws.Context, *iam.GetAccessKeyLastUsedInput, ...request.Option) (*iam.GetAccessKeyLastUsedOutput, error) {
+	return &iam.GetAccessKeyLastUsedOutput{AccessKeyLastUsed: &iam.AccessKeyLastUsed{
+		LastUsedDate: aws.Time(time.Date(2023, 5, 17, 10, 30, 0, 0, time.UTC)),
+		ServiceName:  aws.String("s3"),
+		Region:       aws.String("us-west-2"),
+	}}, nil
+}
+
+func (fakeIAM) ListAttachedUserPoliciesPagesWithContext(_ aws.Context, _ *iam.ListAttachedUserPoliciesInput, fn func(*iam.ListAttachedUserPoliciesOutput, bool) bool, _ ...request.Option) error {
+	fn(&iam.ListAttachedUserPoliciesOutput{AttachedPolicies: []*iam.AttachedPolicy{{
+		PolicyName: aws.String("ReadS3"),
+		PolicyArn:  aws.String("arn:aws:iam::123456789012:policy/ReadS3"),
+	}}}, true)
+	return nil
+}
+
+func (fakeIAM) ListUserPoliciesPagesWithContext(aws.Context, *iam.ListUserPoliciesInput, func(*iam.ListUserPoliciesOutput, bool) bool, ...request.Option) error {
+	return awserr.New("AccessDenied", "not authorized to perform iam:ListUserPolicies", nil)
+}
+
+func (fakeIAM) ListGroupsForUserPagesWithContext(_ aws.Context, _ *iam.ListGroupsForUserInput, fn func(*iam.ListGroupsForUserOutput, bool) bool, _ ...request.Option) error {
+	fn(&iam.ListGroupsForUserOutput{Groups: []*iam.Group{{GroupName: aws.String("ops")}}}, true)
+	return nil
+}
+
+func (fakeIAM) ListAttachedGroupPoliciesPagesWithContext(aws.Context, *iam.ListAttachedGroupPoliciesInput, func(*iam.ListAttachedGroupPoliciesOutput, bool) bool, ...request.Option) error {
+	return nil
+}
+
+func (fakeIAM) ListGroupPoliciesPagesWithContext(_ aws.Context, _ *iam.ListGroupPoliciesInput, fn func(*iam.ListGroupPoliciesOutput, bool) bool, _ ...request.Option) error {
+	fn(&iam.ListGroupPoliciesOutput{PolicyNames: []*string{aws.String("ops-ec2")}}, true)
+	return nil
+}
+
+func (fakeIAM) GetGroupPolicyWithContext(aws.Context, *iam.GetGroupPolicyInput, ...request.Option) (*iam.GetGroupPolicyOutput, error) {
+	return &iam.GetGroupPolicyOutput{PolicyDocument: aws.String(url.PathEscape(`{"Statement":{"Effect":"Allow","Action":"ec2:*","Resource":"*"}}`))}, nil
+}
+
+func (fakeIAM) GetPolicyWithContext(aws.Context, *iam.GetPolicyInput, ...request.Option) (*iam.GetPolicyOutput, error) {
+	return &iam.GetPolicyOutput{Policy: &iam.Policy{DefaultVersionId: aws.String("v2")}}, nil
+}
+
+func (fakeIAM) GetPolicyVersionWithContext(_ aws.Context, input *iam.GetPolicyVersionInput, _ ...request.Option) (*iam.GetPolicyVersionOutput, error) {
+	document := `{"Statement":{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}}`
+	if aws.StringValue(input.VersionId) != "v2" {
+		document = `{"Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`
+	}
+	return &iam.GetPolicyVersionOutput{PolicyVersion: &iam.PolicyVersion{Document: aws.String(url.PathEscape(document))}}, nil
+}
+
+func TestAnalyzeUser(t *testing.T) {
+	report, err := analyze(context.Background(), fakeSTS{arn: "arn:aws:iam::123456789012:user/alice"}, fakeIAM{}, "AKIAEXAMPLEKEYID1234")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []analyzer.Field{
+		{Name: "Access Key ID", Value: "AKIAEXAMPLEKEYID1234"},
+		{Name: "Account", Value: "123456789012"},
+		{Name: "ARN", Value: "arn:aws:iam::123456789012:user/alice"},
+		{Name: "User ID", Value: "AIDAEXAMPLEUSERID"},
+		{Name: "Principal", Value: "user"},
+		{Name: "Last Used", Value: "2023-05-17T10:30:00Z"},
+		{Name: "Last Used Service", Value: "s3"},
+		{Name: "Last Used Region", Value: "us-west-2"},
+	}, report.Identity)
+	assert.Equal(t, []analyzer.Permission{
+		{Scope: "ec2", Allowed: []string{"*"}},
+		{Scope: "s3", Allowed: []string{"Get*"}},
+	}, report.Permissions)
+	assert.Equal(t, []analyzer.Resource{
+		{Kind: "managed policy", Name: "ReadS3", Attributes: []analyzer.Field{
+			{Name: "ARN", Value: "arn:aws:iam::123456789012:policy/ReadS3"},
+			{Name: "Attached To", Value: "user alice"},
+		}},
+		{Kind: "inline policy", Name: "ops-ec2", Attributes: []analyzer.Field{{Name: "Attached To", Value: "group ops"}}},
+	}, report.Resources)
+	assert.Contains(t, report.Notes, "could not list the inline policies of user alice: AccessDenied")
+}
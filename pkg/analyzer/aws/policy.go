@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+)
+
+// policyDocument is an IAM policy document.
+type policyDocument struct {
+	Statement statements
+}
+
+// statements are the statements of a policy document, which can be a single
+// statement or a list of them.
+type statements []statement
+
+func (s *statements) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		var st statement
+		if err := json.Unmarshal(data, &st); err != nil {
+			return err
+		}
+		*s = statements{st}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]statement)(s))
+}
+
+type statement struct {
+	Effect      string
+	Action      stringList
+	NotAction   stringList
+	Resource    stringList
+	NotResource stringList
+	Condition   json.RawMessage
+}
+
+// stringList is a string or a list of strings.
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*l = stringList{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// parsePolicy parses a policy document as returned by the IAM API, which
+// URL-encodes it.
+func parsePolicy(document string) (policyDocument, error) {
+	var doc policyDocument
+	decoded, err := url.PathUnescape(document)
+	if err != nil {
+		// Documents that aren't encoded are used as is.
+		decoded = document
+	}
+	if err := json.Unmarshal([]byte(decoded), &doc); err != nil {
+		return doc, fmt.Errorf("could not parse policy document: %w", err)
+	}
+	return doc, nil
+}
+
+// summarize summarizes the actions that the statements of policies allow and
+// deny, by service. Actions that only apply to some resources or under
+// conditions are marked, since whether they're allowed depends on the
+// request.
+func summarize(docs []policyDocument) (permissions []analyzer.Permission, admin bool) {
+	type access struct{ allowed, denied map[string]struct{} }
+	services := map[string]*access{}
+	add := func(service, action string, allow bool) {
+		a, ok := services[service]
+		if !ok {
+			a = &access{allowed: map[string]struct{}{}, denied: map[string]struct{}{}}
+			services[service] = a
+		}
+		if allow {
+			a.allowed[action] = struct{}{}
+		} else {
+			a.denied[action] = struct{}{}
+		}
+	}
+
+	for _, doc := range docs {
+		for _, st := range doc.Statement {
+			allow := strings.EqualFold(st.Effect, "Allow")
+			var qualifiers []string
+			if !(len(st.Resource) == 1 && st.Resource[0] == "*") {
+				qualifiers = append(qualifiers, "some resources")
+			}
+			if len(st.Condition) > 0 && string(st.Condition) != "null" {
+				qualifiers = append(qualifiers, "conditional")
+			}
+			suffix := ""
+			if len(qualifiers) > 0 {
+				suffix = " (" + strings.Join(qualifiers, ", ") + ")"
+			}
+
+			for _, action := range st.Action {
+				service, name, found := strings.Cut(action, ":")
+				if !found {
+					// A bare * is every action of every service.
+					service, name = "*", action
+				}
+				if allow && service == "*" && name == "*" && suffix == "" {
+					admin = true
+				}
+				add(strings.ToLower(service), name+suffix, allow)
+			}
+			if len(st.NotAction) > 0 {
+				add("*", "all actions except "+strings.Join(st.NotAction, ", ")+suffix, allow)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		permissions = append(permissions, analyzer.Permission{
+			Scope:   name,
+			Allowed: sortedKeys(services[name].allowed),
+			Denied:  sortedKeys(services[name].denied),
+		})
+	}
+	return permissions, admin
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
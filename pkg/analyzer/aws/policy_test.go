@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+)
+
+func TestParsePolicy(t *testing.T) {
+	// The IAM API URL-encodes documents.
+	doc, err := parsePolicy(url.PathEscape(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, statements{{Effect: "Allow", Action: stringList{"s3:GetObject"}, Resource: stringList{"*"}}}, doc.Statement)
+
+	doc, err = parsePolicy(`{"Statement":[{"Effect":"Deny","Action":["s3:DeleteBucket","ec2:*"],"Resource":["*"]}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, stringList{"s3:DeleteBucket", "ec2:*"}, doc.Statement[0].Action)
+
+	_, err = parsePolicy(`{"Statement":`)
+	assert.Error(t, err)
+}
+
+func TestSummarize(t *testing.T) {
+	var docs []policyDocument
+	for _, document := range []string{
+		`{"Statement":[
+			{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"},
+			{"Effect":"Allow","Action":"S3:ListBucket","Resource":"arn:aws:s3:::bucket"},
+			{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"}
+		]}`,
+		`{"Statement":{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":"us-east-1"}}}}`,
+		`{"Statement":{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}}`,
+	} {
+		doc, err := parsePolicy(document)
+		assert.NoError(t, err)
+		docs = append(docs, doc)
+	}
+
+	permissions, admin := summarize(docs)
+	assert.False(t, admin)
+	assert.Equal(t, []analyzer.Permission{
+		{Scope: "*", Allowed: []string{"all actions except iam:*"}},
+		{Scope: "ec2", Allowed: []string{"Describe* (conditional)"}},
+		{Scope: "s3", Allowed: []string{"GetObject", "ListBucket (some resources)", "PutObject"}, Denied: []string{"DeleteBucket"}},
+	}, permissions)
+
+	admins, err := parsePolicy(`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`)
+	assert.NoError(t, err)
+	_, admin = summarize([]policyDocument{admins})
+	assert.True(t, admin)
+}
+
+func TestPrincipalOf(t *testing.T) {
+	tests := []struct {
+		arn, kind, name string
+	}{
+		{"arn:aws:iam::123456789012:user/alice", "user", "alice"},
+		{"arn:aws:iam::123456789012:user/division/team/bob", "user", "bob"},
+		{"arn:aws:sts::123456789012:assumed-role/deploy/session", "role", "deploy"},
+		{"arn:aws:iam::123456789012:root", "root", ""},
+		{"arn:aws:sts::123456789012:federated-user/carol", "federated user", "carol"},
+		{"not an arn", "unknown", ""},
+	}
+	for _, tt := range tests {
+		kind, name := principalOf(tt.arn)
+		assert.Equal(t, tt.kind, kind, tt.arn)
+		assert.Equal(t, tt.name, name, tt.arn)
+	}
+}
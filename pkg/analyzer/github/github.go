@@ -0,0 +1,449 @@
+// Package github analyzes GitHub personal access tokens, and OAuth and GitHub
+// App tokens.
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// DefaultEndpoint is the API that tokens are analyzed against by default.
+const DefaultEndpoint = "https://api.github.com"
+
+// maxRepoPages limits the repositories that are listed, 100 per page.
+const maxRepoPages = 10
+
+var client = common.SaneHttpClient()
+
+// errInvalidToken is returned by requests with a token that GitHub doesn't
+// accept.
+var errInvalidToken = errors.New("the token is invalid, expired or revoked")
+
+// errNoAccess is returned by requests the token isn't allowed to make. GitHub
+// answers some of them with 404 Not Found to hide private resources.
+var errNoAccess = errors.New("the token doesn't have the permission to do it")
+
+// Types of tokens, from their prefixes.
+const (
+	classicToken      = "classic personal access token"
+	fineGrainedToken  = "fine-grained personal access token"
+	oauthToken        = "OAuth app token"
+	appUserToken      = "GitHub App user token"
+	installationToken = "GitHub App installation token"
+)
+
+var tokenPrefixes = []struct {
+	prefix, kind string
+}{
+	{"ghp_", classicToken},
+	{"github_pat_", fineGrainedToken},
+	{"gho_", oauthToken},
+	{"ghu_", appUserToken},
+	{"ghs_", installationToken},
+}
+
+// scopes describe the scopes of classic personal access tokens and OAuth app
+// tokens.
+var scopes = map[string]string{
+	"repo":                   "read and write all repositories the user can access, including private ones",
+	"repo:status":            "read and write commit statuses",
+	"repo_deployment":        "read and write deployments",
+	"public_repo":            "read and write public repositories",
+	"repo:invite":            "accept and decline invitations to repositories",
+	"security_events":        "read and write code scanning and secret scanning alerts",
+	"workflow":               "update GitHub Actions workflows",
+	"write:packages":         "publish packages",
+	"read:packages":          "download packages",
+	"delete:packages":        "delete packages",
+	"admin:org":              "manage organizations, their members and teams",
+	"write:org":              "read and write organization and team membership",
+	"read:org":               "read organization and team membership",
+	"admin:public_key":       "manage the SSH keys of the user",
+	"write:public_key":       "add SSH keys to the user",
+	"read:public_key":        "read the SSH keys of the user",
+	"admin:repo_hook":        "manage repository webhooks",
+	"write:repo_hook":        "read and write repository webhooks",
+	"read:repo_hook":         "read repository webhooks",
+	"admin:org_hook":         "manage organization webhooks",
+	"gist":                   "read and write gists",
+	"notifications":          "read and manage notifications",
+	"user":                   "read and write the profile of the user",
+	"read:user":              "read the profile of the user",
+	"user:email":             "read the email addresses of the user",
+	"user:follow":            "follow and unfollow users",
+	"project":                "read and write projects",
+	"read:project":           "read projects",
+	"delete_repo":            "delete repositories",
+	"admin:gpg_key":          "manage the GPG keys of the user",
+	"write:gpg_key":          "add GPG keys to the user",
+	"read:gpg_key":           "read the GPG keys of the user",
+	"admin:ssh_signing_key":  "manage the SSH signing keys of the user",
+	"codespace":              "create and manage codespaces",
+	"copilot":                "manage GitHub Copilot settings",
+	"admin:enterprise":       "manage enterprises",
+	"manage_runners:org":     "manage the self-hosted runners of organizations",
+	"manage_billing:copilot": "manage the Copilot billing of organizations",
+	"audit_log":              "read the audit log",
+}
+
+// repoProbes are read requests to a private repository that fine-grained
+// and GitHub App tokens need a repository permission for.
+var repoProbes = []struct {
+	permission, path string
+}{
+	{"contents", "/repos/%s/commits?per_page=1"},
+	{"issues", "/repos/%s/issues?per_page=1"},
+	{"pull_requests", "/repos/%s/pulls?per_page=1"},
+	{"actions", "/repos/%s/actions/runs?per_page=1"},
+	{"deployments", "/repos/%s/deployments?per_page=1"},
+	{"secrets", "/repos/%s/actions/secrets?per_page=1"},
+	{"variables", "/repos/%s/actions/variables?per_page=1"},
+	{"webhooks", "/repos/%s/hooks?per_page=1"},
+}
+
+// userProbes are read requests that fine-grained tokens need an account
+// permission for.
+var userProbes = []struct {
+	permission, path string
+}{
+	{"email_addresses", "/user/emails?per_page=1"},
+	{"git_ssh_keys", "/user/keys?per_page=1"},
+	{"gpg_keys", "/user/gpg_keys?per_page=1"},
+}
+
+type userRes struct {
+	Login     string `json:"login"`
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Type      string `json:"type"`
+	HTMLURL   string `json:"html_url"`
+	SiteAdmin bool   `json:"site_admin"`
+}
+
+type rateLimitRes struct {
+	Resources struct {
+		Core struct {
+			Limit     int   `json:"limit"`
+			Remaining int   `json:"remaining"`
+			Reset     int64 `json:"reset"`
+		} `json:"core"`
+	} `json:"resources"`
+}
+
+type membershipRes struct {
+	Role         string `json:"role"`
+	Organization struct {
+		Login string `json:"login"`
+	} `json:"organization"`
+}
+
+type repoRes struct {
+	FullName    string `json:"full_name"`
+	Private     bool   `json:"private"`
+	Visibility  string `json:"visibility"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin    bool `json:"admin"`
+		Maintain bool `json:"maintain"`
+		Push     bool `json:"push"`
+		Triage   bool `json:"triage"`
+		Pull     bool `json:"pull"`
+	} `json:"permissions"`
+}
+
+// Analyze reports the type and scopes or permissions of a token, its user,
+// its rate limit, and the organizations and repositories it can access. The
+// endpoint is https://api.github.com, or https://HOST/api/v3 for GitHub
+// Enterprise Server.
+func Analyze(ctx context.Context, token, endpoint string) (*analyzer.Report, error) {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	kind := tokenType(token)
+
+	report := &analyzer.Report{Analyzer: "GitHub"}
+	report.AddIdentity("Endpoint", endpoint)
+
+	// Installation tokens act as an app, not as a user.
+	if kind == installationToken {
+		var list struct {
+			Repositories []repoRes `json:"repositories"`
+		}
+		if _, err := get(ctx, token, endpoint+"/installation/repositories?per_page=100", &list); err != nil {
+			return nil, err
+		}
+		report.AddIdentity("Token Type", kind)
+		rateLimit(ctx, token, endpoint, report)
+		addRepos(report, list.Repositories, nil)
+		probeRepo(ctx, token, endpoint, list.Repositories, report)
+		return report, nil
+	}
+
+	var user userRes
+	res, err := get(ctx, token, endpoint+"/user", &user)
+	if err != nil {
+		return nil, err
+	}
+	// Classic personal access tokens and OAuth app tokens have scopes, and
+	// the header lists them.
+	_, listed := res.Header["X-Oauth-Scopes"]
+	classic := listed && kind != fineGrainedToken && kind != appUserToken
+	var tokenScopes []string
+	for _, scope := range strings.Split(res.Header.Get("X-OAuth-Scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			tokenScopes = append(tokenScopes, scope)
+		}
+	}
+	if kind == "" {
+		// Tokens created before 2021 have no prefix.
+		kind = "unknown"
+		if classic {
+			kind = classicToken
+		}
+	}
+
+	report.AddIdentity("Token Type", kind)
+	if classic {
+		report.AddIdentity("Scopes", strings.Join(tokenScopes, ", "))
+	}
+	expires := res.Header.Get("GitHub-Authentication-Token-Expiration")
+	if expires == "" {
+		expires = "never"
+	}
+	report.AddIdentity("Expires", expires)
+	report.AddIdentity("User", user.Login)
+	report.AddIdentity("User ID", strconv.Itoa(user.ID))
+	report.AddIdentity("Name", user.Name)
+	report.AddIdentity("Email", user.Email)
+	report.AddIdentity("Account Type", user.Type)
+	report.AddIdentity("User URL", user.HTMLURL)
+	if user.SiteAdmin {
+		report.AddIdentity("Site Administrator", "yes")
+		report.AddNote("the user of the token is an administrator of the GitHub Enterprise Server instance")
+	}
+	rateLimit(ctx, token, endpoint, report)
+
+	if classic {
+		for _, scope := range tokenScopes {
+			description, ok := scopes[scope]
+			if !ok {
+				description = "unknown scope"
+			}
+			report.Permissions = append(report.Permissions, analyzer.Permission{Scope: scope, Allowed: []string{description}})
+		}
+	} else {
+		probeUser(ctx, token, endpoint, report)
+	}
+
+	orgs(ctx, token, endpoint, report)
+	repos := listRepos(ctx, token, endpoint, report)
+	if classic {
+		addRepos(report, repos, tokenScopes)
+	} else {
+		addRepos(report, repos, nil)
+		probeRepo(ctx, token, endpoint, repos, report)
+	}
+	return report, nil
+}
+
+// tokenType returns the type of a token from its prefix, or "" if it has none.
+func tokenType(token string) string {
+	for _, p := range tokenPrefixes {
+		if strings.HasPrefix(token, p.prefix) {
+			return p.kind
+		}
+	}
+	return ""
+}
+
+// rateLimit adds the rate limit of the token. Tokens of the same user share
+// it, and its size tells apart users, apps and GitHub Enterprise Cloud.
+func rateLimit(ctx context.Context, token, endpoint string, report *analyzer.Report) {
+	var limit rateLimitRes
+	if _, err := get(ctx, token, endpoint+"/rate_limit", &limit); err != nil {
+		report.AddNote("could not read the rate limit of the token: %v", err)
+		return
+	}
+	core := limit.Resources.Core
+	report.AddIdentity("Rate Limit", fmt.Sprintf("%d of %d requests per hour remaining, resets at %s",
+		core.Remaining, core.Limit, time.Unix(core.Reset, 0).UTC().Format(time.RFC3339)))
+}
+
+// orgs adds the organizations the token's user is a member of, with their
+// role, and whether the token is authorized for their SAML SSO.
+func orgs(ctx context.Context, token, endpoint string, report *analyzer.Report) {
+	var memberships []membershipRes
+	if _, err := get(ctx, token, endpoint+"/user/memberships/orgs?state=active&per_page=100", &memberships); err != nil {
+		report.AddNote("could not list the organizations of the token: %v", err)
+		return
+	}
+	for _, m := range memberships {
+		resource := analyzer.Resource{Kind: "organization", Name: m.Organization.Login, Access: []string{m.Role}}
+		// Organizations that enforce SSO reject the requests of tokens that
+		// aren't authorized for it, and the header says so.
+		res, err := get(ctx, token, fmt.Sprintf("%s/orgs/%s/repos?per_page=1", endpoint, m.Organization.Login), nil)
+		switch {
+		case res != nil && strings.HasPrefix(res.Header.Get("X-GitHub-SSO"), "required"):
+			resource.Attributes = append(resource.Attributes, analyzer.Field{Name: "SSO", Value: "the token is not authorized"})
+		case err == nil:
+			resource.Attributes = append(resource.Attributes, analyzer.Field{Name: "SSO", Value: "the token is authorized, or SSO isn't enforced"})
+		}
+		report.Resources = append(report.Resources, resource)
+	}
+}
+
+// listRepos lists the repositories the token can access.
+func listRepos(ctx context.Context, token, endpoint string, report *analyzer.Report) []repoRes {
+	var repos []repoRes
+	for page := 1; page <= maxRepoPages; page++ {
+		var list []repoRes
+		res, err := get(ctx, token, fmt.Sprintf("%s/user/repos?per_page=100&page=%d", endpoint, page), &list)
+		if err != nil {
+			report.AddNote("could not list the repositories of the token: %v", err)
+			return repos
+		}
+		repos = append(repos, list...)
+		if page == 1 {
+			// The repositories of organizations that enforce SSO are left out
+			// if the token isn't authorized for it.
+			if sso := res.Header.Get("X-GitHub-SSO"); strings.HasPrefix(sso, "partial-results") {
+				_, ids, _ := strings.Cut(sso, "organizations=")
+				report.AddNote("the repositories of the organizations with IDs %s are not listed because the token is not authorized for their SSO", ids)
+			}
+		}
+		if !strings.Contains(res.Header.Get("Link"), `rel="next"`) {
+			return repos
+		}
+	}
+	report.AddNote("only the first %d repositories are listed", maxRepoPages*100)
+	return repos
+}
+
+// addRepos adds repositories with the roles of the user in them. Classic
+// tokens need the repo scope, or public_repo for public repositories, to do
+// more than read them.
+func addRepos(report *analyzer.Report, repos []repoRes, tokenScopes []string) {
+	for _, r := range repos {
+		write := tokenScopes == nil || has(tokenScopes, "repo") || (!r.Private && has(tokenScopes, "public_repo"))
+
+		var access []string
+		for _, role := range []struct {
+			name    string
+			granted bool
+			write   bool
+		}{
+			{"admin", r.Permissions.Admin, true},
+			{"maintain", r.Permissions.Maintain, true},
+			{"push", r.Permissions.Push, true},
+			{"triage", r.Permissions.Triage, true},
+			{"pull", r.Permissions.Pull, false},
+		} {
+			if role.granted && (write || !role.write) {
+				access = append(access, role.name)
+			}
+		}
+
+		visibility := r.Visibility
+		if visibility == "" {
+			visibility = "public"
+			if r.Private {
+				visibility = "private"
+			}
+		}
+		report.Resources = append(report.Resources, analyzer.Resource{
+			Kind:   "repository",
+			Name:   r.FullName,
+			Access: access,
+			Attributes: []analyzer.Field{
+				{Name: "Visibility", Value: visibility},
+				{Name: "URL", Value: r.HTMLURL},
+			},
+		})
+	}
+}
+
+// probeUser adds the account permissions of a token that has no scopes, by
+// trying read requests that need them.
+func probeUser(ctx context.Context, token, endpoint string, report *analyzer.Report) {
+	for _, p := range userProbes {
+		probe(ctx, token, endpoint+p.path, p.permission, report)
+	}
+}
+
+// probeRepo adds the repository permissions of a token that has no scopes,
+// by trying read requests that need them on a private repository. Public
+// repositories can be read without them. Write permissions aren't tried.
+func probeRepo(ctx context.Context, token, endpoint string, repos []repoRes, report *analyzer.Report) {
+	for _, r := range repos {
+		if !r.Private {
+			continue
+		}
+		for _, p := range repoProbes {
+			probe(ctx, token, endpoint+fmt.Sprintf(p.path, r.FullName), p.permission, report)
+		}
+		report.AddNote("the repository permissions were tried with read requests to %s; write permissions were not tried", r.FullName)
+		return
+	}
+	report.AddNote("the token can't access any private repository to try its repository permissions on")
+}
+
+func probe(ctx context.Context, token, url, permission string, report *analyzer.Report) {
+	res, err := get(ctx, token, url, nil)
+	switch {
+	// Empty repositories have no commits to list.
+	case err == nil, res != nil && res.StatusCode == http.StatusConflict:
+		report.Permissions = append(report.Permissions, analyzer.Permission{Scope: permission, Allowed: []string{"read"}})
+	case errors.Is(err, errNoAccess):
+	default:
+		report.AddNote("could not try the %s permission: %v", permission, err)
+	}
+}
+
+// get decodes the JSON response of a GET request to v, unless it's nil. The
+// response is returned with its headers even if the request failed.
+func get(ctx context.Context, token, url string, v any) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return res, errInvalidToken
+	case http.StatusForbidden, http.StatusNotFound:
+		return res, errNoAccess
+	default:
+		return res, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	if v == nil {
+		return res, nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return res, fmt.Errorf("could not decode the response: %w", err)
+	}
+	return res, nil
+}
+
+func has(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
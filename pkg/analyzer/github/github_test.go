@@ -0,0 +1,134 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const rateLimitBody = `{"resources":{"core":{"limit":5000,"remaining":4990,"reset":1684319400}}}`
+
+func TestAnalyzeClassic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ghp_valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/user":
+			w.Header().Set("X-OAuth-Scopes", "public_repo, read:org")
+			_, _ = w.Write([]byte(`{"login":"alice","id":7,"name":"Alice","type":"User","html_url":"https://github.com/alice"}`))
+		case "/rate_limit":
+			_, _ = w.Write([]byte(rateLimitBody))
+		case "/user/memberships/orgs":
+			_, _ = w.Write([]byte(`[{"role":"admin","organization":{"login":"acme"}},{"role":"member","organization":{"login":"corp"}}]`))
+		case "/orgs/acme/repos":
+			_, _ = w.Write([]byte(`[]`))
+		case "/orgs/corp/repos":
+			w.Header().Set("X-GitHub-SSO", "required; url=https://github.com/orgs/corp/sso")
+			w.WriteHeader(http.StatusForbidden)
+		case "/user/repos":
+			w.Header().Set("X-GitHub-SSO", "partial-results; organizations=21955855")
+			_, _ = w.Write([]byte(`[
+				{"full_name":"acme/app","private":true,"visibility":"private","html_url":"https://github.com/acme/app","permissions":{"admin":true,"maintain":true,"push":true,"triage":true,"pull":true}},
+				{"full_name":"alice/site","private":false,"visibility":"public","html_url":"https://github.com/alice/site","permissions":{"admin":true,"maintain":true,"push":true,"triage":true,"pull":true}}
+			]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	report, err := Analyze(context.Background(), "ghp_valid", server.URL+"/")
+	assert.NoError(t, err)
+	assert.Equal(t, []analyzer.Field{
+		{Name: "Endpoint", Value: server.URL},
+		{Name: "Token Type", Value: classicToken},
+		{Name: "Scopes", Value: "public_repo, read:org"},
+		{Name: "Expires", Value: "never"},
+		{Name: "User", Value: "alice"},
+		{Name: "User ID", Value: "7"},
+		{Name: "Name", Value: "Alice"},
+		{Name: "Account Type", Value: "User"},
+		{Name: "User URL", Value: "https://github.com/alice"},
+		{Name: "Rate Limit", Value: "4990 of 5000 requests per hour remaining, resets at 2023-05-17T10:30:00Z"},
+	}, report.Identity)
+	assert.Equal(t, []analyzer.Permission{
+		{Scope: "public_repo", Allowed: []string{scopes["public_repo"]}},
+		{Scope: "read:org", Allowed: []string{scopes["read:org"]}},
+	}, report.Permissions)
+	assert.Equal(t, []analyzer.Resource{
+		{Kind: "organization", Name: "acme", Access: []string{"admin"}, Attributes: []analyzer.Field{{Name: "SSO", Value: "the token is authorized, or SSO isn't enforced"}}},
+		{Kind: "organization", Name: "corp", Access: []string{"member"}, Attributes: []analyzer.Field{{Name: "SSO", Value: "the token is not authorized"}}},
+		// Without the repo scope, the token can only read private repositories.
+		{Kind: "repository", Name: "acme/app", Access: []string{"pull"}, Attributes: []analyzer.Field{
+			{Name: "Visibility", Value: "private"},
+			{Name: "URL", Value: "https://github.com/acme/app"},
+		}},
+		{Kind: "repository", Name: "alice/site", Access: []string{"admin", "maintain", "push", "triage", "pull"}, Attributes: []analyzer.Field{
+			{Name: "Visibility", Value: "public"},
+			{Name: "URL", Value: "https://github.com/alice/site"},
+		}},
+	}, report.Resources)
+	assert.Equal(t, []string{
+		"the repositories of the organizations with IDs 21955855 are not listed because the token is not authorized for their SSO",
+	}, report.Notes)
+
+	_, err = Analyze(context.Background(), "ghp_invalid", server.URL)
+	assert.ErrorIs(t, err, errInvalidToken)
+}
+
+func TestAnalyzeFineGrained(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user":
+			w.Header().Set("GitHub-Authentication-Token-Expiration", "2023-08-01 00:00:00 UTC")
+			_, _ = w.Write([]byte(`{"login":"alice","id":7,"type":"User"}`))
+		case "/rate_limit":
+			_, _ = w.Write([]byte(rateLimitBody))
+		case "/user/memberships/orgs":
+			w.WriteHeader(http.StatusForbidden)
+		case "/user/repos":
+			_, _ = w.Write([]byte(`[{"full_name":"alice/app","private":true,"permissions":{"admin":true,"push":true,"pull":true}}]`))
+		case "/user/emails", "/repos/alice/app/issues":
+			_, _ = w.Write([]byte(`[]`))
+		case "/repos/alice/app/commits":
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	report, err := Analyze(context.Background(), "github_pat_valid", server.URL)
+	assert.NoError(t, err)
+	assert.Contains(t, report.Identity, analyzer.Field{Name: "Token Type", Value: fineGrainedToken})
+	assert.Contains(t, report.Identity, analyzer.Field{Name: "Expires", Value: "2023-08-01 00:00:00 UTC"})
+	assert.Equal(t, []analyzer.Permission{
+		{Scope: "email_addresses", Allowed: []string{"read"}},
+		{Scope: "contents", Allowed: []string{"read"}},
+		{Scope: "issues", Allowed: []string{"read"}},
+	}, report.Permissions)
+	assert.Equal(t, []analyzer.Resource{
+		{Kind: "repository", Name: "alice/app", Access: []string{"admin", "push", "pull"}, Attributes: []analyzer.Field{
+			{Name: "Visibility", Value: "private"},
+			{Name: "URL", Value: ""},
+		}},
+	}, report.Resources)
+	assert.Equal(t, []string{
+		"could not list the organizations of the token: " + errNoAccess.Error(),
+		"the repository permissions were tried with read requests to alice/app; write permissions were not tried",
+	}, report.Notes)
+}
+
+func TestTokenType(t *testing.T) {
+	assert.Equal(t, classicToken, tokenType("ghp_abc"))
+	assert.Equal(t, fineGrainedToken, tokenType("github_pat_abc"))
+	assert.Equal(t, installationToken, tokenType("ghs_abc"))
+	assert.Equal(t, "", tokenType("0123456789abcdef0123456789abcdef01234567"))
+}
@@ -0,0 +1,261 @@
+// Package gitlab analyzes GitLab personal, project and group access tokens.
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// DefaultURL is the instance that tokens are analyzed against by default.
+const DefaultURL = "https://gitlab.com"
+
+// maxProjectPages limits the projects that are listed, 100 per page.
+const maxProjectPages = 10
+
+var client = common.SaneHttpClient()
+
+// errInvalidToken is returned by requests with a token that the instance
+// doesn't accept.
+var errInvalidToken = errors.New("the token is invalid, expired or revoked")
+
+// scopes describe the scopes of tokens.
+var scopes = map[string]string{
+	"api":              "read and write everything the user can, including the API, repositories and registries",
+	"read_api":         "read everything the user can through the API",
+	"read_user":        "read the profile of the user",
+	"read_repository":  "pull the repositories the user can access",
+	"write_repository": "push to the repositories the user can push to",
+	"read_registry":    "pull the container images the user can access",
+	"write_registry":   "push container images",
+	"sudo":             "act as any user, for administrators",
+	"admin_mode":       "use the API as an administrator in admin mode",
+	"create_runner":    "create runners",
+	"k8s_proxy":        "use the Kubernetes agent proxy",
+	"ai_features":      "use the AI features",
+}
+
+// Access levels of project members.
+const (
+	guest      = 10
+	reporter   = 20
+	developer  = 30
+	maintainer = 40
+	owner      = 50
+)
+
+var accessLevels = map[int]string{
+	guest:      "guest",
+	reporter:   "reporter",
+	developer:  "developer",
+	maintainer: "maintainer",
+	owner:      "owner",
+}
+
+// botUserPat matches the usernames of the bot users GitLab creates for
+// project and group access tokens.
+var botUserPat = regexp.MustCompile(`^(project|group)_\d+_bot`)
+
+type tokenRes struct {
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  string   `json:"expires_at"`
+	CreatedAt  string   `json:"created_at"`
+	LastUsedAt string   `json:"last_used_at"`
+}
+
+type userRes struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	IsAdmin  bool   `json:"is_admin"`
+	WebURL   string `json:"web_url"`
+}
+
+type projectRes struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+	Visibility        string `json:"visibility"`
+	WebURL            string `json:"web_url"`
+	Permissions       struct {
+		ProjectAccess *struct {
+			AccessLevel int `json:"access_level"`
+		} `json:"project_access"`
+		GroupAccess *struct {
+			AccessLevel int `json:"access_level"`
+		} `json:"group_access"`
+	} `json:"permissions"`
+}
+
+// Analyze reports the scopes of a token, its user, and the projects it can
+// access with what it can do in them. The token is tried against each
+// instance until one accepts it, like the detector's verifier URLs.
+func Analyze(ctx context.Context, token string, urls []string) (*analyzer.Report, error) {
+	var errs []error
+	for _, baseURL := range urls {
+		baseURL = strings.TrimSuffix(baseURL, "/")
+		report, err := analyze(ctx, token, baseURL)
+		if err == nil {
+			return report, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", baseURL, err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+func analyze(ctx context.Context, token, baseURL string) (*analyzer.Report, error) {
+	// Any token can read its own details, whatever its scopes.
+	var info tokenRes
+	if _, err := get(ctx, token, baseURL+"/api/v4/personal_access_tokens/self", &info); err != nil {
+		return nil, err
+	}
+	var user userRes
+	_, userErr := get(ctx, token, baseURL+"/api/v4/user", &user)
+
+	report := &analyzer.Report{Analyzer: "GitLab"}
+	report.AddIdentity("URL", baseURL)
+	report.AddIdentity("Token Name", info.Name)
+	if userErr == nil {
+		report.AddIdentity("Token Type", tokenType(user.Username))
+	}
+	report.AddIdentity("Scopes", strings.Join(info.Scopes, ", "))
+	report.AddIdentity("Created", info.CreatedAt)
+	expires := info.ExpiresAt
+	if expires == "" {
+		expires = "never"
+	}
+	report.AddIdentity("Expires", expires)
+	report.AddIdentity("Last Used", info.LastUsedAt)
+	if userErr != nil {
+		report.AddNote("could not read the user of the token: %v", userErr)
+	} else {
+		report.AddIdentity("User", user.Username)
+		report.AddIdentity("User ID", strconv.Itoa(user.ID))
+		report.AddIdentity("Name", user.Name)
+		report.AddIdentity("Email", user.Email)
+		report.AddIdentity("User URL", user.WebURL)
+		if user.IsAdmin {
+			report.AddIdentity("Administrator", "yes")
+			report.AddNote("the user of the token is an administrator of the instance")
+		}
+	}
+
+	for _, scope := range info.Scopes {
+		description, ok := scopes[scope]
+		if !ok {
+			description = "unknown scope"
+		}
+		report.Permissions = append(report.Permissions, analyzer.Permission{Scope: scope, Allowed: []string{description}})
+	}
+
+	projects(ctx, token, baseURL, info.Scopes, report)
+	return report, nil
+}
+
+// tokenType returns whether a token is a personal, project, or group access
+// token from the username of its user.
+func tokenType(username string) string {
+	if match := botUserPat.FindStringSubmatch(username); match != nil {
+		return match[1]
+	}
+	return "personal"
+}
+
+// projects adds the projects the token's user is a member of. What the token
+// can do in them is inferred from the access level of the user and the
+// scopes of the token. Protected branches and variables may restrict it
+// further.
+func projects(ctx context.Context, token, baseURL string, tokenScopes []string, report *analyzer.Report) {
+	write := has(tokenScopes, "api") || has(tokenScopes, "write_repository")
+	admin := has(tokenScopes, "api")
+	readAPI := has(tokenScopes, "api") || has(tokenScopes, "read_api")
+
+	for page := 1; page <= maxProjectPages; page++ {
+		var list []projectRes
+		res, err := get(ctx, token, fmt.Sprintf("%s/api/v4/projects?membership=true&per_page=100&page=%d", baseURL, page), &list)
+		if err != nil {
+			report.AddNote("could not list the projects of the token: %v", err)
+			return
+		}
+		for _, p := range list {
+			level := 0
+			if p.Permissions.ProjectAccess != nil {
+				level = p.Permissions.ProjectAccess.AccessLevel
+			}
+			if p.Permissions.GroupAccess != nil && p.Permissions.GroupAccess.AccessLevel > level {
+				level = p.Permissions.GroupAccess.AccessLevel
+			}
+
+			var access []string
+			if name, ok := accessLevels[level]; ok {
+				access = append(access, name)
+			}
+			if write && level >= developer {
+				access = append(access, "push")
+			}
+			if admin && level >= maintainer {
+				access = append(access, "admin")
+			}
+			if readAPI && level >= maintainer {
+				access = append(access, "read CI variables")
+			}
+			report.Resources = append(report.Resources, analyzer.Resource{
+				Kind:   "project",
+				Name:   p.PathWithNamespace,
+				Access: access,
+				Attributes: []analyzer.Field{
+					{Name: "Visibility", Value: p.Visibility},
+					{Name: "URL", Value: p.WebURL},
+				},
+			})
+		}
+		if res.Header.Get("X-Next-Page") == "" {
+			return
+		}
+	}
+	report.AddNote("only the first %d projects are listed", maxProjectPages*100)
+}
+
+// get decodes the JSON response of a GET request to v.
+func get(ctx context.Context, token, url string, v any) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return nil, errInvalidToken
+	case http.StatusForbidden:
+		return nil, errors.New("the token doesn't have the scope to do it")
+	default:
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return nil, fmt.Errorf("could not decode the response: %w", err)
+	}
+	return res, nil
+}
+
+func has(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
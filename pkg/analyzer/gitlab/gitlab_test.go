@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+func TestAnalyze(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer glpat-valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v4/personal_access_tokens/self":
+			_, _ = w.Write([]byte(`{"name":"ci","scopes":["read_api","write_repository"],"created_at":"2023-01-01T00:00:00Z","expires_at":null}`))
+		case "/api/v4/user":
+			_, _ = w.Write([]byte(`{"id":7,"username":"alice","name":"Alice","web_url":"https://gitlab.example.com/alice"}`))
+		case "/api/v4/projects":
+			if r.URL.Query().Get("page") == "1" {
+				w.Header().Set("X-Next-Page", "2")
+				_, _ = w.Write([]byte(`[{"path_with_namespace":"org/app","visibility":"private","web_url":"https://gitlab.example.com/org/app","permissions":{"project_access":{"access_level":30},"group_access":{"access_level":40}}}]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[{"path_with_namespace":"org/docs","visibility":"public","web_url":"https://gitlab.example.com/org/docs","permissions":{"project_access":{"access_level":20},"group_access":null}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// The token is tried against each instance until one accepts it.
+	unused := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unused.Close()
+
+	report, err := Analyze(context.Background(), "glpat-valid", []string{unused.URL, server.URL + "/"})
+	assert.NoError(t, err)
+	assert.Equal(t, []analyzer.Field{
+		{Name: "URL", Value: server.URL},
+		{Name: "Token Name", Value: "ci"},
+		{Name: "Token Type", Value: "personal"},
+		{Name: "Scopes", Value: "read_api, write_repository"},
+		{Name: "Created", Value: "2023-01-01T00:00:00Z"},
+		{Name: "Expires", Value: "never"},
+		{Name: "User", Value: "alice"},
+		{Name: "User ID", Value: "7"},
+		{Name: "Name", Value: "Alice"},
+		{Name: "User URL", Value: "https://gitlab.example.com/alice"},
+	}, report.Identity)
+	assert.Equal(t, []analyzer.Permission{
+		{Scope: "read_api", Allowed: []string{scopes["read_api"]}},
+		{Scope: "write_repository", Allowed: []string{scopes["write_repository"]}},
+	}, report.Permissions)
+	assert.Equal(t, []analyzer.Resource{
+		{Kind: "project", Name: "org/app", Access: []string{"maintainer", "push", "read CI variables"}, Attributes: []analyzer.Field{
+			{Name: "Visibility", Value: "private"},
+			{Name: "URL", Value: "https://gitlab.example.com/org/app"},
+		}},
+		{Kind: "project", Name: "org/docs", Access: []string{"reporter"}, Attributes: []analyzer.Field{
+			{Name: "Visibility", Value: "public"},
+			{Name: "URL", Value: "https://gitlab.example.com/org/docs"},
+		}},
+	}, report.Resources)
+	assert.Empty(t, report.Notes)
+
+	_, err = Analyze(context.Background(), "glpat-invalid", []string{server.URL})
+	assert.ErrorIs(t, err, errInvalidToken)
+}
+
+func TestTokenType(t *testing.T) {
+	assert.Equal(t, "project", tokenType("project_42_bot_5f3a"))
+	assert.Equal(t, "group", tokenType("group_7_bot"))
+	assert.Equal(t, "personal", tokenType("alice"))
+}
@@ -0,0 +1,150 @@
+// Package codeowners finds the owners of files from the CODEOWNERS file of a
+// repository, in the syntax of GitHub or GitLab.
+package codeowners
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Locations are the paths a repository's CODEOWNERS file can have, in the
+// order they are looked up.
+var Locations = []string{".github/CODEOWNERS", "CODEOWNERS", ".gitlab/CODEOWNERS", "docs/CODEOWNERS"}
+
+// File is a parsed CODEOWNERS file.
+type File struct {
+	// GitHub files have a single section. GitLab files can have more, and a
+	// file gets the owners of every section that has a rule matching it.
+	sections []section
+}
+
+type section struct {
+	rules []rule
+}
+
+type rule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+// sectionPat matches the headers of GitLab sections, e.g. "[Docs] @docs" or
+// "^[Optional][2]", with their default owners.
+var sectionPat = regexp.MustCompile(`^\^?\[[^\]]+\](?:\[\d+\])?(.*)$`)
+
+// Find reads the first CODEOWNERS file of the repository at Locations.
+func Find(read func(name string) ([]byte, error)) (*File, error) {
+	for _, name := range Locations {
+		if data, err := read(name); err == nil {
+			return Parse(data), nil
+		}
+	}
+	return nil, errors.New("the repository has no CODEOWNERS file")
+}
+
+// Parse parses a CODEOWNERS file.
+func Parse(data []byte) *File {
+	f := &File{sections: []section{{}}}
+	var defaults []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if match := sectionPat.FindStringSubmatch(line); match != nil {
+			f.sections = append(f.sections, section{})
+			defaults = ownersOf(strings.Fields(match[1]))
+			continue
+		}
+
+		fields := strings.Fields(line)
+		owners := ownersOf(fields[1:])
+		if len(owners) == 0 {
+			owners = defaults
+		}
+		current := &f.sections[len(f.sections)-1]
+		current.rules = append(current.rules, rule{pattern: compile(fields[0]), owners: owners})
+	}
+	return f
+}
+
+// ownersOf returns the owners of a rule, up to a comment.
+func ownersOf(fields []string) []string {
+	var owners []string
+	for _, field := range fields {
+		if strings.HasPrefix(field, "#") {
+			break
+		}
+		owners = append(owners, field)
+	}
+	return owners
+}
+
+// Owners returns the owners of the file at a path relative to the root of
+// the repository. The last rule of a section that matches the path wins.
+func (f *File) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	var owners []string
+	seen := map[string]bool{}
+	for _, s := range f.sections {
+		for i := len(s.rules) - 1; i >= 0; i-- {
+			if !s.rules[i].pattern.MatchString(path) {
+				continue
+			}
+			for _, owner := range s.rules[i].owners {
+				if !seen[owner] {
+					seen[owner] = true
+					owners = append(owners, owner)
+				}
+			}
+			break
+		}
+	}
+	return owners
+}
+
+// compile compiles a pattern with the rules of gitignore that CODEOWNERS
+// files use: patterns with a slash before their end are relative to the
+// root, other patterns match at any depth, and patterns matching a directory
+// match the files in it. Like on GitHub, dir/* only matches the files
+// directly in dir.
+func compile(pattern string) *regexp.Regexp {
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+		case pattern[i] == '?':
+			b.WriteString("[^/]")
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			i++
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.HasSuffix(pattern, "/*"):
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.MustCompile(b.String())
+}
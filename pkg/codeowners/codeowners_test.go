@@ -0,0 +1,74 @@
+package codeowners
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnersGitHub(t *testing.T) {
+	f := Parse([]byte(`
+# Default owners.
+*       @org/everyone
+*.js    @org/frontend # inline comment
+/build/logs/ @org/ops
+docs/*  docs@example.com
+apps/   @octocat
+**/secrets/** @org/security
+`))
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@org/everyone"}},
+		{"web/app.js", []string{"@org/frontend"}},
+		{"build/logs/out.txt", []string{"@org/ops"}},
+		{"src/build/logs/out.txt", []string{"@org/everyone"}},
+		{"docs/getting-started.md", []string{"docs@example.com"}},
+		{"docs/build-app/troubleshooting.md", []string{"@org/everyone"}},
+		{"apps/main.go", []string{"@octocat"}},
+		{"services/apps/main.go", []string{"@octocat"}},
+		{"deploy/secrets/prod/key.pem", []string{"@org/security"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, f.Owners(tt.path), tt.path)
+	}
+}
+
+func TestOwnersGitLabSections(t *testing.T) {
+	f := Parse([]byte(`
+* @admins
+
+[Backend] @backend
+internal/
+api/ @api-team
+
+^[Docs][2] @docs
+*.md
+`))
+
+	assert.Equal(t, []string{"@admins", "@backend"}, f.Owners("internal/db/config.go"))
+	assert.Equal(t, []string{"@admins", "@api-team", "@docs"}, f.Owners("api/README.md"))
+	assert.Equal(t, []string{"@admins"}, f.Owners("main.go"))
+}
+
+func TestFind(t *testing.T) {
+	files := map[string]string{
+		"docs/CODEOWNERS": "* @docs",
+		"CODEOWNERS":      "* @root",
+	}
+	read := func(name string) ([]byte, error) {
+		if data, ok := files[name]; ok {
+			return []byte(data), nil
+		}
+		return nil, errors.New("not found")
+	}
+	f, err := Find(read)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"@root"}, f.Owners("main.go"))
+
+	_, err = Find(func(string) ([]byte, error) { return nil, errors.New("not found") })
+	assert.Error(t, err)
+}
@@ -10,3 +10,17 @@ func IsDone(ctx context.Context) bool {
 		return false
 	}
 }
+
+type detectorNameKey struct{}
+
+// WithDetectorName returns a copy of ctx annotated with the name of the
+// detector that will make requests with it.
+func WithDetectorName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, detectorNameKey{}, name)
+}
+
+// DetectorName returns the detector name stored in ctx, if any.
+func DetectorName(ctx context.Context) string {
+	name, _ := ctx.Value(detectorNameKey{}).(string)
+	return name
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrVerificationDryRun is returned for verification requests that weren't
+// sent because verification is running in dry run mode.
+var ErrVerificationDryRun = errors.New("verification dry run: request not sent")
+
+var (
+	verificationDryRun atomic.Bool
+
+	planMu sync.Mutex
+	// plan counts the requests that would have been sent during a dry run.
+	plan = map[PlannedRequest]int{}
+)
+
+// SetVerificationDryRun enables or disables verification dry run mode. In
+// dry run mode verification requests are recorded instead of being sent, so
+// no secrets leave the machine.
+func SetVerificationDryRun(enabled bool) {
+	verificationDryRun.Store(enabled)
+}
+
+// VerificationDryRun reports whether verification is running in dry run mode.
+func VerificationDryRun() bool {
+	return verificationDryRun.Load()
+}
+
+// PlannedRequest is a verification request that would have been sent.
+type PlannedRequest struct {
+	Detector string
+	Method   string
+	// Endpoint is the request URL without its query string.
+	Endpoint string
+}
+
+// VerificationPlan returns every request recorded during a dry run along
+// with how many times it would have been sent.
+func VerificationPlan() map[PlannedRequest]int {
+	planMu.Lock()
+	defer planMu.Unlock()
+	copied := make(map[PlannedRequest]int, len(plan))
+	for k, v := range plan {
+		copied[k] = v
+	}
+	return copied
+}
+
+// PlannedRequests collects the requests recorded for a single context.
+type PlannedRequests struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns the recorded requests in the order they were made.
+func (p *PlannedRequests) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+func (p *PlannedRequests) add(r PlannedRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, r)
+}
+
+type plannedRequestsKey struct{}
+
+// WithPlannedRequests returns a copy of ctx that records the requests planned
+// with it during a dry run in p.
+func WithPlannedRequests(ctx context.Context, p *PlannedRequests) context.Context {
+	return context.WithValue(ctx, plannedRequestsKey{}, p)
+}
+
+// DryRunTransport records requests instead of sending them while
+// verification is running in dry run mode.
+type DryRunTransport struct {
+	T http.RoundTripper
+}
+
+func NewDryRunTransport(T http.RoundTripper) *DryRunTransport {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &DryRunTransport{T}
+}
+
+func (t *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !VerificationDryRun() {
+		return t.T.RoundTrip(req)
+	}
+
+	planRequest(req.Context(), req.Method, req.URL)
+	return nil, ErrVerificationDryRun
+}
+
+// planRequest records a request that would have been sent to endpoint.
+func planRequest(ctx context.Context, method string, endpoint *url.URL) {
+	stripped := *endpoint
+	stripped.RawQuery = ""
+	stripped.User = nil
+	planned := PlannedRequest{
+		Detector: DetectorName(ctx),
+		Method:   method,
+		Endpoint: stripped.String(),
+	}
+
+	planMu.Lock()
+	plan[planned]++
+	planMu.Unlock()
+	if p, ok := ctx.Value(plannedRequestsKey{}).(*PlannedRequests); ok {
+		p.add(planned)
+	}
+}
+
+// CheckVerificationConnection applies dry run mode and the verification
+// allowlist to detectors that verify secrets over protocols other than HTTP.
+// Call it before connecting to endpoint; if it returns an error the
+// connection must not be made.
+func CheckVerificationConnection(ctx context.Context, endpoint *url.URL) error {
+	if err := checkEgress(ctx, endpoint.Hostname()); err != nil {
+		return err
+	}
+	if VerificationDryRun() {
+		planRequest(ctx, "CONNECT", endpoint)
+		return ErrVerificationDryRun
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	stdcontext "context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// ErrEgressBlocked is returned for verification requests to hosts that aren't
+// in the verification allowlist.
+var ErrEgressBlocked = errors.New("verification host is not in the allowlist")
+
+var (
+	egressMu sync.RWMutex
+	// egressAllowlist holds the lowercase hosts verification requests may be
+	// sent to. A nil allowlist allows every host.
+	egressAllowlist []string
+	// egressBlocked counts the verification requests that were blocked.
+	egressBlocked uint64
+)
+
+// SetVerificationAllowlist restricts verification requests to the given
+// hosts. Entries may be an exact host ("api.github.com") or a wildcard
+// matching a domain and its subdomains ("*.gitlab.com"). A nil allowlist
+// allows every host.
+func SetVerificationAllowlist(hosts []string) {
+	egressMu.Lock()
+	defer egressMu.Unlock()
+	if hosts == nil {
+		egressAllowlist = nil
+		return
+	}
+	egressAllowlist = make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		egressAllowlist = append(egressAllowlist, strings.ToLower(strings.TrimSpace(host)))
+	}
+}
+
+// BlockedVerificationRequests returns the number of verification requests
+// blocked by the allowlist.
+func BlockedVerificationRequests() uint64 {
+	return atomic.LoadUint64(&egressBlocked)
+}
+
+// hostAllowed reports whether verification requests may be sent to host.
+func hostAllowed(host string) bool {
+	egressMu.RLock()
+	defer egressMu.RUnlock()
+	if egressAllowlist == nil {
+		return true
+	}
+	host = strings.ToLower(host)
+	for _, allowed := range egressAllowlist {
+		if domain, ok := strings.CutPrefix(allowed, "*."); ok {
+			if host == domain || strings.HasSuffix(host, "."+domain) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowlistTransport blocks requests to hosts that aren't in the
+// verification allowlist.
+type AllowlistTransport struct {
+	T http.RoundTripper
+}
+
+func NewAllowlistTransport(T http.RoundTripper) *AllowlistTransport {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &AllowlistTransport{T}
+}
+
+func (t *AllowlistTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := checkEgress(req.Context(), req.URL.Hostname()); err != nil {
+		return nil, err
+	}
+	return t.T.RoundTrip(req)
+}
+
+// checkEgress returns ErrEgressBlocked if verification requests may not be
+// sent to host.
+func checkEgress(ctx stdcontext.Context, host string) error {
+	if hostAllowed(host) {
+		return nil
+	}
+	atomic.AddUint64(&egressBlocked, 1)
+	context.AddLogger(ctx).Logger().V(2).Info("blocked verification request",
+		"host", host,
+		"detector", DetectorName(ctx),
+	)
+	return fmt.Errorf("%w: %s", ErrEgressBlocked, host)
+}
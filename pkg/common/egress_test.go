@@ -0,0 +1,175 @@
+package common
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestHostAllowed(t *testing.T) {
+	defer SetVerificationAllowlist(nil)
+
+	if !hostAllowed("api.github.com") {
+		t.Errorf("expected every host to be allowed without an allowlist")
+	}
+
+	SetVerificationAllowlist([]string{"API.github.com", " *.gitlab.com", "*example.com"})
+	tests := map[string]bool{
+		"api.github.com":         true,
+		"API.GITHUB.COM":         true,
+		"github.com":             false,
+		"gitlab.com":             true,
+		"custom.gitlab.com":      true,
+		"a.b.gitlab.com":         true,
+		"evilgitlab.com":         false,
+		"gitlab.com.attacker.io": false,
+		// Only "*." makes a wildcard.
+		"example.com":     false,
+		"evilexample.com": false,
+	}
+	for host, want := range tests {
+		if got := hostAllowed(host); got != want {
+			t.Errorf("%s: got %t, want %t", host, got, want)
+		}
+	}
+}
+
+func TestAllowlistTransport(t *testing.T) {
+	defer SetVerificationAllowlist(nil)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	SetVerificationAllowlist([]string{"api.github.com"})
+	blocked := BlockedVerificationRequests()
+	_, err := SaneHttpClient().Get(server.URL)
+	if !errors.Is(err, ErrEgressBlocked) {
+		t.Errorf("expected ErrEgressBlocked, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("expected the request to be blocked")
+	}
+	if got := BlockedVerificationRequests(); got != blocked+1 {
+		t.Errorf("blocked requests: got %d, want %d", got, blocked+1)
+	}
+
+	SetVerificationAllowlist([]string{"127.0.0.1"})
+	res, err := SaneHttpClient().Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if requests != 1 {
+		t.Errorf("expected the request to be sent")
+	}
+}
+
+// bypassingCalls are the functions that send requests without the egress
+// allowlist and dry run mode, by import path.
+var bypassingCalls = map[string][]string{
+	"net/http": {"DefaultClient", "Get", "Head", "Post", "PostForm"},
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common": {"RetryableHttpClient", "RetryableHttpClientTimeout", "PinnedRetryableHttpClient"},
+}
+
+// connectingCalls are the functions that open connections, which need a
+// CheckVerificationConnection call in the same file, by import path.
+var connectingCalls = map[string][]string{
+	"net":                               {"Dial", "DialTimeout"},
+	"crypto/tls":                        {"Dial", "DialWithDialer"},
+	"database/sql":                      {"Open"},
+	"github.com/go-ldap/ldap/v3":        {"Dial", "DialURL"},
+	"github.com/go-redis/redis":         {"NewClient"},
+	"github.com/jlaffaye/ftp":           {"Dial"},
+	"github.com/rabbitmq/amqp091-go":    {"Dial", "DialConfig", "DialTLS"},
+	"go.mongodb.org/mongo-driver/mongo": {"Connect"},
+	"golang.org/x/crypto/ssh":           {"Dial"},
+}
+
+// TestDetectorsUseVerificationEgress fails when a detector verifies secrets
+// without going through the egress allowlist and dry run mode, i.e. with an
+// HTTP client other than SaneHttpClient's or a connection that isn't checked
+// with CheckVerificationConnection first.
+func TestDetectorsUseVerificationEgress(t *testing.T) {
+	fset := token.NewFileSet()
+	err := filepath.WalkDir("../detectors", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		imports := map[string]string{}
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := importPath[strings.LastIndex(importPath, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+		var connections []token.Pos
+		checked := false
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CompositeLit:
+				if sel, ok := n.Type.(*ast.SelectorExpr); ok && selects(imports, sel, "net/http", "Client") {
+					t.Errorf("%s: verification must use common.SaneHttpClient instead of an http.Client", fset.Position(n.Pos()))
+				}
+			case *ast.SelectorExpr:
+				if n.Sel.Name == "DialContext" {
+					connections = append(connections, n.Pos())
+				}
+				if selects(imports, n, "github.com/trufflesecurity/trufflehog/v3/pkg/common", "CheckVerificationConnection") {
+					checked = true
+				}
+				for importPath, names := range bypassingCalls {
+					if selects(imports, n, importPath, names...) {
+						t.Errorf("%s: verification must use common.SaneHttpClient instead of %s.%s", fset.Position(n.Pos()), importPath, n.Sel.Name)
+					}
+				}
+				for importPath, names := range connectingCalls {
+					if selects(imports, n, importPath, names...) {
+						connections = append(connections, n.Pos())
+					}
+				}
+			}
+			return true
+		})
+		if !checked {
+			for _, pos := range connections {
+				t.Errorf("%s: connections must be checked with common.CheckVerificationConnection first", fset.Position(pos))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// selects reports whether sel selects one of the names of the package with
+// the import path.
+func selects(imports map[string]string, sel *ast.SelectorExpr, importPath string, names ...string) bool {
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || imports[pkg.Name] != importPath {
+		return false
+	}
+	for _, name := range names {
+		if sel.Sel.Name == name {
+			return true
+		}
+	}
+	return false
+}
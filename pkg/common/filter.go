@@ -5,15 +5,24 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 )
 
+// Filter selects the files that sources scan by their paths, which must match
+// an include rule and no exclude rule, and by their sizes.
 type Filter struct {
 	include *FilterRuleSet
 	exclude *FilterRuleSet
+	// maxSize is the size of the largest file that passes, if set.
+	maxSize int64
 }
 
+// globRulePrefix marks the rules of a rules file that are glob patterns rather
+// than regular expressions.
+const globRulePrefix = "glob:"
+
 type FilterRuleSet []regexp.Regexp
 
 // FilterEmpty returns a Filter that always passes.
@@ -50,7 +59,8 @@ func FilterFromFiles(includeFilterPath, excludeFilterPath string) (*Filter, erro
 	return filter, nil
 }
 
-// FilterRulesFromFile loads the list of regular expression filter rules in `source` and creates a FilterRuleSet.
+// FilterRulesFromFile loads the list of filter rules in `source` and creates a FilterRuleSet.
+// Rules are regular expressions, or glob patterns if they start with "glob:".
 func FilterRulesFromFile(source string) (*FilterRuleSet, error) {
 	rules := FilterRuleSet{}
 	if source == "" {
@@ -83,6 +93,14 @@ func FilterRulesFromFile(source string) (*FilterRuleSet, error) {
 		if emptyLinePattern.MatchString(line) {
 			continue
 		}
+		if glob, ok := strings.CutPrefix(line, globRulePrefix); ok {
+			pattern, err := GlobToRegexp(strings.TrimSpace(glob))
+			if err != nil {
+				return nil, fmt.Errorf("can not compile glob pattern: %s", line)
+			}
+			rules = append(rules, *pattern)
+			continue
+		}
 		pattern, err := regexp.Compile(line)
 		if err != nil {
 			return nil, fmt.Errorf("can not compile regular expression: %s", line)
@@ -102,6 +120,27 @@ func (filter *Filter) Pass(object string) bool {
 	return !excluded && included
 }
 
+// SetMaxSize sets the size of the largest file that passes the filter. Files of
+// any size pass if it isn't positive.
+func (filter *Filter) SetMaxSize(size int64) {
+	filter.maxSize = size
+}
+
+// PassFile returns true if the path of a file passes the filter and the file
+// isn't larger than the max size.
+func (filter *Filter) PassFile(path string, size int64) bool {
+	return filter.PassSize(size) && filter.Pass(path)
+}
+
+// PassSize returns true if a file of the size isn't larger than the max size.
+// Negative sizes are unknown, and always pass.
+func (filter *Filter) PassSize(size int64) bool {
+	if filter == nil {
+		return true
+	}
+	return filter.maxSize <= 0 || size <= filter.maxSize
+}
+
 // Matches will return true if any of the regular expressions in the FilterRuleSet match the pattern.
 func (rules *FilterRuleSet) Matches(object string) bool {
 	if rules == nil {
@@ -114,3 +153,48 @@ func (rules *FilterRuleSet) Matches(object string) bool {
 	}
 	return false
 }
+
+// GlobToRegexp compiles a glob pattern into a regular expression that matches
+// the paths ending with it, starting at a path segment. Patterns starting with
+// a slash only match from the start of the path. "*" and "?" don't match
+// slashes, while "**" matches across directories.
+func GlobToRegexp(glob string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	if strings.HasPrefix(glob, "/") {
+		b.WriteString("^")
+		glob = glob[1:]
+	} else {
+		b.WriteString("(^|/)")
+	}
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if strings.HasPrefix(glob[i:], "**/") {
+				b.WriteString("(.*/)?")
+				i += 2
+			} else if strings.HasPrefix(glob[i:], "**") {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated character class")
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
@@ -176,3 +176,69 @@ func testFilterWriteFile(filename string, content []byte) error {
 	}
 	return f.Close()
 }
+
+func TestFilterGlobRules(t *testing.T) {
+	tests := map[string]struct {
+		glob  string
+		path  string
+		match bool
+	}{
+		"BaseName":              {glob: "*.env", path: "config/prod.env", match: true},
+		"BaseNameOtherExt":      {glob: "*.env", path: "config/prod.envrc", match: false},
+		"StarNoSlash":           {glob: "config/*.env", path: "config/nested/prod.env", match: false},
+		"DoubleStar":            {glob: "config/**/*.env", path: "config/nested/prod.env", match: true},
+		"DoubleStarNoDirectory": {glob: "config/**/*.env", path: "config/prod.env", match: true},
+		"SegmentBoundary":       {glob: "vendor/**", path: "myvendor/lib.go", match: false},
+		"AnyParent":             {glob: "vendor/**", path: "/src/vendor/lib.go", match: true},
+		"Anchored":              {glob: "/vendor/**", path: "src/vendor/lib.go", match: false},
+		"QuestionMark":          {glob: "id_???", path: ".ssh/id_rsa", match: true},
+		"NegatedClass":          {glob: "*.[!g]o", path: "main.go", match: false},
+		"MetaCharacters":        {glob: "a+b(1).txt", path: "a+b(1).txt", match: true},
+	}
+	for name, test := range tests {
+		pattern, err := GlobToRegexp(test.glob)
+		if err != nil {
+			t.Fatalf("%s: could not compile glob %q: %s", name, test.glob, err)
+		}
+		if pattern.MatchString(test.path) != test.match {
+			t.Errorf("%s: unexpected match of %q with %q: %t", name, test.path, test.glob, !test.match)
+		}
+	}
+}
+
+func TestFilterPassFile(t *testing.T) {
+	includeTestFile := "/tmp/trufflehog_test_gfilter.txt"
+	if err := testFilterWriteFile(includeTestFile, []byte("glob: *.go\n^docs/")); err != nil {
+		t.Fatalf("failed to create include rules file: %s", err)
+	}
+	defer os.Remove(includeTestFile)
+
+	filter, err := FilterFromFiles(includeTestFile, "")
+	if err != nil {
+		t.Fatalf("failed to create filter from files: %s", err)
+	}
+	filter.SetMaxSize(10)
+
+	tests := map[string]struct {
+		path string
+		size int64
+		pass bool
+	}{
+		"GlobRule":       {path: "pkg/main.go", size: 5, pass: true},
+		"RegexRule":      {path: "docs/README.md", size: 5, pass: true},
+		"NotIncluded":    {path: "README.md", size: 5, pass: false},
+		"TooLarge":       {path: "pkg/main.go", size: 11, pass: false},
+		"UnknownSize":    {path: "pkg/main.go", size: -1, pass: true},
+		"AtTheSizeLimit": {path: "pkg/main.go", size: 10, pass: true},
+	}
+	for name, test := range tests {
+		if filter.PassFile(test.path, test.size) != test.pass {
+			t.Errorf("%s: unexpected filter result. path: %q, size: %d, pass: %t", name, test.path, test.size, !test.pass)
+		}
+	}
+
+	var nilFilter *Filter
+	if !nilFilter.PassFile("anything", 1<<40) {
+		t.Errorf("nil filter should pass every file")
+	}
+}
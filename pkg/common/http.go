@@ -144,10 +144,17 @@ var saneTransport = &http.Transport{
 	ExpectContinueTimeout: 1 * time.Second,
 }
 
+// verificationTransport wraps T with the egress allowlist, dry run mode,
+// verification error recording, retry policy, rate limits, and tracing applied
+// to requests detectors make to verify secrets.
+func verificationTransport(T http.RoundTripper) http.RoundTripper {
+	return NewAllowlistTransport(NewDryRunTransport(NewVerificationErrorTransport(NewRetryTransport(NewRateLimitTransport(NewTracingTransport(T))))))
+}
+
 func SaneHttpClient() *http.Client {
 	httpClient := &http.Client{}
 	httpClient.Timeout = DefaultResponseTimeout
-	httpClient.Transport = NewCustomTransport(saneTransport)
+	httpClient.Transport = NewCustomTransport(verificationTransport(saneTransport))
 	return httpClient
 }
 
@@ -155,6 +162,6 @@ func SaneHttpClient() *http.Client {
 func SaneHttpClientTimeOut(timeOutSeconds int64) *http.Client {
 	httpClient := &http.Client{}
 	httpClient.Timeout = time.Second * time.Duration(timeOutSeconds)
-	httpClient.Transport = NewCustomTransport(nil)
+	httpClient.Transport = NewCustomTransport(verificationTransport(nil))
 	return httpClient
 }
@@ -0,0 +1,201 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitPollInterval is how often waiting requests check whether they may
+// be sent.
+const rateLimitPollInterval = 100 * time.Millisecond
+
+// apiQuota is the rate limit quota of one resource of an API, as reported by
+// the headers of its last response.
+type apiQuota struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// RateLimitController throttles the requests a source makes to the GitHub or
+// GitLab API based on the rate limit headers of the responses. As the
+// remaining quota runs low, fewer requests are allowed in flight at once, and
+// once it's nearly exhausted every request waits for the quota to reset. A
+// Retry-After header, which the APIs send for secondary rate limits, pauses
+// every request for the given time. This slows scans down instead of failing
+// them with rate limit errors halfway through.
+type RateLimitController struct {
+	mu          sync.Mutex
+	maxInFlight int
+	inFlight    int
+	// quotas are keyed by the resource they apply to. GitHub reports separate
+	// quotas for the REST, GraphQL and search APIs.
+	quotas      map[string]apiQuota
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// NewRateLimitController creates a controller that allows up to maxInFlight
+// requests at once while there's quota left.
+func NewRateLimitController(maxInFlight int) *RateLimitController {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+	return &RateLimitController{
+		maxInFlight: maxInFlight,
+		quotas:      map[string]apiQuota{},
+		now:         time.Now,
+	}
+}
+
+// Wait blocks until the quota of the API isn't exhausted. Sources call it
+// before starting work that will make requests, such as cloning a repo.
+func (c *RateLimitController) Wait(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		wait := c.pauseLocked()
+		c.mu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// acquire waits until a request may be sent and reserves a slot for it.
+func (c *RateLimitController) acquire(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		wait := c.pauseLocked()
+		if wait <= 0 && c.inFlight < c.allowedLocked() {
+			c.inFlight++
+			c.mu.Unlock()
+			return nil
+		}
+		c.mu.Unlock()
+		if wait <= 0 || wait > rateLimitPollInterval {
+			wait = rateLimitPollInterval
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// release frees the slot of a request and records the quota its response
+// reported.
+func (c *RateLimitController) release(res *http.Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	if res != nil {
+		c.observeLocked(res)
+	}
+}
+
+// pauseLocked returns how long requests must wait before being sent.
+func (c *RateLimitController) pauseLocked() time.Duration {
+	now := c.now()
+	wait := c.pausedUntil.Sub(now)
+	for resource, quota := range c.quotas {
+		if !quota.reset.After(now) {
+			// The quota has been reset since it was reported.
+			delete(c.quotas, resource)
+			continue
+		}
+		// Keep enough quota for the requests that are already in flight.
+		if quota.remaining <= c.maxInFlight {
+			if untilReset := quota.reset.Sub(now); untilReset > wait {
+				wait = untilReset
+			}
+		}
+	}
+	return wait
+}
+
+// allowedLocked returns how many requests may be in flight at once. It shrinks
+// in proportion to the remaining quota once less than a fifth of it is left.
+func (c *RateLimitController) allowedLocked() int {
+	allowed := c.maxInFlight
+	for _, quota := range c.quotas {
+		if quota.limit <= 0 || quota.remaining*5 >= quota.limit {
+			continue
+		}
+		n := c.maxInFlight * quota.remaining * 5 / quota.limit
+		if n < allowed {
+			allowed = n
+		}
+	}
+	if allowed < 1 {
+		allowed = 1
+	}
+	return allowed
+}
+
+// observeLocked records the rate limit headers of a response. GitHub sends
+// X-RateLimit-* headers, and GitLab sends RateLimit-* headers.
+func (c *RateLimitController) observeLocked(res *http.Response) {
+	now := c.now()
+	if retryAfter, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && retryAfter > 0 {
+		if until := now.Add(time.Duration(retryAfter) * time.Second); until.After(c.pausedUntil) {
+			c.pausedUntil = until
+		}
+	}
+
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		remaining, err := strconv.Atoi(res.Header.Get(prefix + "Remaining"))
+		if err != nil {
+			continue
+		}
+		reset, err := strconv.ParseInt(res.Header.Get(prefix+"Reset"), 10, 64)
+		if err != nil {
+			continue
+		}
+		limit, _ := strconv.Atoi(res.Header.Get(prefix + "Limit"))
+		resource := res.Header.Get(prefix + "Resource")
+		c.quotas[resource] = apiQuota{
+			limit:     limit,
+			remaining: remaining,
+			reset:     time.Unix(reset, 0),
+		}
+		return
+	}
+}
+
+// Transport wraps T so that its requests are throttled by the controller.
+func (c *RateLimitController) Transport(T http.RoundTripper) http.RoundTripper {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &rateLimitControllerTransport{c: c, T: T}
+}
+
+type rateLimitControllerTransport struct {
+	c *RateLimitController
+	T http.RoundTripper
+}
+
+func (t *rateLimitControllerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.c.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	res, err := t.T.RoundTrip(req)
+	t.c.release(res)
+	return res, err
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimitController(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := strconv.FormatInt(now.Add(time.Minute).Unix(), 10)
+
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		wantAllowed int
+		wantPause   time.Duration
+	}{
+		{
+			name:        "no headers",
+			wantAllowed: 10,
+		},
+		{
+			name: "plenty of quota",
+			headers: map[string]string{
+				"X-RateLimit-Limit":     "5000",
+				"X-RateLimit-Remaining": "4000",
+				"X-RateLimit-Reset":     reset,
+			},
+			wantAllowed: 10,
+		},
+		{
+			name: "low quota",
+			headers: map[string]string{
+				"X-RateLimit-Limit":     "5000",
+				"X-RateLimit-Remaining": "500",
+				"X-RateLimit-Reset":     reset,
+			},
+			wantAllowed: 5,
+		},
+		{
+			name: "exhausted quota",
+			headers: map[string]string{
+				"X-RateLimit-Limit":     "5000",
+				"X-RateLimit-Remaining": "3",
+				"X-RateLimit-Reset":     reset,
+			},
+			wantAllowed: 1,
+			wantPause:   time.Minute,
+		},
+		{
+			name: "gitlab headers",
+			headers: map[string]string{
+				"RateLimit-Limit":     "600",
+				"RateLimit-Remaining": "0",
+				"RateLimit-Reset":     reset,
+			},
+			wantAllowed: 1,
+			wantPause:   time.Minute,
+		},
+		{
+			name: "retry after",
+			headers: map[string]string{
+				"Retry-After": "30",
+			},
+			wantAllowed: 10,
+			wantPause:   30 * time.Second,
+		},
+		{
+			name: "quota already reset",
+			headers: map[string]string{
+				"X-RateLimit-Limit":     "5000",
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(now.Add(-time.Second).Unix(), 10),
+			},
+			wantAllowed: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewRateLimitController(10)
+			c.now = func() time.Time { return now }
+
+			res := &http.Response{Header: http.Header{}}
+			for k, v := range tt.headers {
+				res.Header.Set(k, v)
+			}
+			c.inFlight = 1
+			c.release(res)
+
+			if pause := c.pauseLocked(); pause != tt.wantPause && !(pause <= 0 && tt.wantPause == 0) {
+				t.Errorf("expected pause %v, got %v", tt.wantPause, pause)
+			}
+			if allowed := c.allowedLocked(); allowed != tt.wantAllowed {
+				t.Errorf("expected %d requests in flight, got %d", tt.wantAllowed, allowed)
+			}
+		})
+	}
+}
+
+func TestRateLimitControllerTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	}))
+	defer server.Close()
+
+	c := NewRateLimitController(2)
+	client := &http.Client{Transport: c.Transport(nil)}
+
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	// The quota is exhausted, so the next request must wait for it to reset.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req); err == nil {
+		t.Errorf("expected the request to wait until the context was done")
+	}
+	if err := c.Wait(ctx); err == nil {
+		t.Errorf("expected Wait to return the context error")
+	}
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit configures a token bucket that allows Rate requests per second
+// with bursts of up to Burst requests.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l RateLimit) newLimiter() *rate.Limiter {
+	burst := l.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(l.Rate), burst)
+}
+
+var (
+	rateLimitMu sync.Mutex
+	// defaultRateLimit applies to every verification host without a more
+	// specific limit. Nil means unlimited.
+	defaultRateLimit *RateLimit
+	// rateLimits holds limits keyed by lowercase host or detector name.
+	rateLimits = map[string]RateLimit{}
+	// limiters holds the token buckets created so far, keyed by the host or
+	// detector name they apply to.
+	limiters = map[string]*rate.Limiter{}
+)
+
+// SetVerificationRateLimit sets the limit applied to each verification host
+// that doesn't have its own limit configured.
+func SetVerificationRateLimit(limit RateLimit) {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+	defaultRateLimit = &limit
+	limiters = map[string]*rate.Limiter{}
+}
+
+// SetVerificationRateLimitFor sets the limit for a single host (e.g.
+// "api.github.com") or detector name (e.g. "gitlab"). Detector limits take
+// precedence over host limits and are shared by all hosts the detector
+// contacts.
+func SetVerificationRateLimitFor(key string, limit RateLimit) {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+	rateLimits[strings.ToLower(key)] = limit
+	limiters = map[string]*rate.Limiter{}
+}
+
+// ResetVerificationRateLimits removes all configured verification rate limits.
+func ResetVerificationRateLimits() {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+	defaultRateLimit = nil
+	rateLimits = map[string]RateLimit{}
+	limiters = map[string]*rate.Limiter{}
+}
+
+// verificationLimiter returns the token bucket that applies to the request,
+// or nil if it isn't rate limited.
+func verificationLimiter(req *http.Request) *rate.Limiter {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+
+	key := strings.ToLower(DetectorName(req.Context()))
+	limit, ok := rateLimits[key]
+	if !ok {
+		key = strings.ToLower(req.URL.Hostname())
+		limit, ok = rateLimits[key]
+	}
+	if !ok {
+		if defaultRateLimit == nil {
+			return nil
+		}
+		limit = *defaultRateLimit
+	}
+
+	limiter, ok := limiters[key]
+	if !ok {
+		limiter = limit.newLimiter()
+		limiters[key] = limiter
+	}
+	return limiter
+}
+
+// RateLimitTransport waits for the verification rate limit of the request's
+// detector or host before sending it.
+type RateLimitTransport struct {
+	T http.RoundTripper
+}
+
+func NewRateLimitTransport(T http.RoundTripper) *RateLimitTransport {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &RateLimitTransport{T}
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := verificationLimiter(req); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.T.RoundTrip(req)
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestVerificationLimiter(t *testing.T) {
+	defer ResetVerificationRateLimits()
+
+	newRequest := func(ctx context.Context, url string) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	ctx := context.Background()
+
+	if l := verificationLimiter(newRequest(ctx, "https://api.github.com/user")); l != nil {
+		t.Fatalf("expected no limiter without configuration")
+	}
+
+	SetVerificationRateLimit(RateLimit{Rate: 10})
+	github := verificationLimiter(newRequest(ctx, "https://api.github.com/user"))
+	if github == nil {
+		t.Fatalf("expected default limiter")
+	}
+	if l := verificationLimiter(newRequest(ctx, "https://API.github.com/orgs")); l != github {
+		t.Errorf("expected requests to the same host to share a limiter")
+	}
+	if l := verificationLimiter(newRequest(ctx, "https://gitlab.com/api/v4/user")); l == github {
+		t.Errorf("expected requests to different hosts to use different limiters")
+	}
+
+	SetVerificationRateLimitFor("api.github.com", RateLimit{Rate: 2, Burst: 3})
+	if l := verificationLimiter(newRequest(ctx, "https://api.github.com/user")); l.Limit() != 2 || l.Burst() != 3 {
+		t.Errorf("expected host limit, got %v/%d", l.Limit(), l.Burst())
+	}
+
+	SetVerificationRateLimitFor("Gitlab", RateLimit{Rate: 1})
+	gitlabCtx := WithDetectorName(ctx, "Gitlab")
+	gitlab := verificationLimiter(newRequest(gitlabCtx, "https://gitlab.com/api/v4/user"))
+	if gitlab.Limit() != 1 || gitlab.Burst() != 1 {
+		t.Errorf("expected detector limit, got %v/%d", gitlab.Limit(), gitlab.Burst())
+	}
+	if l := verificationLimiter(newRequest(gitlabCtx, "https://gitlab.example.com/api/v4/user")); l != gitlab {
+		t.Errorf("expected detector limit to be shared across hosts")
+	}
+}
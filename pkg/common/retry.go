@@ -0,0 +1,142 @@
+package common
+
+import (
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how verification HTTP requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one. Values less than 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. Each subsequent retry
+	// doubles the delay up to MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts.
+	MaxDelay time.Duration
+	// Jitter randomizes each delay between half and the full computed value.
+	Jitter bool
+	// RetryOnStatus lists the response status codes that trigger a retry.
+	// Transport errors are always retried.
+	RetryOnStatus []int
+}
+
+// DefaultRetryPolicy makes a single attempt per request. Retries are opt-in
+// to preserve the previous best-effort verification behavior.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 1,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+	Jitter:      true,
+	RetryOnStatus: []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+var (
+	retryPolicyMu sync.RWMutex
+	retryPolicy   = DefaultRetryPolicy
+)
+
+// SetVerificationRetryPolicy sets the retry policy used by the HTTP clients
+// detectors verify secrets with.
+func SetVerificationRetryPolicy(policy RetryPolicy) {
+	retryPolicyMu.Lock()
+	defer retryPolicyMu.Unlock()
+	retryPolicy = policy
+}
+
+// VerificationRetryPolicy returns the current verification retry policy.
+func VerificationRetryPolicy() RetryPolicy {
+	retryPolicyMu.RLock()
+	defer retryPolicyMu.RUnlock()
+	return retryPolicy
+}
+
+// shouldRetry reports whether a response status code should be retried.
+func (p RetryPolicy) shouldRetry(status int) bool {
+	for _, s := range p.RetryOnStatus {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay to wait before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter && delay > 0 {
+		half := int64(delay / 2)
+		delay = time.Duration(half + rand.Int63n(half+1))
+	}
+	return delay
+}
+
+// RetryTransport retries requests according to the verification retry
+// policy. The policy is read at request time so it can be changed after
+// clients have been created.
+type RetryTransport struct {
+	T http.RoundTripper
+}
+
+func NewRetryTransport(T http.RoundTripper) *RetryTransport {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &RetryTransport{T}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	policy := VerificationRetryPolicy()
+	// Requests with a body that can't be replayed are only attempted once.
+	if policy.MaxAttempts < 2 || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return t.T.RoundTrip(req)
+	}
+
+	var (
+		res *http.Response
+		err error
+	)
+	for attempt := 1; ; attempt++ {
+		res, err = t.T.RoundTrip(req)
+		if attempt >= policy.MaxAttempts {
+			return res, err
+		}
+		if err == nil && !policy.shouldRetry(res.StatusCode) {
+			return res, nil
+		}
+		if err == nil {
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(policy.backoff(attempt))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
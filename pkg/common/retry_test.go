@@ -0,0 +1,104 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       RetryPolicy
+		statuses     []int
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			name:         "single attempt by default",
+			policy:       DefaultRetryPolicy,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries until success",
+			policy:       RetryPolicy{MaxAttempts: 3, RetryOnStatus: []int{http.StatusServiceUnavailable}},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "gives up after max attempts",
+			policy:       RetryPolicy{MaxAttempts: 2, RetryOnStatus: []int{http.StatusTooManyRequests}},
+			statuses:     []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 2,
+		},
+		{
+			name:         "does not retry unlisted status",
+			policy:       RetryPolicy{MaxAttempts: 3, RetryOnStatus: []int{http.StatusTooManyRequests}},
+			statuses:     []int{http.StatusUnauthorized, http.StatusOK},
+			wantStatus:   http.StatusUnauthorized,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			SetVerificationRetryPolicy(tt.policy)
+			defer SetVerificationRetryPolicy(DefaultRetryPolicy)
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := SaneHttpClient().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status: got %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts: got %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	want := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, w := range want {
+		if got := policy.backoff(i + 1); got != w {
+			t.Errorf("retry %d: got %s, want %s", i+1, got, w)
+		}
+	}
+
+	policy.Jitter = true
+	for i := 1; i < 5; i++ {
+		got := policy.backoff(i)
+		if max := want[i-1]; got < max/2 || got > max {
+			t.Errorf("retry %d: jittered delay %s outside [%s, %s]", i, got, max/2, max)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingTransport records a span for each verification request, under the
+// span of the detector that sent it. Only the method and host of requests
+// are recorded, since URLs often contain the secrets being verified.
+type TracingTransport struct {
+	T http.RoundTripper
+}
+
+func NewTracingTransport(T http.RoundTripper) *TracingTransport {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &TracingTransport{T}
+}
+
+func (t *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer("github.com/trufflesecurity/trufflehog/v3/pkg/common").Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("net.peer.name", req.URL.Hostname()),
+			attribute.String("detector", DetectorName(req.Context())),
+		),
+	)
+	defer span.End()
+
+	res, err := t.T.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		// The error of a request includes its URL.
+		span.SetStatus(codes.Error, "request failed")
+		return res, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= 500 {
+		span.SetStatus(codes.Error, res.Status)
+	}
+	return res, nil
+}
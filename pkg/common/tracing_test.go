@@ -0,0 +1,59 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingTransport(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(provider)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	ctx, parent := provider.Tracer("test").Start(WithDetectorName(context.Background(), "Github"), "detect")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/user?api_key=secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := NewTracingTransport(nil).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "HTTP GET" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("expected the request span to be a child of the detector span")
+	}
+	attrs := map[string]string{}
+	for _, attr := range span.Attributes() {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+		if strings.Contains(attr.Value.Emit(), "secret") {
+			t.Errorf("expected no secret in attribute %s", attr.Key)
+		}
+	}
+	if attrs["detector"] != "Github" || attrs["http.status_code"] != "401" || attrs["net.peer.name"] != "127.0.0.1" {
+		t.Errorf("unexpected attributes %v", attrs)
+	}
+}
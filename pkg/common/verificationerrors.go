@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// VerificationErrors collects the errors of the verification requests made
+// with a single context, i.e. the requests that failed or that the provider
+// couldn't answer, so the validity of the secrets they verify is unknown.
+type VerificationErrors struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+// Err returns the recorded errors joined together, or nil if there are none.
+func (v *VerificationErrors) Err() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return errors.Join(v.errs...)
+}
+
+func (v *VerificationErrors) add(err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.errs = append(v.errs, err)
+}
+
+type verificationErrorsKey struct{}
+
+// WithVerificationErrors returns a copy of ctx that records the errors of the
+// verification requests made with it in v.
+func WithVerificationErrors(ctx context.Context, v *VerificationErrors) context.Context {
+	return context.WithValue(ctx, verificationErrorsKey{}, v)
+}
+
+// RecordVerificationError records an error that kept a secret from being
+// verified in the context's VerificationErrors, if it has any. The HTTP
+// clients of SaneHttpClient record theirs, detectors that verify over other
+// protocols can call it themselves.
+func RecordVerificationError(ctx context.Context, err error) {
+	if v, ok := ctx.Value(verificationErrorsKey{}).(*VerificationErrors); ok {
+		v.add(err)
+	}
+}
+
+// VerificationErrorTransport records the requests that fail, or that the
+// provider answers with a server error or by rate limiting them, as
+// verification errors.
+type VerificationErrorTransport struct {
+	T http.RoundTripper
+}
+
+func NewVerificationErrorTransport(T http.RoundTripper) *VerificationErrorTransport {
+	if T == nil {
+		T = http.DefaultTransport
+	}
+	return &VerificationErrorTransport{T}
+}
+
+func (t *VerificationErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.T.RoundTrip(req)
+	// The errors name the host only, the rest of the URL may hold the secret.
+	switch {
+	case err != nil:
+		RecordVerificationError(req.Context(), fmt.Errorf("request to %s failed: %w", req.URL.Host, err))
+	case res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests:
+		RecordVerificationError(req.Context(), fmt.Errorf("request to %s failed: %s", req.URL.Host, res.Status))
+	}
+	return res, err
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVerificationErrorTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "invalid secret", url: server.URL + "/user?key=secret"},
+		{name: "server error", url: server.URL + "/down?key=secret", wantErr: true},
+		{name: "unreachable", url: closed.URL + "/user?key=secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := &VerificationErrors{}
+			ctx := WithVerificationErrors(context.Background(), errs)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res, err := SaneHttpClient().Do(req); err == nil {
+				res.Body.Close()
+			}
+
+			err = errs.Err()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verification error: got %v, want error %t", err, tt.wantErr)
+			}
+			if err != nil && strings.Contains(err.Error(), "secret") {
+				t.Errorf("verification error contains the secret: %v", err)
+			}
+		})
+	}
+}
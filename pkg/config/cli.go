@@ -0,0 +1,480 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// sourceKey is the key of the command that is run if the command line doesn't
+// select one.
+const sourceKey = "source"
+
+// profilesKey is the key of the named profiles, which each set flags like the
+// top level of the file does.
+const profilesKey = "profiles"
+
+// targetsKey is the key of the list of targets that one run scans with the
+// same engine.
+const targetsKey = "targets"
+
+// ReadCLIArgs adds the flags and arguments set in a configuration file to the
+// command line arguments of app. See CLIArgs.
+func ReadCLIArgs(app *kingpin.Application, filename, profile string, args []string) ([][]string, error) {
+	input, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return CLIArgs(app, input, profile, args)
+}
+
+// CLIArgs adds the flags and arguments set in a configuration file to the
+// command line arguments of app. The top level keys of the file set the global
+// flags, and a section named after a command sets its flags and arguments:
+//
+//	source: github
+//	concurrency: 8
+//	only-verified: true
+//	github:
+//	  org: [trufflesecurity]
+//	  token: ${GITHUB_TOKEN}
+//	profiles:
+//	  ci-fast:
+//	    include-detectors: aws,github
+//	    github:
+//	      include-comments: false
+//
+// The source is the command run if the command line doesn't select one, and
+// the sections of the other commands are ignored. If a profile is selected, the
+// keys it sets override those at the top level. Flags and arguments given on
+// the command line take precedence over the file. Keys that don't match a flag,
+// argument or command, and values that the flags don't accept, are errors.
+//
+// Instead of a source, the file can list targets, each a command with its
+// flags and arguments, to scan them all in one run:
+//
+//	targets:
+//	- github: {org: [trufflesecurity]}
+//	- s3: {bucket: [logs, backups]}
+//	- filesystem: {path: [/srv]}
+//
+// The arguments of each target are returned, in order, unless the command line
+// selects a command. A command can only be listed once, since its flags are
+// set once per run. Otherwise, the arguments of a single command are returned.
+func CLIArgs(app *kingpin.Application, input []byte, profile string, args []string) ([][]string, error) {
+	doc, err := parseDocument(input)
+	if err != nil {
+		return nil, err
+	}
+	_, cliDoc := splitDocument(doc)
+	profiles, err := profilesOf(cliDoc)
+	if err != nil {
+		return nil, err
+	}
+	delete(cliDoc, profilesKey)
+
+	// docs are the parts of the file that apply, by the prefix of their keys,
+	// with the selected profile last so that it overrides the top level.
+	docs := map[string]map[string]any{"": cliDoc}
+	prefixes := []string{""}
+	if profile != "" {
+		profileDoc, ok := profiles[profile]
+		if !ok {
+			return nil, fmt.Errorf("%s: no profile named %q", profilesKey, profile)
+		}
+		prefix := profilesKey + "." + profile + "."
+		docs[prefix] = profileDoc
+		prefixes = append(prefixes, prefix)
+	}
+
+	// The errors of the command line are reported once it's parsed with the
+	// arguments of the file.
+	given := map[string]struct{}{}
+	var command string
+	if parsed, _ := app.ParseContext(args); parsed != nil {
+		for _, element := range parsed.Elements {
+			switch clause := element.Clause.(type) {
+			case *kingpin.FlagClause:
+				given[clause.Model().Name] = struct{}{}
+			case *kingpin.ArgClause:
+				given[clause.Model().Name] = struct{}{}
+			}
+		}
+		if parsed.SelectedCommand != nil {
+			command = parsed.SelectedCommand.FullCommand()
+		}
+	}
+
+	var source string
+	var targets []target
+	for _, prefix := range prefixes {
+		if value, ok := docs[prefix][sourceKey]; ok {
+			if source, ok = value.(string); !ok || app.GetCommand(source) == nil {
+				return nil, fmt.Errorf("%s%s: expected the name of a command, got %v", prefix, sourceKey, value)
+			}
+		}
+		if _, ok := docs[prefix][targetsKey]; ok {
+			if targets, err = targetsOf(app, docs[prefix], prefix); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if source != "" && targets != nil {
+		return nil, fmt.Errorf("%s and %s can't both be set", sourceKey, targetsKey)
+	}
+
+	// The profiles that aren't selected are checked too, so that mistakes in
+	// them don't go unnoticed until they're used.
+	for _, name := range sortedKeys(profiles) {
+		if name == profile {
+			continue
+		}
+		prefix := profilesKey + "." + name + "."
+		check := newCLIArgs(app, given, "")
+		if err := check.collect(profiles[name], prefix, false); err != nil {
+			return nil, err
+		}
+		profileTargets, err := targetsOf(app, profiles[name], prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range profileTargets {
+			if err := check.collectCommand(t.cmd, t.key, t.section, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if command != "" || targets == nil {
+		if command == "" && source != "" {
+			command = source
+			args = append([]string{source}, args...)
+		}
+		c := newCLIArgs(app, given, command)
+		for _, prefix := range prefixes {
+			if err := c.collect(docs[prefix], prefix, true); err != nil {
+				return nil, err
+			}
+		}
+		// The targets aren't scanned, but are checked all the same.
+		for _, t := range targets {
+			if err := c.collectCommand(t.cmd, t.key, t.section, false); err != nil {
+				return nil, err
+			}
+		}
+		return [][]string{c.commandLine(args)}, nil
+	}
+
+	runs := make([][]string, 0, len(targets))
+	for _, t := range targets {
+		name := t.cmd.FullCommand()
+		c := newCLIArgs(app, given, name)
+		for _, prefix := range prefixes {
+			if err := c.collect(docs[prefix], prefix, true); err != nil {
+				return nil, err
+			}
+		}
+		// The section of the target overrides the section of its command.
+		if err := c.collectCommand(t.cmd, t.key, t.section, true); err != nil {
+			return nil, err
+		}
+		runs = append(runs, c.commandLine(append([]string{name}, args...)))
+	}
+	return runs, nil
+}
+
+// target is a command that a run scans, listed in the targets of a
+// configuration file.
+type target struct {
+	cmd *kingpin.CmdClause
+	// key is the path of the target's section in the file.
+	key     string
+	section map[string]any
+}
+
+// targetsOf returns the targets listed in doc, whose paths in the file start
+// with prefix.
+func targetsOf(app *kingpin.Application, doc map[string]any, prefix string) ([]target, error) {
+	value, ok := doc[targetsKey]
+	if !ok {
+		return nil, nil
+	}
+	list, ok := value.([]any)
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("%s%s: expected a list of targets", prefix, targetsKey)
+	}
+	targets := make([]target, 0, len(list))
+	seen := map[string]struct{}{}
+	for i, item := range list {
+		t, err := targetOf(app, prefix+targetsKey+"["+strconv.Itoa(i)+"]", item)
+		if err != nil {
+			return nil, err
+		}
+		name := t.cmd.FullCommand()
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("%s: the %s command can only be listed once, list several values in its flags instead", t.key, name)
+		}
+		seen[name] = struct{}{}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// targetOf returns the target that item, at key in the file, lists: the name
+// of a command with its flags and arguments.
+func targetOf(app *kingpin.Application, key string, item any) (target, error) {
+	m, ok := item.(map[string]any)
+	if !ok || len(m) != 1 {
+		return target{}, fmt.Errorf("%s: expected the name of a command with its flags and arguments", key)
+	}
+	var name string
+	var value any
+	for name, value = range m {
+	}
+	cmd := app.GetCommand(flagName(name))
+	if cmd == nil {
+		return target{}, fmt.Errorf("%s.%s: unknown command", key, name)
+	}
+	section, ok := value.(map[string]any)
+	if !ok && value != nil {
+		return target{}, fmt.Errorf("%s.%s: expected the flags and arguments of the %s command", key, name, cmd.FullCommand())
+	}
+	return target{cmd: cmd, key: key + "." + name, section: section}, nil
+}
+
+// profilesOf returns the named profiles of a configuration file.
+func profilesOf(doc map[string]any) (map[string]map[string]any, error) {
+	value, ok := doc[profilesKey]
+	if !ok {
+		return nil, nil
+	}
+	section, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a map of profiles by name", profilesKey)
+	}
+	profiles := make(map[string]map[string]any, len(section))
+	for name, value := range section {
+		profile, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: expected the flags of the profile", profilesKey, name)
+		}
+		detectorsDoc, _ := splitDocument(profile)
+		for key := range detectorsDoc {
+			return nil, fmt.Errorf("%s.%s.%s: detectors are configured at the top level, for every profile", profilesKey, name, key)
+		}
+		if _, ok := profile[profilesKey]; ok {
+			return nil, fmt.Errorf("%s.%s.%s: profiles can't be nested", profilesKey, name, profilesKey)
+		}
+		if _, ok := profile[schedulesKey]; ok {
+			return nil, fmt.Errorf("%s.%s.%s: schedules are configured at the top level", profilesKey, name, schedulesKey)
+		}
+		profiles[name] = profile
+	}
+	return profiles, nil
+}
+
+// cliArgs collects the command line arguments that a configuration file sets.
+type cliArgs struct {
+	app *kingpin.Application
+	// given are the names of the flags and arguments given on the command
+	// line.
+	given map[string]struct{}
+	// command is the selected command.
+	command string
+	// flags and args are the arguments that set the flags and the positional
+	// arguments, by the key that sets them without the profile prefix, so
+	// that profiles override the top level.
+	flags, args map[string][]string
+}
+
+func newCLIArgs(app *kingpin.Application, given map[string]struct{}, command string) *cliArgs {
+	return &cliArgs{
+		app:     app,
+		given:   given,
+		command: command,
+		flags:   map[string][]string{},
+		args:    map[string][]string{},
+	}
+}
+
+// commandLine returns args with the collected arguments added.
+func (c *cliArgs) commandLine(args []string) []string {
+	for _, key := range sortedKeys(c.flags) {
+		args = append(args, c.flags[key]...)
+	}
+	for _, key := range sortedKeys(c.args) {
+		args = append(args, c.args[key]...)
+	}
+	return args
+}
+
+// collect collects the arguments set by the keys of doc, whose paths in the
+// file start with prefix. The keys are only checked if apply isn't set.
+func (c *cliArgs) collect(doc map[string]any, prefix string, apply bool) error {
+	for _, key := range sortedKeys(doc) {
+		if key == sourceKey || key == targetsKey || key == schedulesKey {
+			continue
+		}
+		name := flagName(key)
+		if cmd := c.app.GetCommand(name); cmd != nil {
+			section, ok := doc[key].(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s%s: expected the flags and arguments of the %s command", prefix, key, name)
+			}
+			if err := c.collectCommand(cmd, prefix+key, section, apply && name == c.command); err != nil {
+				return err
+			}
+			continue
+		}
+		flag := c.app.GetFlag(name)
+		if flag == nil {
+			return fmt.Errorf("%s%s: unknown flag or command", prefix, key)
+		}
+		set, err := flagArgs(flag, prefix+key, doc[key], c.given, apply)
+		if err != nil {
+			return err
+		}
+		if apply {
+			c.flags[name] = set
+		}
+	}
+	return nil
+}
+
+// collectCommand collects the flags and arguments set in the section of a
+// command, at key in the file.
+func (c *cliArgs) collectCommand(cmd *kingpin.CmdClause, key string, section map[string]any, apply bool) error {
+	for _, k := range sortedKeys(section) {
+		sectionKey := key + "." + k
+		name := flagName(k)
+		id := cmd.FullCommand() + "." + name
+		if flag := cmd.GetFlag(name); flag != nil {
+			set, err := flagArgs(flag, sectionKey, section[k], c.given, apply)
+			if err != nil {
+				return err
+			}
+			if apply {
+				c.flags[id] = set
+			}
+			continue
+		}
+		arg := cmd.GetArg(name)
+		if arg == nil {
+			return fmt.Errorf("%s: unknown flag or argument of the %s command", sectionKey, cmd.FullCommand())
+		}
+		model := arg.Model()
+		argValues, err := values(sectionKey, section[k], isCumulative(model.Value))
+		if err != nil {
+			return err
+		}
+		if _, ok := c.given[model.Name]; !ok && apply {
+			c.args[id] = argValues
+		}
+	}
+	return nil
+}
+
+// flagArgs returns the command line arguments that set a flag to a value, or
+// nothing if the flag is given on the command line or apply isn't set. The
+// values are checked by setting them, since the flag is set to them when the
+// arguments are parsed.
+func flagArgs(flag *kingpin.FlagClause, key string, value any, given map[string]struct{}, apply bool) ([]string, error) {
+	model := flag.Model()
+	if model.IsBoolFlag() {
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected true or false, got %v", key, value)
+		}
+		if _, ok := given[model.Name]; ok || !apply {
+			return nil, nil
+		}
+		if b {
+			return []string{"--" + model.Name}, nil
+		}
+		return []string{"--no-" + model.Name}, nil
+	}
+
+	cumulative := isCumulative(model.Value)
+	flagValues, err := values(key, value, cumulative)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := given[model.Name]; ok || !apply {
+		return nil, nil
+	}
+	args := make([]string, 0, len(flagValues))
+	for _, v := range flagValues {
+		// Cumulative values would be set twice, and are only checked when
+		// the arguments are parsed.
+		if !cumulative {
+			if err := model.Value.Set(v); err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+		}
+		args = append(args, "--"+model.Name+"="+v)
+	}
+	return args, nil
+}
+
+// isCumulative reports whether a flag or argument can be repeated.
+func isCumulative(value kingpin.Value) bool {
+	v, ok := value.(interface{ IsCumulative() bool })
+	return ok && v.IsCumulative()
+}
+
+// values returns the command line values of a value of the configuration file.
+// Lists and maps are only accepted by cumulative flags and arguments, and maps
+// are converted to key=value pairs.
+func values(key string, value any, cumulative bool) ([]string, error) {
+	switch v := value.(type) {
+	case []any:
+		if !cumulative {
+			return nil, fmt.Errorf("%s: expected a single value, got a list", key)
+		}
+		var vals []string
+		for i, item := range v {
+			s, err := scalar(key+"["+strconv.Itoa(i)+"]", item)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, s)
+		}
+		return vals, nil
+	case map[string]any:
+		if !cumulative {
+			return nil, fmt.Errorf("%s: expected a single value, got a map", key)
+		}
+		var vals []string
+		for _, k := range sortedKeys(v) {
+			s, err := scalar(key+"."+k, v[k])
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, k+"="+s)
+		}
+		return vals, nil
+	default:
+		s, err := scalar(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return []string{s}, nil
+	}
+}
+
+func scalar(key string, value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case nil:
+		return "", fmt.Errorf("%s: missing value", key)
+	default:
+		return "", fmt.Errorf("%s: expected a string, number or boolean", key)
+	}
+}
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func testApp() *kingpin.Application {
+	app := kingpin.New("test", "")
+	app.Flag("concurrency", "").Default("4").Int()
+	app.Flag("only-verified", "").Bool()
+	app.Flag("verifier", "").StringMap()
+	github := app.Command("github", "")
+	github.Flag("org", "").Strings()
+	github.Flag("token", "").String()
+	git := app.Command("git", "")
+	git.Arg("uri", "").Required().String()
+	s3 := app.Command("s3", "")
+	s3.Flag("bucket", "").Strings()
+	return app
+}
+
+func TestCLIArgs(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		profile string
+		args    []string
+		env     map[string]string
+		want    []string
+		wantErr string
+	}{
+		"flags": {
+			input: `
+concurrency: 8
+only-verified: true
+verifier: {gitlab: https://gitlab.internal.corp}
+github:
+  org: [a, b]
+`,
+			args: []string{"github"},
+			want: []string{"github", "--concurrency=8", "--org=a", "--org=b", "--only-verified", "--verifier=gitlab=https://gitlab.internal.corp"},
+		},
+		"command line takes precedence": {
+			input: "concurrency: 8\nonly_verified: false\ngithub: {org: [a]}\n",
+			args:  []string{"github", "--concurrency=2", "--org=c"},
+			want:  []string{"github", "--concurrency=2", "--org=c", "--no-only-verified"},
+		},
+		"source": {
+			input: "source: git\ngit: {uri: https://example.com/repo.git}\ngithub: {org: [a]}\n",
+			want:  []string{"git", "https://example.com/repo.git"},
+		},
+		"source selected on the command line": {
+			input: "source: git\ngit: {uri: https://example.com/repo.git}\ngithub: {org: [a]}\n",
+			args:  []string{"github"},
+			want:  []string{"github", "--org=a"},
+		},
+		"environment variables": {
+			input: "github:\n  token: ${TEST_TOKEN}\n  org: ['${TEST_ORG:-default}', '$$literal']\n",
+			args:  []string{"github"},
+			env:   map[string]string{"TEST_TOKEN": "secret"},
+			want:  []string{"github", "--org=default", "--org=$literal", "--token=secret"},
+		},
+		"detector settings are ignored": {
+			input: "concurrency: 1\ndetector_settings:\n- name: gitlab\n",
+			want:  []string{"--concurrency=1"},
+		},
+		"profile": {
+			input: `
+concurrency: 8
+only-verified: true
+github: {org: [a]}
+profiles:
+  ci-fast:
+    concurrency: 2
+    github: {token: secret}
+  deep-audit:
+    concurrency: 16
+`,
+			profile: "ci-fast",
+			args:    []string{"github"},
+			want:    []string{"github", "--concurrency=2", "--org=a", "--token=secret", "--only-verified"},
+		},
+		"profiles are ignored unless selected": {
+			input: "concurrency: 8\nprofiles:\n  deep-audit: {concurrency: 16}\n",
+			want:  []string{"--concurrency=8"},
+		},
+		"source in a profile": {
+			input:   "source: github\ngit: {uri: https://example.com/repo.git}\nprofiles:\n  repo: {source: git}\n",
+			profile: "repo",
+			want:    []string{"git", "https://example.com/repo.git"},
+		},
+		"unknown profile": {
+			input:   "profiles:\n  ci-fast: {concurrency: 2}\n",
+			profile: "ci",
+			wantErr: `profiles: no profile named "ci"`,
+		},
+		"error in a profile": {
+			input:   "profiles:\n  ci-fast:\n    github: {orgs: [a]}\n",
+			wantErr: "profiles.ci-fast.github.orgs: unknown flag or argument of the github command",
+		},
+		"detectors in a profile": {
+			input:   "profiles:\n  ci-fast:\n    detector_settings: []\n",
+			wantErr: "profiles.ci-fast.detector_settings: detectors are configured at the top level",
+		},
+		"unset environment variable": {
+			input:   "github:\n  token: ${TEST_UNSET_TOKEN}\n",
+			wantErr: "github.token: environment variable TEST_UNSET_TOKEN is not set",
+		},
+		"unknown flag": {
+			input:   "github:\n  orgs: [a]\n",
+			wantErr: "github.orgs: unknown flag or argument of the github command",
+		},
+		"unknown global flag": {
+			input:   "concurency: 8\n",
+			wantErr: "concurency: unknown flag or command",
+		},
+		"invalid value": {
+			input:   "concurrency: many\n",
+			wantErr: "concurrency: ",
+		},
+		"list for a single value": {
+			input:   "github:\n  token: [a, b]\n",
+			wantErr: "github.token: expected a single value, got a list",
+		},
+		"invalid boolean": {
+			input:   "only-verified: yes please\n",
+			wantErr: "only-verified: expected true or false",
+		},
+		"unknown source": {
+			input:   "source: gitlab\n",
+			wantErr: "source: expected the name of a command",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			got, err := CLIArgs(testApp(), []byte(tt.input), tt.profile, tt.args)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, [][]string{tt.want}, got)
+		})
+	}
+}
+
+func TestCLIArgsTargets(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		profile string
+		args    []string
+		want    [][]string
+		wantErr string
+	}{
+		"targets": {
+			input: `
+concurrency: 8
+github: {token: secret}
+targets:
+- github: {org: [a, b]}
+- s3: {bucket: [logs, backups]}
+- git: {uri: https://example.com/repo.git}
+`,
+			args: []string{"--only-verified"},
+			want: [][]string{
+				{"github", "--only-verified", "--concurrency=8", "--org=a", "--org=b", "--token=secret"},
+				{"s3", "--only-verified", "--concurrency=8", "--bucket=logs", "--bucket=backups"},
+				{"git", "--only-verified", "--concurrency=8", "https://example.com/repo.git"},
+			},
+		},
+		"target overrides the section of its command": {
+			input: "github: {org: [a], token: secret}\ntargets:\n- github: {org: [b]}\n",
+			want:  [][]string{{"github", "--org=b", "--token=secret"}},
+		},
+		"command selected on the command line": {
+			input: "github: {org: [a]}\ntargets:\n- s3: {bucket: [logs]}\n",
+			args:  []string{"github"},
+			want:  [][]string{{"github", "--org=a"}},
+		},
+		"targets in a profile": {
+			input:   "targets:\n- s3: {bucket: [logs]}\nprofiles:\n  code:\n    targets:\n    - github: {org: [a]}\n",
+			profile: "code",
+			want:    [][]string{{"github", "--org=a"}},
+		},
+		"source and targets": {
+			input:   "source: github\ntargets:\n- s3: {}\n",
+			wantErr: "source and targets can't both be set",
+		},
+		"command listed twice": {
+			input:   "targets:\n- s3: {bucket: [a]}\n- s3: {bucket: [b]}\n",
+			wantErr: "targets[1].s3: the s3 command can only be listed once",
+		},
+		"unknown command": {
+			input:   "targets:\n- gitlab: {}\n",
+			wantErr: "targets[0].gitlab: unknown command",
+		},
+		"error in a target": {
+			input:   "targets:\n- s3: {buckets: [a]}\n",
+			wantErr: "targets[0].s3.buckets: unknown flag or argument of the s3 command",
+		},
+		"error in an unused target": {
+			input:   "targets:\n- s3: {buckets: [a]}\n",
+			args:    []string{"github"},
+			wantErr: "targets[0].s3.buckets: unknown flag or argument of the s3 command",
+		},
+		"schedules are ignored": {
+			input: "concurrency: 8\nschedules:\n- {name: a, cron: '@daily', scan: {s3: {}}}\n",
+			want:  [][]string{{"--concurrency=8"}},
+		},
+		"not a list": {
+			input:   "targets: {s3: {}}\n",
+			wantErr: "targets: expected a list of targets",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := CLIArgs(testApp(), []byte(tt.input), tt.profile, tt.args)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,17 +1,44 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/custom_detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/entropy"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+	dpb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/protoyaml"
 )
 
 // Config holds user supplied configuration.
 type Config struct {
-	Detectors []detectors.Detector
+	Detectors        []detectors.Detector
+	DetectorSettings []DetectorSettings
+	IgnoreRules      []IgnoreRule
+}
+
+// DetectorSettings tunes the built-in detectors matching ID.
+type DetectorSettings struct {
+	ID DetectorID
+	// Keywords are added to the detector's keywords.
+	Keywords []string
+	// VerifierURLs replace the URLs the detector verifies secrets against,
+	// for detectors that support it.
+	VerifierURLs []string
+	// EntropyThresholds set the entropy thresholds of the charsets the
+	// entropy detector checks, by charset name.
+	EntropyThresholds map[string]float64
+	// Verify turns verification on or off for the detector, if set.
+	Verify *bool
 }
 
 // Read parses a given filename into a Config.
@@ -23,11 +50,22 @@ func Read(filename string) (*Config, error) {
 	return NewYAML(input)
 }
 
-// NewYAML parses the given YAML data into a Config.
+// NewYAML parses the given YAML data into a Config. The keys that set command
+// line flags are ignored, see CLIArgs.
 func NewYAML(input []byte) (*Config, error) {
+	doc, err := parseDocument(input)
+	if err != nil {
+		return nil, err
+	}
+	detectorsDoc, _ := splitDocument(doc)
+	detectorsJSON, err := json.Marshal(detectorsDoc)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse the raw YAML into a structure.
 	var messages custom_detectorspb.CustomDetectors
-	if err := protoyaml.UnmarshalStrict(input, &messages); err != nil {
+	if err := protoyaml.UnmarshalStrict(detectorsJSON, &messages); err != nil {
 		return nil, err
 	}
 	// Convert the structured YAML into detectors.
@@ -39,7 +77,143 @@ func NewYAML(input []byte) (*Config, error) {
 		}
 		detectors = append(detectors, detector)
 	}
+	var settings []DetectorSettings
+	for _, settingsConfig := range messages.DetectorSettings {
+		s, err := newDetectorSettings(settingsConfig)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, s)
+	}
+	var ignoreRules []IgnoreRule
+	for _, ruleConfig := range messages.Ignore {
+		rule, err := newIgnoreRule(ruleConfig)
+		if err != nil {
+			return nil, err
+		}
+		ignoreRules = append(ignoreRules, rule)
+	}
 	return &Config{
-		Detectors: detectors,
+		Detectors:        detectors,
+		DetectorSettings: settings,
+		IgnoreRules:      ignoreRules,
 	}, nil
 }
+
+func newDetectorSettings(settingsConfig *custom_detectorspb.DetectorSettings) (DetectorSettings, error) {
+	id, err := asDetectorID(settingsConfig.GetName())
+	if err != nil {
+		return DetectorSettings{}, fmt.Errorf("invalid detector settings: %w", err)
+	}
+	if len(settingsConfig.GetEntropyThresholds()) > 0 {
+		if id.ID != dpb.DetectorType_Entropy {
+			return DetectorSettings{}, fmt.Errorf("invalid detector settings for %s: only the entropy detector has entropy thresholds", id)
+		}
+		if _, err := entropy.CharsetsWithThresholds(settingsConfig.GetEntropyThresholds()); err != nil {
+			return DetectorSettings{}, fmt.Errorf("invalid detector settings for %s: %w", id, err)
+		}
+	}
+	return DetectorSettings{
+		ID:                id,
+		Keywords:          settingsConfig.GetKeywords(),
+		VerifierURLs:      settingsConfig.GetVerifierUrls(),
+		EntropyThresholds: settingsConfig.GetEntropyThresholds(),
+		Verify:            settingsConfig.Verify,
+	}, nil
+}
+
+// parseDocument parses a configuration file, and interpolates the environment
+// variables in its strings.
+func parseDocument(input []byte) (map[string]any, error) {
+	doc := map[string]any{}
+	if err := yaml.Unmarshal(input, &doc); err != nil {
+		return nil, err
+	}
+	for _, key := range sortedKeys(doc) {
+		interpolated, err := interpolate(key, doc[key])
+		if err != nil {
+			return nil, err
+		}
+		doc[key] = interpolated
+	}
+	return doc, nil
+}
+
+// splitDocument splits a configuration file into the keys that configure
+// detectors, which are the fields of the CustomDetectors message, and the keys
+// that set command line flags.
+func splitDocument(doc map[string]any) (detectorsDoc, cliDoc map[string]any) {
+	fields := (&custom_detectorspb.CustomDetectors{}).ProtoReflect().Descriptor().Fields()
+	detectorsDoc, cliDoc = map[string]any{}, map[string]any{}
+	for key, value := range doc {
+		if fields.ByJSONName(key) != nil || fields.ByTextName(key) != nil {
+			detectorsDoc[key] = value
+		} else {
+			cliDoc[key] = value
+		}
+	}
+	return detectorsDoc, cliDoc
+}
+
+// envReference matches the references to environment variables in strings,
+// ${NAME} or ${NAME:-default}, and the $$ escape. Bare $NAME references aren't
+// expanded, so that regular expressions ending lines keep working.
+var envReference = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// interpolate replaces the references to environment variables in the strings
+// of a value. Variables without a default must be set.
+func interpolate(key string, value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		var err error
+		expanded := envReference.ReplaceAllStringFunc(v, func(ref string) string {
+			if ref == "$$" {
+				return "$"
+			}
+			match := envReference.FindStringSubmatch(ref)
+			if val, ok := os.LookupEnv(match[1]); ok {
+				return val
+			}
+			if match[2] != "" {
+				return match[3]
+			}
+			if err == nil {
+				err = fmt.Errorf("%s: environment variable %s is not set", key, match[1])
+			}
+			return ""
+		})
+		return expanded, err
+	case []any:
+		for i, item := range v {
+			interpolated, err := interpolate(key+"["+strconv.Itoa(i)+"]", item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = interpolated
+		}
+	case map[string]any:
+		for _, k := range sortedKeys(v) {
+			interpolated, err := interpolate(key+"."+k, v[k])
+			if err != nil {
+				return nil, err
+			}
+			v[k] = interpolated
+		}
+	}
+	return value, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// flagName returns the name of the flag that a key sets. Like on the command
+// line, underscores can be used instead of dashes.
+func flagName(key string) string {
+	return strings.ReplaceAll(key, "_", "-")
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dpb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestDetectorSettings(t *testing.T) {
+	verify := false
+	tests := map[string]struct {
+		input    string
+		expected []DetectorSettings
+	}{
+		"settings": {
+			input: `
+detector_settings:
+- name: gitlab.v2
+  keywords: [glcorp]
+  verifier_urls: [https://gitlab.internal.corp]
+- name: Slack
+  verify: false
+- name: entropy
+  entropy_thresholds:
+    hex: 3.5
+`,
+			expected: []DetectorSettings{
+				{
+					ID:           DetectorID{ID: dpb.DetectorType_Gitlab, Version: 2},
+					Keywords:     []string{"glcorp"},
+					VerifierURLs: []string{"https://gitlab.internal.corp"},
+				},
+				{ID: DetectorID{ID: dpb.DetectorType_Slack}, Verify: &verify},
+				{ID: DetectorID{ID: dpb.DetectorType_Entropy}, EntropyThresholds: map[string]float64{"hex": 3.5}},
+			},
+		},
+		"unknown detector":          {input: "detector_settings:\n- name: hogwash\n"},
+		"unknown charset":           {input: "detector_settings:\n- name: entropy\n  entropy_thresholds: {octal: 2}\n"},
+		"thresholds of non-entropy": {input: "detector_settings:\n- name: gitlab\n  entropy_thresholds: {hex: 3}\n"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewYAML([]byte(tt.input))
+			if tt.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got.DetectorSettings)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	dpb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
 
@@ -68,6 +69,65 @@ func ParseDetectors(input string) ([]DetectorID, error) {
 	return output, nil
 }
 
+// Matches reports whether the detector is of the ID's type and, if the ID has
+// one, version. Detectors without versions don't match IDs with a version.
+func (id DetectorID) Matches(d detectors.Detector) bool {
+	if d.Type() != id.ID {
+		return false
+	}
+	if id.Version == 0 {
+		return true
+	}
+	versioner, ok := d.(detectors.Versioner)
+	return ok && versioner.Version() == id.Version
+}
+
+// IncludeFilter returns a filter for engine.WithFilterDetectors that keeps the
+// detectors matching any of the IDs.
+func IncludeFilter(ids []DetectorID) func(detectors.Detector) bool {
+	return func(d detectors.Detector) bool {
+		return matchesAny(ids, d)
+	}
+}
+
+// ExcludeFilter returns a filter for engine.WithFilterDetectors that drops the
+// detectors matching any of the IDs.
+func ExcludeFilter(ids []DetectorID) func(detectors.Detector) bool {
+	return func(d detectors.Detector) bool {
+		return !matchesAny(ids, d)
+	}
+}
+
+func matchesAny(ids []DetectorID, d detectors.Detector) bool {
+	for _, id := range ids {
+		if id.Matches(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckVersions returns an error for the first ID with a version that none of
+// the detectors have, e.g. a typo like gitlab.v3.
+func CheckVersions(ids []DetectorID, available []detectors.Detector) error {
+	for _, id := range ids {
+		if id.Version == 0 {
+			continue
+		}
+		found := false
+		for _, d := range available {
+			if id.Matches(d) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("detector %s has no version %d", dpb.DetectorType_name[int32(id.ID)], id.Version)
+		}
+	}
+	return nil
+}
+
 func (id DetectorID) String() string {
 	name := dpb.DetectorType_name[int32(id.ID)]
 	if name == "" {
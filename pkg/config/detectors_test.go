@@ -1,9 +1,12 @@
 package config
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	dpb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
 
@@ -45,3 +48,71 @@ func TestDetectorParsing(t *testing.T) {
 		})
 	}
 }
+
+type fakeDetector struct {
+	detectorType dpb.DetectorType
+	version      int
+}
+
+func (d fakeDetector) FromData(context.Context, bool, []byte) ([]detectors.Result, error) {
+	return nil, nil
+}
+
+func (d fakeDetector) Keywords() []string { return nil }
+
+func (d fakeDetector) Type() dpb.DetectorType { return d.detectorType }
+
+type fakeVersionedDetector struct {
+	fakeDetector
+}
+
+func (d fakeVersionedDetector) Version() int { return d.version }
+
+func TestDetectorFilters(t *testing.T) {
+	aws := fakeDetector{detectorType: dpb.DetectorType_AWS}
+	gitlabV1 := fakeVersionedDetector{fakeDetector{detectorType: dpb.DetectorType_Gitlab, version: 1}}
+	gitlabV2 := fakeVersionedDetector{fakeDetector{detectorType: dpb.DetectorType_Gitlab, version: 2}}
+	all := []detectors.Detector{aws, gitlabV1, gitlabV2}
+
+	tests := map[string]struct {
+		include  string
+		exclude  string
+		expected []detectors.Detector
+	}{
+		"all":                      {"all", "", all},
+		"type includes versions":   {"gitlab", "", []detectors.Detector{gitlabV1, gitlabV2}},
+		"one version":              {"gitlab.v2", "", []detectors.Detector{gitlabV2}},
+		"several versions":         {"gitlab.v1,gitlab.v2", "", []detectors.Detector{gitlabV1, gitlabV2}},
+		"version of unversioned":   {"aws.v1", "", nil},
+		"exclude version":          {"all", "gitlab.v1", []detectors.Detector{aws, gitlabV2}},
+		"exclude takes precedence": {"aws,gitlab", "gitlab", []detectors.Detector{aws}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			include, err := ParseDetectors(tt.include)
+			assert.NoError(t, err)
+			exclude, err := ParseDetectors(tt.exclude)
+			assert.NoError(t, err)
+
+			var got []detectors.Detector
+			for _, d := range all {
+				if IncludeFilter(include)(d) && ExcludeFilter(exclude)(d) {
+					got = append(got, d)
+				}
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCheckVersions(t *testing.T) {
+	available := []detectors.Detector{
+		fakeDetector{detectorType: dpb.DetectorType_AWS},
+		fakeVersionedDetector{fakeDetector{detectorType: dpb.DetectorType_Gitlab, version: 1}},
+	}
+
+	assert.NoError(t, CheckVersions([]DetectorID{{ID: dpb.DetectorType_AWS}, {ID: dpb.DetectorType_Gitlab, Version: 1}}, available))
+	assert.Error(t, CheckVersions([]DetectorID{{ID: dpb.DetectorType_Gitlab, Version: 3}}, available))
+	assert.Error(t, CheckVersions([]DetectorID{{ID: dpb.DetectorType_AWS, Version: 1}}, available))
+}
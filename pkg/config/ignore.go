@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+// IgnoreRule ignores the results that match all of its conditions, e.g. the
+// example keys of the AWS detector in testdata/**.
+type IgnoreRule struct {
+	// Detectors are the detectors the rule applies to. All detectors if
+	// empty.
+	Detectors []DetectorID
+	// Secrets match the ignored secrets. Any secret if empty.
+	Secrets []*regexp.Regexp
+	// Paths match the files of the ignored results. Any path if empty, and
+	// results without a file don't match rules with paths.
+	Paths  []*regexp.Regexp
+	Reason string
+}
+
+func newIgnoreRule(ruleConfig *custom_detectorspb.IgnoreRule) (IgnoreRule, error) {
+	if len(ruleConfig.GetDetectors()) == 0 && len(ruleConfig.GetSecrets()) == 0 && len(ruleConfig.GetPaths()) == 0 {
+		return IgnoreRule{}, fmt.Errorf("invalid ignore rule: it needs detectors, secrets or paths")
+	}
+	rule := IgnoreRule{Reason: ruleConfig.GetReason()}
+	for _, item := range ruleConfig.GetDetectors() {
+		ids, err := ParseDetectors(item)
+		if err != nil {
+			return IgnoreRule{}, fmt.Errorf("invalid ignore rule: %w", err)
+		}
+		rule.Detectors = append(rule.Detectors, ids...)
+	}
+	for _, secret := range ruleConfig.GetSecrets() {
+		re, err := regexp.Compile(secret)
+		if err != nil {
+			return IgnoreRule{}, fmt.Errorf("invalid ignore rule secret %q: %w", secret, err)
+		}
+		rule.Secrets = append(rule.Secrets, re)
+	}
+	for _, path := range ruleConfig.GetPaths() {
+		re, err := common.GlobToRegexp(path)
+		if err != nil {
+			return IgnoreRule{}, fmt.Errorf("invalid ignore rule path %q: %w", path, err)
+		}
+		rule.Paths = append(rule.Paths, re)
+	}
+	return rule, nil
+}
+
+// Matches reports whether the rule ignores a result of the detector, found in
+// the file at path, or an empty path if it wasn't found in a file.
+func (r IgnoreRule) Matches(detector detectors.Detector, result detectors.Result, path string) bool {
+	if len(r.Detectors) > 0 && !matchesAny(r.Detectors, detector) {
+		return false
+	}
+	if len(r.Secrets) > 0 && !matchesSecret(r.Secrets, result) {
+		return false
+	}
+	if len(r.Paths) > 0 && (path == "" || !matchesPath(r.Paths, path)) {
+		return false
+	}
+	return true
+}
+
+func matchesSecret(patterns []*regexp.Regexp, result detectors.Result) bool {
+	for _, re := range patterns {
+		if re.Match(result.Raw) || (len(result.RawV2) > 0 && re.Match(result.RawV2)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPath(patterns []*regexp.Regexp, path string) bool {
+	for _, re := range patterns {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
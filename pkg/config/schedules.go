@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// schedulesKey is the key of the scans that the daemon command runs on a
+// schedule.
+const schedulesKey = "schedules"
+
+// scheduleName matches the names of schedules, which name their state files.
+var scheduleName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// Schedule is a scan that the daemon command runs on a schedule.
+type Schedule struct {
+	Name string
+	// Cron is when the scan runs, in the cron format.
+	Cron string
+	// Args are the command line arguments of the scan. The flags that the
+	// rest of the configuration file sets aren't included.
+	Args []string
+}
+
+// ReadSchedules returns the schedules of a configuration file. See Schedules.
+func ReadSchedules(app *kingpin.Application, filename string) ([]Schedule, error) {
+	input, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Schedules(app, input)
+}
+
+// Schedules returns the scans that the schedules of a configuration file list.
+// Each has a unique name, a cron schedule, and a command with its flags and
+// arguments, like a target:
+//
+//	schedules:
+//	- name: org
+//	  cron: "0 */6 * * *"
+//	  scan:
+//	    github: {org: [trufflesecurity]}
+func Schedules(app *kingpin.Application, input []byte) ([]Schedule, error) {
+	doc, err := parseDocument(input)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := doc[schedulesKey]
+	if !ok {
+		return nil, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a list of schedules", schedulesKey)
+	}
+
+	schedules := make([]Schedule, 0, len(list))
+	seen := map[string]struct{}{}
+	for i, item := range list {
+		key := schedulesKey + "[" + strconv.Itoa(i) + "]"
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a schedule with a name, a cron schedule and a scan", key)
+		}
+		for _, k := range sortedKeys(m) {
+			if k != "name" && k != "cron" && k != "scan" {
+				return nil, fmt.Errorf("%s.%s: unknown key, expected name, cron or scan", key, k)
+			}
+		}
+
+		name, ok := m["name"].(string)
+		if !ok || !scheduleName.MatchString(name) {
+			return nil, fmt.Errorf("%s.name: expected a name of letters, digits, '.', '_' and '-'", key)
+		}
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("%s.name: there is another schedule named %q", key, name)
+		}
+		seen[name] = struct{}{}
+		cron, ok := m["cron"].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.cron: expected a cron schedule, e.g. \"0 * * * *\"", key)
+		}
+		t, err := targetOf(app, key+".scan", m["scan"])
+		if err != nil {
+			return nil, err
+		}
+
+		c := newCLIArgs(app, map[string]struct{}{}, t.cmd.FullCommand())
+		if err := c.collectCommand(t.cmd, t.key, t.section, true); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, Schedule{
+			Name: name,
+			Cron: cron,
+			Args: c.commandLine([]string{t.cmd.FullCommand()}),
+		})
+	}
+	return schedules, nil
+}
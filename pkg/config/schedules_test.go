@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedules(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    []Schedule
+		wantErr string
+	}{
+		"schedules": {
+			input: `
+concurrency: 8
+github: {token: secret}
+schedules:
+- name: org
+  cron: "0 */6 * * *"
+  scan:
+    github: {org: [a, b]}
+- name: repo
+  cron: "@daily"
+  scan:
+    git: {uri: https://example.com/repo.git}
+`,
+			want: []Schedule{
+				{Name: "org", Cron: "0 */6 * * *", Args: []string{"github", "--org=a", "--org=b"}},
+				{Name: "repo", Cron: "@daily", Args: []string{"git", "https://example.com/repo.git"}},
+			},
+		},
+		"no schedules": {
+			input: "concurrency: 8\n",
+			want:  nil,
+		},
+		"duplicate name": {
+			input:   "schedules:\n- {name: a, cron: '@daily', scan: {s3: {}}}\n- {name: a, cron: '@hourly', scan: {s3: {}}}\n",
+			wantErr: `schedules[1].name: there is another schedule named "a"`,
+		},
+		"invalid name": {
+			input:   "schedules:\n- {name: ../a, cron: '@daily', scan: {s3: {}}}\n",
+			wantErr: "schedules[0].name: expected a name",
+		},
+		"missing cron": {
+			input:   "schedules:\n- {name: a, scan: {s3: {}}}\n",
+			wantErr: "schedules[0].cron: expected a cron schedule",
+		},
+		"unknown key": {
+			input:   "schedules:\n- {name: a, cron: '@daily', every: 1h, scan: {s3: {}}}\n",
+			wantErr: "schedules[0].every: unknown key",
+		},
+		"error in the scan": {
+			input:   "schedules:\n- {name: a, cron: '@daily', scan: {s3: {buckets: [a]}}}\n",
+			wantErr: "schedules[0].scan.s3.buckets: unknown flag or argument of the s3 command",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Schedules(testApp(), []byte(tt.input))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package custom_detectors
+
+import (
+	"fmt"
+	"hash/crc32"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// celCostLimit stops validations that do too much work, e.g. comprehensions
+// over large chunks.
+const celCostLimit = 1_000_000
+
+// celEnv declares the variables and functions available to validations:
+//
+//	match     map(string, string)       The full match of each regex, by regex name.
+//	captures  map(string, list(string)) The capture groups of each regex, by regex name.
+//	data      string                    The data the matches were found in.
+//
+//	luhn(string) bool      Whether the digits of the string pass the Luhn check.
+//	crc32(string) int      The CRC-32 (IEEE) checksum of the string.
+//	entropy(string) double The Shannon entropy of the string.
+var celEnv = mustCELEnv()
+
+func mustCELEnv() *cel.Env {
+	env, err := cel.NewEnv(
+		ext.Strings(),
+		ext.Encoders(),
+		cel.Variable("match", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("captures", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+		cel.Variable("data", cel.StringType),
+		cel.Function("luhn",
+			cel.Overload("luhn_string", []*cel.Type{cel.StringType}, cel.BoolType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					return types.Bool(luhn(string(v.(types.String))))
+				}),
+			),
+		),
+		cel.Function("crc32",
+			cel.Overload("crc32_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					return types.Int(crc32.ChecksumIEEE([]byte(v.(types.String))))
+				}),
+			),
+		),
+		cel.Function("entropy",
+			cel.Overload("entropy_string", []*cel.Type{cel.StringType}, cel.DoubleType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					return types.Double(detectors.ShannonEntropy(string(v.(types.String))))
+				}),
+			),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return env
+}
+
+// compileValidation compiles a validation expression. The expression must
+// evaluate to a bool.
+func compileValidation(expr string) (cel.Program, error) {
+	ast, issues := celEnv.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !cel.BoolType.IsAssignableType(ast.OutputType()) {
+		return nil, fmt.Errorf("expression returns %s, not bool", ast.OutputType())
+	}
+	return celEnv.Program(ast, cel.CostLimit(celCostLimit))
+}
+
+// validMatch reports whether the match passes the validation. Matches fail
+// validation if it can't be evaluated.
+func validMatch(program cel.Program, match map[string][]string, data string) bool {
+	full := make(map[string]string, len(match))
+	captures := make(map[string][]string, len(match))
+	for name, values := range match {
+		// values[0] contains the entire regex match.
+		full[name] = values[0]
+		captures[name] = values[1:]
+	}
+	out, _, err := program.Eval(map[string]any{
+		"match":    full,
+		"captures": captures,
+		"data":     data,
+	})
+	if err != nil {
+		return false
+	}
+	valid, ok := out.Value().(bool)
+	return ok && valid
+}
+
+// luhn reports whether the digits of s pass the Luhn check. Separators like
+// spaces and dashes are ignored.
+func luhn(s string) bool {
+	var sum, digits int
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits > 1 && sum%10 == 0
+}
@@ -0,0 +1,106 @@
+package custom_detectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+func TestCustomDetectorsValidationCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{
+			name:  "Test valid expression",
+			input: `size(match.token) == 40 && luhn(captures.token[0])`,
+		},
+		{
+			name:    "Test syntax error",
+			input:   `size(match.token) ==`,
+			wantErr: true,
+		},
+		{
+			name:    "Test unknown variable",
+			input:   `size(secret) == 40`,
+			wantErr: true,
+		},
+		{
+			name:    "Test non-bool expression",
+			input:   `size(match.token)`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := compileValidation(tt.input)
+
+			if (got != nil && !tt.wantErr) || (got == nil && tt.wantErr) {
+				t.Errorf("compileValidation() error = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomDetectorsValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		validation string
+		data       string
+		want       []string
+	}{
+		{
+			name:       "Test length check",
+			validation: `size(captures.card[0]) == 16`,
+			data:       "card 4242424242424242 card 42424242",
+			want:       []string{"card 4242424242424242"},
+		},
+		{
+			name:       "Test checksum",
+			validation: `luhn(captures.card[0])`,
+			data:       "card 4242424242424242 card 4242424242424241",
+			want:       []string{"card 4242424242424242"},
+		},
+		{
+			name:       "Test context assertion",
+			validation: `!data.contains("example")`,
+			data:       "example card 4242424242424242",
+		},
+		{
+			name:       "Test evaluation error",
+			validation: `captures.card[1] == ""`,
+			data:       "card 4242424242424242",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+				Name:       "card",
+				Keywords:   []string{"card"},
+				Regex:      map[string]string{"card": `card (\d+)`},
+				Validation: tt.validation,
+			})
+			assert.NoError(t, err)
+
+			results, err := detector.FromData(context.Background(), false, []byte(tt.data))
+			assert.NoError(t, err)
+
+			var got []string
+			for _, result := range results {
+				got = append(got, string(result.Raw))
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestLuhn(t *testing.T) {
+	assert.True(t, luhn("4242424242424242"))
+	assert.True(t, luhn("4242-4242-4242-4242"))
+	assert.False(t, luhn("4242424242424241"))
+	assert.False(t, luhn("0"))
+}
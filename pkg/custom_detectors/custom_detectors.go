@@ -1,12 +1,12 @@
 package custom_detectors
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"net/http"
+	"fmt"
 	"regexp"
-	"strings"
+
+	"github.com/google/cel-go/cel"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
@@ -25,10 +25,17 @@ const maxTotalMatches = 100
 // initialization).
 type customRegexWebhook struct {
 	*custom_detectorspb.CustomRegex
+	// validation filters the matches, if the detector has a validation
+	// expression.
+	validation cel.Program
+	// successBodies are the compiled successBodyRegex of each verifier, or
+	// nil for verifiers without one.
+	successBodies []*regexp.Regexp
 }
 
 // Ensure the Scanner satisfies the interface at compile time.
 var _ detectors.Detector = (*customRegexWebhook)(nil)
+var _ detectors.Endpointer = (*customRegexWebhook)(nil)
 
 // NewWebhookCustomRegex initializes and validates a customRegexWebhook. An
 // unexported type is intentionally returned here to ensure the values have
@@ -42,6 +49,7 @@ func NewWebhookCustomRegex(pb *custom_detectorspb.CustomRegex) (*customRegexWebh
 		return nil, err
 	}
 
+	detector := &customRegexWebhook{CustomRegex: pb}
 	for _, verify := range pb.Verify {
 		if err := ValidateVerifyEndpoint(verify.Endpoint, verify.Unsafe); err != nil {
 			return nil, err
@@ -49,15 +57,75 @@ func NewWebhookCustomRegex(pb *custom_detectorspb.CustomRegex) (*customRegexWebh
 		if err := ValidateVerifyHeaders(verify.Headers); err != nil {
 			return nil, err
 		}
+		if err := ValidateVerifyRanges(verify.SuccessRanges); err != nil {
+			return nil, err
+		}
+		if verify.Method != "" {
+			if err := ValidateVerifyMethod(verify.Method); err != nil {
+				return nil, err
+			}
+			if err := ValidateRegexVars(pb.Regex, templates(verify)...); err != nil {
+				return nil, err
+			}
+		}
+
+		var successBody *regexp.Regexp
+		if verify.SuccessBodyRegex != "" {
+			var err error
+			successBody, err = regexp.Compile(verify.SuccessBodyRegex)
+			if err != nil {
+				return nil, fmt.Errorf("success body regex: %w", err)
+			}
+		}
+		detector.successBodies = append(detector.successBodies, successBody)
+	}
+
+	if pb.Validation != "" {
+		program, err := compileValidation(pb.Validation)
+		if err != nil {
+			return nil, fmt.Errorf("validation: %w", err)
+		}
+		detector.validation = program
 	}
 
 	// TODO: Copy only necessary data out of pb.
-	return &customRegexWebhook{pb}, nil
+	return detector, nil
 }
 
 var httpClient = common.SaneHttpClient()
 
 func (c *customRegexWebhook) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	matches, err := c.Matches(data)
+	if err != nil {
+		return nil, err
+	}
+
+	g := new(errgroup.Group)
+
+	// Create result object and test for verification.
+	resultsCh := make(chan detectors.Result, maxTotalMatches)
+	for _, match := range matches {
+		match := match
+		g.Go(func() error {
+			return c.createResults(ctx, match, verify, resultsCh)
+		})
+	}
+
+	// Ignore any errors and collect as many of the results as we can.
+	_ = g.Wait()
+	close(resultsCh)
+
+	for result := range resultsCh {
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// Matches returns the combinations of the matches of the detector's regexes
+// in data that pass its validation, by regex name. Each match is the whole
+// match followed by the capture groups of the regex.
+func (c *customRegexWebhook) Matches(data []byte) ([]map[string][]string, error) {
 	dataStr := string(data)
 	regexMatches := make(map[string][][]string, len(c.GetRegex()))
 
@@ -81,28 +149,14 @@ func (c *customRegexWebhook) FromData(ctx context.Context, verify bool, data []b
 	//    {"foo": ["match1"], "bar": ["match2"]},
 	//    {"foo": ["match1"], "bar": ["match3"]},
 	// ]
-	matches := permutateMatches(regexMatches)
-
-	g := new(errgroup.Group)
-
-	// Create result object and test for verification.
-	resultsCh := make(chan detectors.Result, maxTotalMatches)
-	for _, match := range matches {
-		match := match
-		g.Go(func() error {
-			return c.createResults(ctx, match, verify, resultsCh)
-		})
-	}
-
-	// Ignore any errors and collect as many of the results as we can.
-	_ = g.Wait()
-	close(resultsCh)
-
-	for result := range resultsCh {
-		results = append(results, result)
+	var matches []map[string][]string
+	for _, match := range permutateMatches(regexMatches) {
+		if c.validation != nil && !validMatch(c.validation, match, dataStr) {
+			continue
+		}
+		matches = append(matches, match)
 	}
-
-	return results, nil
+	return matches, nil
 }
 
 func (c *customRegexWebhook) createResults(ctx context.Context, match map[string][]string, verify bool, results chan<- detectors.Result) error {
@@ -139,34 +193,31 @@ func (c *customRegexWebhook) createResults(ctx context.Context, match map[string
 		return nil
 	}
 	// Try each config until we successfully verify.
-	for _, verifyConfig := range c.GetVerify() {
+	var verifyErr error
+	for i, verifyConfig := range c.GetVerify() {
 		if common.IsDone(ctx) {
 			// TODO: Log we're possibly leaving out results.
 			return ctx.Err()
 		}
-		req, err := http.NewRequestWithContext(ctx, "POST", verifyConfig.GetEndpoint(), bytes.NewReader(jsonBody))
+		req, err := newVerifyRequest(ctx, verifyConfig, match, raw, jsonBody)
 		if err != nil {
 			continue
 		}
-		for _, header := range verifyConfig.GetHeaders() {
-			key, value, found := strings.Cut(header, ":")
-			if !found {
-				// Should be unreachable due to validation.
-				continue
-			}
-			req.Header.Add(key, strings.TrimLeft(value, "\t\n\v\f\r "))
-		}
 		res, err := httpClient.Do(req)
 		if err != nil {
+			verifyErr = err
 			continue
 		}
-		// TODO: Read response body.
+		verified := verifiedResponse(res, verifyConfig.GetSuccessRanges(), c.successBody(i))
 		res.Body.Close()
-		if res.StatusCode == http.StatusOK {
+		if verified {
 			result.Verified = true
 			break
 		}
 	}
+	if !result.Verified {
+		result.SetVerificationError(verifyErr)
+	}
 
 	select {
 	case <-ctx.Done():
@@ -176,10 +227,27 @@ func (c *customRegexWebhook) createResults(ctx context.Context, match map[string
 	}
 }
 
+// successBody returns the compiled success body regex of the i-th verifier.
+func (c *customRegexWebhook) successBody(i int) *regexp.Regexp {
+	if i >= len(c.successBodies) {
+		return nil
+	}
+	return c.successBodies[i]
+}
+
 func (c *customRegexWebhook) Keywords() []string {
 	return c.GetKeywords()
 }
 
+// Endpoints returns the webhooks that matches are verified with.
+func (c *customRegexWebhook) Endpoints() []string {
+	endpoints := make([]string, 0, len(c.GetVerify()))
+	for _, verify := range c.GetVerify() {
+		endpoints = append(endpoints, verify.GetEndpoint())
+	}
+	return endpoints
+}
+
 // productIndices produces a permutation of indices for each length. Example:
 // productIndices(3, 2) -> [[0 0] [1 0] [2 0] [0 1] [1 1] [2 1]]. It returns
 // a slice of length no larger than maxTotalMatches.
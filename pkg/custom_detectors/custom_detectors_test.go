@@ -106,7 +106,7 @@ func TestCustomDetectorsParsing(t *testing.T) {
 
 func TestFromData_InvalidRegEx(t *testing.T) {
 	c := &customRegexWebhook{
-		&custom_detectorspb.CustomRegex{
+		CustomRegex: &custom_detectorspb.CustomRegex{
 			Name:     "Internal bi tool",
 			Keywords: []string{"secret_v1_", "pat_v2_"},
 			Regex: map[string]string{
@@ -208,6 +208,24 @@ func TestDetector(t *testing.T) {
 	assert.Equal(t, results[0].Raw, []byte(`password="123456"`))
 }
 
+func TestDetectorMatches(t *testing.T) {
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:     "test",
+		Keywords: []string{"id"},
+		Regex: map[string]string{
+			"id":     `id=(\w+)`,
+			"secret": `secret=(\w+)`,
+		},
+	})
+	assert.NoError(t, err)
+	matches, err := detector.Matches([]byte("id=abc secret=s1 secret=s2"))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []map[string][]string{
+		{"id": {"id=abc", "abc"}, "secret": {"secret=s1", "s1"}},
+		{"id": {"id=abc", "abc"}, "secret": {"secret=s2", "s2"}},
+	}, matches)
+}
+
 func BenchmarkProductIndices(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_ = productIndices(3, 2, 6)
@@ -24,15 +24,9 @@ func NewRegexVarString(original string) RegexVarString {
 
 	matches := nameGroupRegex.FindAllStringSubmatch(original, -1)
 	for _, match := range matches {
-		name, group := match[1], 0
-		// The second match will start with a period followed by any number
-		// of whitespace.
-		if len(match[2]) > 1 {
-			g, err := strconv.Atoi(strings.TrimSpace(match[2][1:]))
-			if err != nil {
-				continue
-			}
-			group = g
+		name, group, ok := parseNameGroup(match)
+		if !ok {
+			continue
 		}
 		variables[name] = group
 	}
@@ -42,3 +36,44 @@ func NewRegexVarString(original string) RegexVarString {
 		variables: variables,
 	}
 }
+
+// Fill replaces the variables of the string with the groups of a match.
+// Variables that aren't in the match are left as they are.
+func (r RegexVarString) Fill(match map[string][]string) string {
+	var filled strings.Builder
+	last := 0
+	for _, loc := range nameGroupRegex.FindAllStringSubmatchIndex(r.original, -1) {
+		submatch := make([]string, 0, len(loc)/2)
+		for i := 0; i < len(loc); i += 2 {
+			if loc[i] < 0 {
+				submatch = append(submatch, "")
+				continue
+			}
+			submatch = append(submatch, r.original[loc[i]:loc[i+1]])
+		}
+		name, group, ok := parseNameGroup(submatch)
+		if !ok || group >= len(match[name]) {
+			continue
+		}
+		filled.WriteString(r.original[last:loc[0]])
+		filled.WriteString(match[name][group])
+		last = loc[1]
+	}
+	filled.WriteString(r.original[last:])
+	return filled.String()
+}
+
+// parseNameGroup returns the name and group of a nameGroupRegex match.
+func parseNameGroup(match []string) (string, int, bool) {
+	name, group := match[1], 0
+	// The second match will start with a period followed by any number
+	// of whitespace.
+	if len(match[2]) > 1 {
+		g, err := strconv.Atoi(strings.TrimSpace(match[2][1:]))
+		if err != nil {
+			return "", 0, false
+		}
+		group = g
+	}
+	return name, group, true
+}
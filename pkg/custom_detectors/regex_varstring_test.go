@@ -74,3 +74,41 @@ func TestVarString(t *testing.T) {
 		})
 	}
 }
+
+func TestVarStringFill(t *testing.T) {
+	match := map[string][]string{
+		"id":     {"id=1234", "1234"},
+		"secret": {"abcd"},
+	}
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "no variables",
+			input: "https://example.com",
+			want:  "https://example.com",
+		},
+		{
+			name:  "full match",
+			input: "Bearer {secret}",
+			want:  "Bearer abcd",
+		},
+		{
+			name:  "subgroup",
+			input: "https://example.com/users/{ id . 1 }?q={id}",
+			want:  "https://example.com/users/1234?q=id=1234",
+		},
+		{
+			name:  "unknown variables",
+			input: "{unknown} {id.2}",
+			want:  "{unknown} {id.2}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewRegexVarString(tt.input).Fill(match))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package custom_detectors
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -43,6 +44,14 @@ func ValidateVerifyEndpoint(endpoint string, unsafe bool) error {
 	return nil
 }
 
+func ValidateVerifyMethod(method string) error {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return nil
+	}
+	return fmt.Errorf("unsupported method %q", method)
+}
+
 func ValidateVerifyHeaders(headers []string) error {
 	for _, header := range headers {
 		if !strings.Contains(header, ":") {
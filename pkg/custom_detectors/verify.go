@@ -0,0 +1,116 @@
+package custom_detectors
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+// secretVar is replaced with the secret in verification request templates.
+const secretVar = "{{secret}}"
+
+// maxSuccessBodySize caps how much of a response is read to match it against
+// the success body regex.
+const maxSuccessBodySize = 1 << 20
+
+// newVerifyRequest builds the request of a verifier. Verifiers with a method
+// send a request built from their templates. Other verifiers send the matches
+// to their endpoint as a JSON webhook.
+func newVerifyRequest(ctx context.Context, verifyConfig *custom_detectorspb.VerifierConfig, match map[string][]string, secret string, jsonBody []byte) (*http.Request, error) {
+	if verifyConfig.GetMethod() == "" {
+		req, err := http.NewRequestWithContext(ctx, "POST", verifyConfig.GetEndpoint(), bytes.NewReader(jsonBody))
+		if err != nil {
+			return nil, err
+		}
+		addHeaders(req, verifyConfig.GetHeaders())
+		return req, nil
+	}
+
+	fill := func(template string) string {
+		return strings.ReplaceAll(NewRegexVarString(template).Fill(match), secretVar, secret)
+	}
+	var body io.Reader
+	if verifyConfig.GetBody() != "" {
+		body = strings.NewReader(fill(verifyConfig.GetBody()))
+	}
+	req, err := http.NewRequestWithContext(ctx, verifyConfig.GetMethod(), fill(verifyConfig.GetEndpoint()), body)
+	if err != nil {
+		return nil, err
+	}
+	headers := make([]string, 0, len(verifyConfig.GetHeaders()))
+	for _, header := range verifyConfig.GetHeaders() {
+		headers = append(headers, fill(header))
+	}
+	addHeaders(req, headers)
+	return req, nil
+}
+
+func addHeaders(req *http.Request, headers []string) {
+	for _, header := range headers {
+		key, value, found := strings.Cut(header, ":")
+		if !found {
+			// Should be unreachable due to validation.
+			continue
+		}
+		req.Header.Add(key, strings.TrimLeft(value, "\t\n\v\f\r "))
+	}
+}
+
+// templates returns the templates of a verifier, without the secret variable.
+func templates(verifyConfig *custom_detectorspb.VerifierConfig) []string {
+	templates := []string{verifyConfig.GetEndpoint(), verifyConfig.GetBody()}
+	templates = append(templates, verifyConfig.GetHeaders()...)
+	for i, template := range templates {
+		templates[i] = strings.ReplaceAll(template, secretVar, "")
+	}
+	return templates
+}
+
+// verifiedResponse reports whether the status of the response is in the
+// success ranges, 200 if there are none, and whether its body matches the
+// success body regex, if there is one.
+func verifiedResponse(res *http.Response, successRanges []string, successBody *regexp.Regexp) bool {
+	if !inRanges(res.StatusCode, successRanges) {
+		return false
+	}
+	if successBody == nil {
+		return true
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxSuccessBodySize))
+	if err != nil {
+		return false
+	}
+	return successBody.Match(body)
+}
+
+// inRanges reports whether the status is in one of the ranges, which were
+// validated by ValidateVerifyRanges.
+func inRanges(status int, ranges []string) bool {
+	if len(ranges) == 0 {
+		return status == http.StatusOK
+	}
+	for _, successRange := range ranges {
+		lower, upper, found := strings.Cut(successRange, "-")
+		if !found {
+			upper = lower
+		}
+		lowerBound, err := strconv.Atoi(lower)
+		if err != nil {
+			continue
+		}
+		upperBound, err := strconv.Atoi(upper)
+		if err != nil {
+			continue
+		}
+		if lowerBound <= status && status <= upperBound {
+			return true
+		}
+	}
+	return false
+}
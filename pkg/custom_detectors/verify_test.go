@@ -0,0 +1,181 @@
+package custom_detectors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+func TestTemplateVerification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/users/1234" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Header.Get("Authorization") {
+		case "Bearer corp_valid":
+			_, _ = w.Write([]byte(`{"active":true}`))
+		case "Bearer corp_revoked":
+			_, _ = w.Write([]byte(`{"active":false}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		regex        map[string]string
+		verify       *custom_detectorspb.VerifierConfig
+		data         string
+		wantVerified bool
+	}{
+		{
+			name:  "Test secret variable",
+			regex: map[string]string{"token": `corp_[a-z]+`},
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:   http.MethodGet,
+				Endpoint: server.URL + "/users/1234",
+				Unsafe:   true,
+				Headers:  []string{"Authorization: Bearer {{secret}}"},
+			},
+			data:         "corp_valid",
+			wantVerified: true,
+		},
+		{
+			name: "Test regex variables",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:   http.MethodGet,
+				Endpoint: server.URL + "/users/{id.1}",
+				Unsafe:   true,
+				Headers:  []string{"Authorization: Bearer {token}"},
+			},
+			data:         "user 1234 corp_valid",
+			wantVerified: true,
+		},
+		{
+			name: "Test invalid secret",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:   http.MethodGet,
+				Endpoint: server.URL + "/users/{id.1}",
+				Unsafe:   true,
+				Headers:  []string{"Authorization: Bearer {token}"},
+			},
+			data: "user 1234 corp_invalid",
+		},
+		{
+			name: "Test success body",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:           http.MethodGet,
+				Endpoint:         server.URL + "/users/{id.1}",
+				Unsafe:           true,
+				Headers:          []string{"Authorization: Bearer {token}"},
+				SuccessBodyRegex: `"active":\s*true`,
+			},
+			data: "user 1234 corp_revoked",
+		},
+		{
+			name: "Test success ranges",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:        http.MethodGet,
+				Endpoint:      server.URL + "/users/{id.1}",
+				Unsafe:        true,
+				SuccessRanges: []string{"400-499"},
+			},
+			data:         "user 1234 corp_valid",
+			wantVerified: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regex := tt.regex
+			if regex == nil {
+				regex = map[string]string{
+					"id":    `user (\d+)`,
+					"token": `corp_[a-z]+`,
+				}
+			}
+			detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+				Name:     "corp",
+				Keywords: []string{"corp_"},
+				Regex:    regex,
+				Verify:   []*custom_detectorspb.VerifierConfig{tt.verify},
+			})
+			assert.NoError(t, err)
+
+			results, err := detector.FromData(context.Background(), true, []byte(tt.data))
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(results))
+			assert.Equal(t, tt.wantVerified, results[0].Verified)
+		})
+	}
+}
+
+func TestTemplateVerificationValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		verify  *custom_detectorspb.VerifierConfig
+		wantErr bool
+	}{
+		{
+			name: "Test secret variable",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:   http.MethodPost,
+				Endpoint: "https://example.com/verify",
+				Body:     `{"token": "{{secret}}"}`,
+			},
+		},
+		{
+			name: "Test unknown variable",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:   http.MethodGet,
+				Endpoint: "https://example.com/users/{user}",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test unsupported method",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:   "CONNECT",
+				Endpoint: "https://example.com/verify",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test invalid success body regex",
+			verify: &custom_detectorspb.VerifierConfig{
+				Method:           http.MethodGet,
+				Endpoint:         "https://example.com/verify",
+				SuccessBodyRegex: "(",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+				Name:     "corp",
+				Keywords: []string{"corp_"},
+				Regex:    map[string]string{"token": `corp_[a-z]+`},
+				Verify:   []*custom_detectorspb.VerifierConfig{tt.verify},
+			})
+
+			if (got != nil && !tt.wantErr) || (got == nil && tt.wantErr) {
+				t.Errorf("NewWebhookCustomRegex() error = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInRanges(t *testing.T) {
+	assert.True(t, inRanges(200, nil))
+	assert.False(t, inRanges(204, nil))
+	assert.True(t, inRanges(204, []string{"200-299"}))
+	assert.True(t, inRanges(403, []string{"200", "403"}))
+	assert.False(t, inRanges(500, []string{"200-299", "403"}))
+}
@@ -0,0 +1,48 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronNext(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2023, 5, 17, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2023, 5, 17, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2023, 5, 17, 10, 45, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2023, 5, 17, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2023, 5, 18, 0, 0, 0, 0, time.UTC)},
+		{"30 2 * * 1-5", time.Date(2023, 5, 18, 2, 30, 0, 0, time.UTC)},
+		{"0 9 * * 0", time.Date(2023, 5, 21, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 7", time.Date(2023, 5, 21, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)},
+		// Either the day of month or of week matches.
+		{"0 0 20 * 5", time.Date(2023, 5, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 */2 * *", time.Date(2023, 5, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			c, err := ParseCron(tt.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, c.Next(from))
+		})
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@often"} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseCron(spec)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+// Package daemon runs scans on cron schedules, and sends the results that
+// weren't reported by earlier runs to sinks.
+package daemon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// Job is a scan that runs on a schedule.
+type Job struct {
+	// Name names the job in the state directory, the sinks and the metrics.
+	Name string
+	Cron *Cron
+	// Args are the arguments of the command that runs the scan.
+	Args []string
+}
+
+// Config configures the daemon.
+type Config struct {
+	// Command is the executable that runs the scans. It must print their
+	// results in the JSON format of --json.
+	Command string
+	Jobs    []Job
+	// StateDir is the directory where the state of each job is kept, so that
+	// results aren't reported again when the daemon restarts.
+	StateDir string
+	Sinks    []Sink
+	// Listen is the address of the health and metrics endpoints. They aren't
+	// served if it's empty.
+	Listen string
+}
+
+// state is the state of a job, kept between runs of the daemon.
+type state struct {
+	LastRun     time.Time `json:"last_run"`
+	LastSuccess time.Time `json:"last_success"`
+	LastError   string    `json:"last_error,omitempty"`
+	// Reported are the fingerprints of the results that were sent to the
+	// sinks, with the time of the scan that found them first.
+	Reported map[string]time.Time `json:"reported"`
+}
+
+// status is the status of a job, for the metrics.
+type status struct {
+	scans, failures   uint64
+	results, verified uint64
+	running           bool
+	lastSuccess, next time.Time
+	lastScanDuration  time.Duration
+}
+
+type daemon struct {
+	cfg Config
+
+	mu       sync.Mutex
+	statuses map[string]*status
+}
+
+// Run runs the jobs on their schedules until ctx is canceled. A job doesn't
+// run again until its previous run finished.
+func Run(ctx context.Context, cfg Config) error {
+	if len(cfg.Jobs) == 0 {
+		return errors.New("no jobs to run")
+	}
+	d := &daemon{cfg: cfg, statuses: map[string]*status{}}
+	for _, job := range cfg.Jobs {
+		if _, ok := d.statuses[job.Name]; ok {
+			return fmt.Errorf("there are two jobs named %q", job.Name)
+		}
+		if job.Cron.Next(time.Now()).IsZero() {
+			return fmt.Errorf("job %q is never scheduled", job.Name)
+		}
+		d.statuses[job.Name] = &status{}
+	}
+	if err := os.MkdirAll(cfg.StateDir, 0o700); err != nil {
+		return fmt.Errorf("could not create the state directory: %w", err)
+	}
+
+	if cfg.Listen != "" {
+		listener, err := net.Listen("tcp", cfg.Listen)
+		if err != nil {
+			return err
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, "ok\n")
+		})
+		mux.HandleFunc("/metrics", d.serveMetrics)
+		server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				ctx.Logger().Error(err, "error serving the health and metrics endpoints")
+			}
+		}()
+		defer server.Close()
+		ctx.Logger().Info("serving the health and metrics endpoints", "address", listener.Addr().String())
+	}
+
+	var wg sync.WaitGroup
+	for _, job := range cfg.Jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			d.schedule(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+	return nil
+}
+
+// schedule runs a job on its schedule until ctx is canceled.
+func (d *daemon) schedule(ctx context.Context, job Job) {
+	logger := ctx.Logger().WithValues("job", job.Name)
+	for {
+		next := job.Cron.Next(time.Now())
+		d.update(job.Name, func(s *status) { s.next = next })
+		logger.V(2).Info("waiting for the next scan", "time", next)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := d.run(ctx, job); err != nil {
+			logger.Error(err, "scan failed")
+		}
+	}
+}
+
+// run runs a job once and sends its new results to the sinks. Results are
+// only remembered as reported once every sink accepted them, so a sink that
+// fails gets them again on the next run.
+func (d *daemon) run(ctx context.Context, job Job) error {
+	st, err := d.loadState(job.Name)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	d.update(job.Name, func(s *status) { s.running = true })
+	results, scanErr := runScan(ctx, d.cfg.Command, job.Args)
+
+	var unreported []scanResult
+	var raw []json.RawMessage
+	for _, r := range results {
+		if _, ok := st.Reported[r.fingerprint]; !ok {
+			unreported = append(unreported, r)
+			raw = append(raw, r.raw)
+		}
+	}
+	var sendErr error
+	if len(raw) > 0 {
+		for _, sink := range d.cfg.Sinks {
+			if err := sink.Send(ctx, job.Name, raw); err != nil {
+				sendErr = errors.Join(sendErr, err)
+			}
+		}
+	}
+	if sendErr == nil {
+		for _, r := range unreported {
+			st.Reported[r.fingerprint] = start
+		}
+	}
+
+	err = errors.Join(scanErr, sendErr)
+	st.LastRun = start
+	st.LastError = ""
+	if err == nil {
+		st.LastSuccess = start
+	} else {
+		st.LastError = err.Error()
+	}
+	saveErr := d.saveState(job.Name, st)
+
+	d.update(job.Name, func(s *status) {
+		s.running = false
+		s.scans++
+		s.lastScanDuration = time.Since(start)
+		if err != nil {
+			s.failures++
+		} else {
+			s.lastSuccess = start
+		}
+		if sendErr == nil {
+			for _, r := range unreported {
+				s.results++
+				if r.verified {
+					s.verified++
+				}
+			}
+		}
+	})
+	ctx.Logger().V(1).Info("scan finished", "job", job.Name, "results", len(results), "new_results", len(unreported), "duration", time.Since(start))
+	return errors.Join(err, saveErr)
+}
+
+func (d *daemon) update(name string, f func(*status)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f(d.statuses[name])
+}
+
+func (d *daemon) statePath(name string) string {
+	return filepath.Join(d.cfg.StateDir, name+".json")
+}
+
+func (d *daemon) loadState(name string) (*state, error) {
+	st := &state{}
+	data, err := os.ReadFile(d.statePath(name))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("could not read the state of the job: %w", err)
+	default:
+		if err := json.Unmarshal(data, st); err != nil {
+			return nil, fmt.Errorf("could not parse the state of the job: %w", err)
+		}
+	}
+	if st.Reported == nil {
+		st.Reported = map[string]time.Time{}
+	}
+	return st, nil
+}
+
+// saveState writes the state of a job to a temporary file first, so that a
+// crash doesn't leave it half written.
+func (d *daemon) saveState(name string, st *state) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	path := d.statePath(name)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("could not write the state of the job: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// scanResult is a result printed by a scan.
+type scanResult struct {
+	raw json.RawMessage
+	// fingerprint identifies the result between runs.
+	fingerprint string
+	verified    bool
+}
+
+// runScan runs a scan and reads its results. The output of the scan on stderr,
+// like its logs, goes to the stderr of the daemon.
+func runScan(ctx context.Context, command string, args []string) ([]scanResult, error) {
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	results, readErr := readResults(stdout)
+	if readErr != nil {
+		// The scan would block on a full pipe otherwise.
+		_, _ = io.Copy(io.Discard, stdout)
+	}
+	if err := cmd.Wait(); err != nil {
+		return results, errors.Join(readErr, fmt.Errorf("scan failed: %w", err))
+	}
+	return results, readErr
+}
+
+// readResults reads results in the JSON format of --json.
+func readResults(r io.Reader) ([]scanResult, error) {
+	var results []scanResult
+	decoder := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return results, fmt.Errorf("could not read the results of the scan: %w", err)
+		}
+		var v struct {
+			SourceMetadata json.RawMessage
+			DetectorName   string
+			DecoderName    string
+			Raw            string
+			RawV2          string
+			Verified       bool
+			Manifest       json.RawMessage
+		}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return results, fmt.Errorf("could not read the results of the scan: %w", err)
+		}
+		// The manifest of the scan isn't a result.
+		if v.Manifest != nil {
+			continue
+		}
+		// Verification details in the extra data may change between runs,
+		// but the verified status is part of the fingerprint, so a result
+		// that becomes verified is reported again.
+		h := sha256.New()
+		for _, part := range []string{string(v.SourceMetadata), v.DetectorName, v.DecoderName, v.Raw, v.RawV2, fmt.Sprint(v.Verified)} {
+			h.Write([]byte(part))
+			h.Write([]byte{0})
+		}
+		results = append(results, scanResult{raw: raw, fingerprint: hex.EncodeToString(h.Sum(nil)), verified: v.Verified})
+	}
+}
+
+// serveMetrics serves the status of the jobs in the Prometheus text format.
+func (d *daemon) serveMetrics(w http.ResponseWriter, _ *http.Request) {
+	d.mu.Lock()
+	names := make([]string, 0, len(d.statuses))
+	statuses := make(map[string]status, len(d.statuses))
+	for name, s := range d.statuses {
+		names = append(names, name)
+		statuses[name] = *s
+	}
+	d.mu.Unlock()
+	sort.Strings(names)
+
+	var b strings.Builder
+	metric := func(name, kind, help string, value func(s status) float64) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+		for _, job := range names {
+			fmt.Fprintf(&b, "%s{job=%q} %g\n", name, job, value(statuses[job]))
+		}
+	}
+	timestamp := func(t time.Time) float64 {
+		if t.IsZero() {
+			return 0
+		}
+		return float64(t.Unix())
+	}
+	metric("trufflehog_daemon_scans_total", "counter", "Scans run.", func(s status) float64 { return float64(s.scans) })
+	metric("trufflehog_daemon_scan_failures_total", "counter", "Scans that failed or whose results couldn't be sent.", func(s status) float64 { return float64(s.failures) })
+	metric("trufflehog_daemon_results_total", "counter", "New results sent to the sinks.", func(s status) float64 { return float64(s.results) })
+	metric("trufflehog_daemon_verified_results_total", "counter", "New verified results sent to the sinks.", func(s status) float64 { return float64(s.verified) })
+	metric("trufflehog_daemon_scan_running", "gauge", "Whether a scan is running.", func(s status) float64 {
+		if s.running {
+			return 1
+		}
+		return 0
+	})
+	metric("trufflehog_daemon_last_scan_duration_seconds", "gauge", "Duration of the last scan.", func(s status) float64 { return s.lastScanDuration.Seconds() })
+	metric("trufflehog_daemon_last_success_timestamp_seconds", "gauge", "Time of the last successful scan.", func(s status) float64 { return timestamp(s.lastSuccess) })
+	metric("trufflehog_daemon_next_scan_timestamp_seconds", "gauge", "Time of the next scan.", func(s status) float64 { return timestamp(s.next) })
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = io.WriteString(w, b.String())
+}
@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// recordingSink records the results it receives, or fails.
+type recordingSink struct {
+	mu      sync.Mutex
+	results []string
+	err     error
+}
+
+func (s *recordingSink) Send(_ context.Context, _ string, results []json.RawMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, r := range results {
+		s.results = append(s.results, string(r))
+	}
+	return nil
+}
+
+func TestRunReportsNewResults(t *testing.T) {
+	ctx := context.Background()
+	first := `{"DetectorName":"AWS","Raw":"AKIA1","Verified":true}`
+	second := `{"DetectorName":"Github","Raw":"ghp_1","Verified":false}`
+	output := filepath.Join(t.TempDir(), "output.json")
+	job := Job{Name: "test", Args: []string{"-c", "cat " + output}}
+	sink := &recordingSink{}
+	d := &daemon{
+		cfg:      Config{Command: "sh", StateDir: t.TempDir(), Sinks: []Sink{sink}},
+		statuses: map[string]*status{"test": {}},
+	}
+
+	manifest := `{"Manifest":{"RunID":"1b4e28ba-2fa1-4d3b-a3f5-ef19b5a7633b"}}`
+	assert.NoError(t, os.WriteFile(output, []byte(first+"\n"+manifest+"\n"), 0o600))
+	assert.NoError(t, d.run(ctx, job))
+	assert.Equal(t, []string{first}, sink.results)
+
+	// Results that were sent already aren't sent again.
+	assert.NoError(t, os.WriteFile(output, []byte(first+"\n"+second+"\n"), 0o600))
+	assert.NoError(t, d.run(ctx, job))
+	assert.Equal(t, []string{first, second}, sink.results)
+
+	// Results that a sink refused are sent again.
+	third := `{"DetectorName":"Slack","Raw":"xoxb-1","Verified":true}`
+	assert.NoError(t, os.WriteFile(output, []byte(third+"\n"), 0o600))
+	sink.err = errors.New("unavailable")
+	assert.Error(t, d.run(ctx, job))
+	sink.err = nil
+	assert.NoError(t, d.run(ctx, job))
+	assert.Equal(t, []string{first, second, third}, sink.results)
+
+	st, err := d.loadState("test")
+	assert.NoError(t, err)
+	assert.Len(t, st.Reported, 3)
+	assert.Empty(t, st.LastError)
+
+	s := d.statuses["test"]
+	assert.Equal(t, uint64(4), s.scans)
+	assert.Equal(t, uint64(1), s.failures)
+	assert.Equal(t, uint64(3), s.results)
+	assert.Equal(t, uint64(2), s.verified)
+
+	recorder := httptest.NewRecorder()
+	d.serveMetrics(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, recorder.Body.String(), `trufflehog_daemon_scans_total{job="test"} 4`)
+}
+
+func TestRunFailedScan(t *testing.T) {
+	d := &daemon{
+		cfg:      Config{Command: "sh", StateDir: t.TempDir()},
+		statuses: map[string]*status{"test": {}},
+	}
+	err := d.run(context.Background(), Job{Name: "test", Args: []string{"-c", "exit 3"}})
+	assert.ErrorContains(t, err, "scan failed")
+
+	st, err := d.loadState("test")
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(st.LastError, "exit status 3"))
+	assert.True(t, st.LastSuccess.IsZero())
+}
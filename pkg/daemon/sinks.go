@@ -0,0 +1,87 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// Sink receives the new results of the scans of a schedule, in the JSON
+// format of --json.
+type Sink interface {
+	Send(ctx context.Context, schedule string, results []json.RawMessage) error
+}
+
+// WebhookSink posts the results of each scan to a URL as a JSON object with the
+// name of the schedule and the list of results.
+type WebhookSink struct {
+	URL string
+	// Client defaults to a client that retries failed requests.
+	Client *http.Client
+}
+
+// Send implements Sink.
+func (s *WebhookSink) Send(ctx context.Context, schedule string, results []json.RawMessage) error {
+	body, err := json.Marshal(struct {
+		Schedule string            `json:"schedule"`
+		Results  []json.RawMessage `json:"results"`
+	}{schedule, results})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := s.Client
+	if client == nil {
+		client = common.RetryableHttpClientTimeout(30)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook %s responded with status %d", s.URL, res.StatusCode)
+	}
+	return nil
+}
+
+// FileSink appends the results of each scan to a file, one per line.
+type FileSink struct {
+	Path string
+
+	// mu keeps the results of scans that finish at the same time apart.
+	mu sync.Mutex
+}
+
+// Send implements Sink.
+func (s *FileSink) Send(_ context.Context, _ string, results []json.RawMessage) error {
+	var buf bytes.Buffer
+	for _, r := range results {
+		buf.Write(r)
+		buf.WriteByte('\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
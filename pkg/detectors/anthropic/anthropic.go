@@ -0,0 +1,142 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	// API keys look like sk-ant-api03-..., admin keys like sk-ant-admin01-...
+	keyPat = regexp.MustCompile(`\b(sk-ant-(?:api|admin)\d{2}-[a-zA-Z0-9_\-]{93}AA)\b`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"sk-ant-"}
+}
+
+// FromData will find and optionally verify Anthropic secrets in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		// Keep the key kind, e.g. sk-ant-api03-, in the redacted key.
+		kind := token[:len("sk-ant-")+strings.Index(token[len("sk-ant-"):], "-")+1]
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_Anthropic,
+			Raw:          []byte(token),
+			Redacted:     kind + "..." + token[len(token)-4:],
+		}
+
+		if verify {
+			verified, extraData, err := verifyToken(ctx, token)
+			if err == nil {
+				s1.Verified = verified
+				s1.ExtraData = extraData
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// verifyToken returns the organization the key belongs to. API keys are
+// checked by listing models, which doesn't use any credit. Admin keys can
+// only use the admin API, which describes the organization.
+func verifyToken(ctx context.Context, token string) (bool, map[string]string, error) {
+	if strings.HasPrefix(token, "sk-ant-admin") {
+		return verifyAdminToken(ctx, token)
+	}
+
+	res, err := get(ctx, "https://api.anthropic.com/v1/models", token)
+	if err != nil {
+		return false, nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var extraData map[string]string
+		if orgID := res.Header.Get("anthropic-organization-id"); orgID != "" {
+			extraData = map[string]string{"organization_id": orgID}
+		}
+		return true, extraData, nil
+	case http.StatusUnauthorized:
+		return false, nil, nil
+	default:
+		return false, nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+type organizationResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func verifyAdminToken(ctx context.Context, token string) (bool, map[string]string, error) {
+	res, err := get(ctx, "https://api.anthropic.com/v1/organizations/me", token)
+	if err != nil {
+		return false, nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var org organizationResponse
+		if err := json.NewDecoder(res.Body).Decode(&org); err != nil {
+			return false, nil, err
+		}
+		return true, map[string]string{
+			"organization_id":   org.ID,
+			"organization_name": org.Name,
+			"admin":             "true",
+		}, nil
+	case http.StatusUnauthorized:
+		return false, nil, nil
+	default:
+		return false, nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+func get(ctx context.Context, url, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("x-api-key", token)
+	req.Header.Add("anthropic-version", "2023-06-01")
+	return client.Do(req)
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_Anthropic
+}
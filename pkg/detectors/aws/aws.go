@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -44,8 +46,9 @@ func WithSkipIDs(skipIDs []string) func(*scanner) {
 	}
 }
 
-// Ensure the scanner satisfies the interface at compile time.
+// Ensure the scanner satisfies the interfaces at compile time.
 var _ detectors.Detector = (*scanner)(nil)
+var _ detectors.OfflineValidator = (*scanner)(nil)
 
 var (
 	client = common.SaneHttpClient()
@@ -58,6 +61,24 @@ var (
 	// But they are extremely unlikely to be generated as an actual AWS secret.
 	// So when we find them, if they're not verified, we should ignore the result.
 	falsePositiveSecretCheck = regexp.MustCompile(`[a-f0-9]{40}`)
+
+	// Key IDs are base32 encoded, so they never contain 0, 1, 8, or 9.
+	validIDPat = regexp.MustCompile(`^(?:AKIA|ABIA|ACCA|ASIA)[A-Z2-7]{16}$`)
+
+	// canaryAccounts are the AWS accounts of the keys that Thinkst
+	// Canarytokens hands out. Any use of such a key, verification included,
+	// alerts whoever planted it.
+	canaryAccounts = map[string]struct{}{
+		"052310077262": {},
+		"171436882533": {},
+		"266735846894": {},
+		"534261010715": {},
+		"595918472158": {},
+		"717712589309": {},
+		"730567214498": {},
+		"819147034852": {},
+		"992382622183": {},
+	}
 )
 
 // Keywords are used for efficiently pre-filtering chunks.
@@ -103,6 +124,9 @@ func (s scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 			}
 		}
 
+		account, _ := accountID(resIDMatch)
+		_, canary := canaryAccounts[account]
+
 		for _, secretMatch := range secretMatches {
 			if len(secretMatch) != 2 {
 				continue
@@ -116,7 +140,15 @@ func (s scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 				RawV2:        []byte(resIDMatch + resSecretMatch),
 			}
 
-			if verify {
+			// Canary keys are reported without verifying them, since that
+			// would trip them.
+			if canary {
+				s1.Canary = true
+				s1.ExtraData = map[string]string{"account": account}
+				s1.TypedExtraData = &detectorspb.ExtraData{Account: account}
+			}
+
+			if verify && !canary {
 				// REQUEST VALUES.
 				method := "GET"
 				service := "sts"
@@ -178,11 +210,19 @@ func (s scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 						identityInfo := identityRes{}
 						err := json.NewDecoder(res.Body).Decode(&identityInfo)
 						if err == nil {
+							identity := identityInfo.GetCallerIdentityResponse.GetCallerIdentityResult
 							s1.Verified = true
 							s1.ExtraData = map[string]string{
-								"account": identityInfo.GetCallerIdentityResponse.GetCallerIdentityResult.Account,
-								"user_id": identityInfo.GetCallerIdentityResponse.GetCallerIdentityResult.UserID,
-								"arn":     identityInfo.GetCallerIdentityResponse.GetCallerIdentityResult.Arn,
+								"account": identity.Account,
+								"user_id": identity.UserID,
+								"arn":     identity.Arn,
+							}
+							s1.TypedExtraData = &detectorspb.ExtraData{
+								Account: identity.Account,
+								Family: &detectorspb.ExtraData_Aws{Aws: &detectorspb.AWSExtraData{
+									Arn:    identity.Arn,
+									UserId: identity.UserID,
+								}},
 							}
 						}
 						res.Body.Close()
@@ -237,6 +277,34 @@ func awsCustomCleanResults(results []detectors.Result) []detectors.Result {
 	return out
 }
 
+// accountID decodes the ID of the AWS account that owns a key from the key
+// ID. Only the key IDs issued since 2019 have it: older ones start with AKIAI
+// or AKIAJ.
+func accountID(id string) (string, bool) {
+	if !validIDPat.MatchString(id) || id[4] == 'I' || id[4] == 'J' {
+		return "", false
+	}
+	decoded, err := base32.StdEncoding.DecodeString(id[4:])
+	if err != nil {
+		return "", false
+	}
+	var data [8]byte
+	copy(data[2:], decoded[:6])
+	account := (binary.BigEndian.Uint64(data[:]) & 0x7fffffffff80) >> 7
+	return fmt.Sprintf("%012d", account), true
+}
+
+// ValidateOffline checks that the key ID is validly encoded and that the
+// secret doesn't look like a hash.
+func (s scanner) ValidateOffline(result detectors.Result) bool {
+	id := string(result.Raw)
+	if !validIDPat.MatchString(id) {
+		return false
+	}
+	secret := strings.TrimPrefix(string(result.RawV2), id)
+	return len(secret) == 40 && !falsePositiveSecretCheck.MatchString(secret)
+}
+
 type identityRes struct {
 	GetCallerIdentityResponse struct {
 		GetCallerIdentityResult struct {
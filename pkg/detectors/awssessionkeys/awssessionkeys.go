@@ -0,0 +1,183 @@
+package awssessionkeys
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/aws"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	idPat     = regexp.MustCompile(`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`)
+	secretPat = regexp.MustCompile(`(?:[^A-Za-z0-9+/]|\A)([A-Za-z0-9+/]{40})(?:[^A-Za-z0-9+/]|\z)`)
+	// Session tokens are long base64 strings, much longer than the secret.
+	sessionPat = regexp.MustCompile(`(?:[^A-Za-z0-9+/]|\A)([A-Za-z0-9+/]{100,}={0,3})(?:[^A-Za-z0-9+/=]|\z)`)
+
+	// Hashes, like those for git, do technically match the secret pattern.
+	falsePositiveSecretCheck = regexp.MustCompile(`^[a-f0-9]{40}$`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"AKIA", "ASIA"}
+}
+
+// FromData will find and optionally verify AWS session credentials, an access
+// key ID, secret key, and session token found together, in a given set of
+// bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	idMatches := idPat.FindAllStringSubmatch(dataStr, -1)
+	secretMatches := secretPat.FindAllStringSubmatch(dataStr, -1)
+	sessionMatches := sessionPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, idMatch := range idMatches {
+		id := idMatch[1]
+
+		var idResults []detectors.Result
+	candidates:
+		for _, secretMatch := range secretMatches {
+			secret := secretMatch[1]
+			if falsePositiveSecretCheck.MatchString(secret) {
+				continue
+			}
+			for _, sessionMatch := range sessionMatches {
+				session := sessionMatch[1]
+
+				s1 := detectors.Result{
+					DetectorType: detectorspb.DetectorType_AWSSessionKey,
+					Raw:          []byte(id),
+					Redacted:     id,
+					RawV2:        []byte(id + secret + session),
+				}
+
+				if verify {
+					verified, extraData, err := verifyCredentials(ctx, id, secret, session)
+					if err == nil && verified {
+						s1.Verified = true
+						s1.ExtraData = extraData
+					}
+				}
+
+				// One result per ID is enough, preferably a verified one.
+				if s1.Verified {
+					idResults = []detectors.Result{s1}
+					break candidates
+				}
+				if len(idResults) == 0 && !detectors.IsKnownFalsePositive(secret, detectors.DefaultFalsePositives, true) {
+					idResults = append(idResults, s1)
+				}
+			}
+		}
+		results = append(results, idResults...)
+	}
+
+	return results, nil
+}
+
+// verifyCredentials calls STS GetCallerIdentity with the session credentials.
+func verifyCredentials(ctx context.Context, id, secret, session string) (bool, map[string]string, error) {
+	method := "GET"
+	service := "sts"
+	host := "sts.amazonaws.com"
+	region := "us-east-1"
+	endpoint := "https://sts.amazonaws.com"
+	now := time.Now().UTC()
+	datestamp := now.Format("20060102")
+	amzDate := now.Format("20060102T150405Z0700")
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return false, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// https://docs.aws.amazon.com/general/latest/gr/sigv4-create-canonical-request.html
+	canonicalURI := "/"
+	canonicalHeaders := "host:" + host + "\n"
+	signedHeaders := "host"
+	algorithm := "AWS4-HMAC-SHA256"
+	credentialScope := fmt.Sprintf("%s/%s/%s/aws4_request", datestamp, region, service)
+
+	params := req.URL.Query()
+	params.Add("Action", "GetCallerIdentity")
+	params.Add("Version", "2011-06-15")
+	params.Add("X-Amz-Algorithm", algorithm)
+	params.Add("X-Amz-Credential", id+"/"+credentialScope)
+	params.Add("X-Amz-Date", amzDate)
+	params.Add("X-Amz-Expires", "30")
+	// Temporary credentials are only valid together with their session token.
+	params.Add("X-Amz-Security-Token", session)
+	params.Add("X-Amz-SignedHeaders", signedHeaders)
+
+	canonicalQuerystring := params.Encode()
+	payloadHash := aws.GetHash("") // empty payload
+	canonicalRequest := method + "\n" + canonicalURI + "\n" + canonicalQuerystring + "\n" + canonicalHeaders + "\n" + signedHeaders + "\n" + payloadHash
+
+	stringToSign := algorithm + "\n" + amzDate + "\n" + credentialScope + "\n" + aws.GetHash(canonicalRequest)
+
+	// https://docs.aws.amazon.com/general/latest/gr/sigv4-calculate-signature.html
+	hash := aws.GetHMAC([]byte(fmt.Sprintf("AWS4%s", secret)), []byte(datestamp))
+	hash = aws.GetHMAC(hash, []byte(region))
+	hash = aws.GetHMAC(hash, []byte(service))
+	hash = aws.GetHMAC(hash, []byte("aws4_request"))
+	signature := hex.EncodeToString(aws.GetHMAC(hash, []byte(stringToSign)))
+
+	params.Add("X-Amz-Signature", signature)
+	req.Header.Add("Content-type", "application/x-www-form-urlencoded; charset=utf-8")
+	req.URL.RawQuery = params.Encode()
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false, nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return false, nil, nil
+	}
+
+	var identity identityRes
+	if err := json.NewDecoder(res.Body).Decode(&identity); err != nil {
+		return false, nil, err
+	}
+	result := identity.GetCallerIdentityResponse.GetCallerIdentityResult
+	return true, map[string]string{
+		"account": result.Account,
+		"user_id": result.UserID,
+		"arn":     result.Arn,
+		// Session credentials are usually issued for an assumed role.
+		"assumed_role": fmt.Sprintf("%t", strings.Contains(result.Arn, ":assumed-role/")),
+	}, nil
+}
+
+type identityRes struct {
+	GetCallerIdentityResponse struct {
+		GetCallerIdentityResult struct {
+			Account string `json:"Account"`
+			Arn     string `json:"Arn"`
+			UserID  string `json:"UserId"`
+		} `json:"GetCallerIdentityResult"`
+	} `json:"GetCallerIdentityResponse"`
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_AWSSessionKey
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -17,6 +18,8 @@ type Scanner struct{}
 // Ensure the Scanner satisfies the interface at compile time.
 var _ detectors.Detector = (*Scanner)(nil)
 
+var client = common.SaneHttpClient()
+
 func mustFmtPat(id, pat string) *regexp.Regexp {
 	combinedID := strings.ReplaceAll(id, "_", "") + "|" + id
 	return regexp.MustCompile(fmt.Sprintf(pat, combinedID))
@@ -63,7 +66,8 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 					if err != nil {
 						continue
 					}
-					err = token.Refresh()
+					token.SetSender(client)
+					err = token.RefreshWithContext(ctx)
 					if err == nil {
 						s.Verified = true
 					}
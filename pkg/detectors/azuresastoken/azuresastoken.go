@@ -0,0 +1,181 @@
+package azuresastoken
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat = regexp.MustCompile(`\b(https://[a-z0-9]{3,24}\.(?:blob|file|queue|table|dfs)\.core\.windows\.net(?:/[^\s?"'<>]*)?\?[^\s"'<>]*\bsig=[A-Za-z0-9%+/=]+[^\s"'<>]*)`)
+)
+
+var (
+	// https://learn.microsoft.com/en-us/rest/api/storageservices/create-account-sas
+	permissionNames = map[rune]string{
+		'r': "read",
+		'a': "add",
+		'c': "create",
+		'w': "write",
+		'd': "delete",
+		'x': "delete_version",
+		'y': "permanent_delete",
+		'l': "list",
+		't': "tag",
+		'f': "filter",
+		'm': "move",
+		'e': "execute",
+		'o': "ownership",
+		'p': "permissions",
+		'i': "set_immutability_policy",
+		'u': "update",
+	}
+	serviceNames = map[rune]string{
+		'b': "blob",
+		'f': "file",
+		'q': "queue",
+		't': "table",
+	}
+	resourceTypeNames = map[rune]string{
+		's': "service",
+		'c': "container",
+		'o': "object",
+	}
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"core.windows.net"}
+}
+
+// FromData will find and optionally verify Azure Storage SAS URLs in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		sasURL, err := url.Parse(strings.ReplaceAll(match[1], "&amp;", "&"))
+		if err != nil {
+			continue
+		}
+		params := sasURL.Query()
+		if params.Get("sig") == "" {
+			continue
+		}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_AzureSASToken,
+			Raw:          []byte(sasURL.String()),
+			Redacted:     sasURL.Scheme + "://" + sasURL.Host + sasURL.Path,
+			ExtraData:    sasExtraData(params),
+		}
+
+		if verify {
+			method, verificationURL := verificationRequest(sasURL)
+			req, err := http.NewRequestWithContext(ctx, method, verificationURL, nil)
+			if err != nil {
+				continue
+			}
+			res, err := client.Do(req)
+			if err == nil {
+				res.Body.Close()
+				// A valid signature without the permission for the request
+				// fails authorization instead of authentication.
+				errorCode := res.Header.Get("x-ms-error-code")
+				if res.StatusCode >= 200 && res.StatusCode < 300 || strings.HasPrefix(errorCode, "Authorization") {
+					s1.Verified = true
+				}
+			}
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// verificationRequest returns the method and URL of a read-only request the
+// SAS token can make: a HEAD of the blob or file, peeking at the queue, or
+// listing the container, share, or account with at most one result.
+func verificationRequest(sasURL *url.URL) (string, string) {
+	u := *sasURL
+	query := u.Query()
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	// The service is the second label of hosts like account.blob.core.windows.net.
+	service := strings.Split(u.Host, ".")[1]
+
+	switch {
+	case len(segments) > 1:
+		return http.MethodHead, u.String()
+	case service == "table":
+		u.Path = "/Tables"
+		query.Set("$top", "1")
+	case service == "queue" && segments[0] != "":
+		u.Path = "/" + segments[0] + "/messages"
+		query.Set("peekonly", "true")
+	case segments[0] != "":
+		if service == "blob" || service == "dfs" {
+			query.Set("restype", "container")
+		} else if service == "file" {
+			query.Set("restype", "directory")
+		}
+		query.Set("comp", "list")
+		query.Set("maxresults", "1")
+	default:
+		query.Set("comp", "list")
+		query.Set("maxresults", "1")
+	}
+	u.RawQuery = query.Encode()
+	return http.MethodGet, u.String()
+}
+
+// sasExtraData describes what the SAS token grants based on its parameters.
+func sasExtraData(params url.Values) map[string]string {
+	extraData := map[string]string{}
+	if permissions := describe(params.Get("sp"), permissionNames); permissions != "" {
+		extraData["permissions"] = permissions
+	}
+	if services := describe(params.Get("ss"), serviceNames); services != "" {
+		extraData["services"] = services
+	}
+	if resourceTypes := describe(params.Get("srt"), resourceTypeNames); resourceTypes != "" {
+		extraData["resource_types"] = resourceTypes
+	}
+	if expiry := params.Get("se"); expiry != "" {
+		extraData["expiry"] = expiry
+	}
+	return extraData
+}
+
+// describe maps each letter of a SAS parameter to its name.
+func describe(letters string, names map[rune]string) string {
+	var described []string
+	for _, letter := range letters {
+		if name, ok := names[letter]; ok {
+			described = append(described, name)
+		}
+	}
+	return strings.Join(described, ",")
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_AzureSASToken
+}
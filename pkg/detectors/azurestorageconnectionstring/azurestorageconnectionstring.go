@@ -0,0 +1,142 @@
+package azurestorageconnectionstring
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+const (
+	defaultEndpointSuffix = "core.windows.net"
+	// storageVersion is the Storage API version used to sign requests.
+	storageVersion = "2021-08-06"
+)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat            = regexp.MustCompile(`(?i)\b(DefaultEndpointsProtocol=https?;AccountName=[a-z0-9]{3,24};AccountKey=[A-Za-z0-9+/]{86}==(?:;EndpointSuffix=[a-z0-9.\-]+)?)`)
+	accountNamePat    = regexp.MustCompile(`(?i)AccountName=([a-z0-9]{3,24})`)
+	accountKeyPat     = regexp.MustCompile(`(?i)AccountKey=([A-Za-z0-9+/]{86}==)`)
+	endpointSuffixPat = regexp.MustCompile(`(?i)EndpointSuffix=([a-z0-9.\-]+)`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"AccountKey="}
+}
+
+// FromData will find and optionally verify Azure Storage connection strings in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		connectionString := match[1]
+		account := accountNamePat.FindStringSubmatch(connectionString)[1]
+		key := accountKeyPat.FindStringSubmatch(connectionString)[1]
+		endpointSuffix := defaultEndpointSuffix
+		if suffix := endpointSuffixPat.FindStringSubmatch(connectionString); suffix != nil {
+			endpointSuffix = suffix[1]
+		}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_AzureStorageConnectionString,
+			Raw:          []byte(key),
+			RawV2:        []byte(connectionString),
+			Redacted:     account,
+			ExtraData:    map[string]string{"account": account},
+		}
+
+		if verify {
+			verified, err := verifyAccountKey(ctx, account, key, endpointSuffix)
+			if err == nil {
+				s1.Verified = verified
+			}
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// verifyAccountKey lists at most one container of the account's blob
+// service, signing the request with the account key.
+func verifyAccountKey(ctx context.Context, account, key, endpointSuffix string) (bool, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return false, err
+	}
+	endpoint := fmt.Sprintf("https://%s.blob.%s/?comp=list&maxresults=1", account, endpointSuffix)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+	date := time.Now().UTC().Format(http.TimeFormat)
+	req.Header.Set("x-ms-date", date)
+	req.Header.Set("x-ms-version", storageVersion)
+
+	// https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+	stringToSign := strings.Join([]string{
+		http.MethodGet,
+		"", // Content-Encoding
+		"", // Content-Language
+		"", // Content-Length
+		"", // Content-MD5
+		"", // Content-Type
+		"", // Date
+		"", // If-Modified-Since
+		"", // If-Match
+		"", // If-None-Match
+		"", // If-Unmodified-Since
+		"", // Range
+		"x-ms-date:" + date,
+		"x-ms-version:" + storageVersion,
+		"/" + account + "/",
+		"comp:list",
+		"maxresults:1",
+	}, "\n")
+	mac := hmac.New(sha256.New, decodedKey)
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", account, signature))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return true, nil
+	case res.StatusCode == http.StatusForbidden:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_AzureStorageConnectionString
+}
@@ -69,8 +69,10 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 				// thankfully official golang examples exist but you just need to dig their many repos https://github.com/bitfinexcom/bitfinex-api-go/blob/master/examples/v2/rest-orders/main.go
 				key := apiKeyRes
 				secret := apiSecretRes
-				http.DefaultClient = client // filed https://github.com/bitfinexcom/bitfinex-api-go/issues/238 to improve this
-				c := rest.NewClientWithURL(*api).Credentials(key, secret)
+				httpDo := func(_ *http.Client, req *http.Request) (*http.Response, error) {
+					return client.Do(req.WithContext(ctx))
+				}
+				c := rest.NewClientWithURLHttpDo(*api, httpDo).Credentials(key, secret)
 
 				isValid := true // assume valid
 				_, err = c.Orders.AllHistory()
@@ -0,0 +1,64 @@
+package canarytoken
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// Scanner finds the web bug and DNS tokens of Thinkst Canarytokens. Loading
+// the URL of a token or resolving its host name alerts whoever planted it, so
+// the results are canaries and are never verified.
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	// Tokens are 25 lowercase alphanumeric characters. Web bug URLs hide
+	// them among random path segments, e.g.
+	// http://canarytokens.com/static/terms/<token>/index.html.
+	urlPat = regexp.MustCompile(`\bhttps?://(?:www\.)?canarytokens\.(?:com|org|net)/[\w./-]*?\b([a-z0-9]{25})\b[\w./-]*`)
+	dnsPat = regexp.MustCompile(`\b([a-z0-9]{25})\.canarytokens\.(?:com|org|net)\b`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"canarytokens"}
+}
+
+// FromData will find Canarytokens in a given set of bytes. Verification is
+// skipped, since it would trip them.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	seen := map[string]struct{}{}
+	for _, pat := range []*regexp.Regexp{urlPat, dnsPat} {
+		for _, match := range pat.FindAllStringSubmatch(dataStr, -1) {
+			if len(match) != 2 {
+				continue
+			}
+			token := match[1]
+			if _, ok := seen[token]; ok {
+				continue
+			}
+			seen[token] = struct{}{}
+
+			results = append(results, detectors.Result{
+				DetectorType: detectorspb.DetectorType_CanaryToken,
+				Canary:       true,
+				Raw:          []byte(token),
+				Redacted:     match[0],
+			})
+		}
+	}
+
+	return results, nil
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_CanaryToken
+}
@@ -0,0 +1,63 @@
+//go:build detectors
+// +build detectors
+
+package canarytoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestCanaryToken_FromData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []detectors.Result
+	}{
+		{
+			name: "web bug",
+			data: `<img src="http://canarytokens.com/static/terms/tags/q8w3n5k2r7t1y6u4i9o0p3a5s/index.html">`,
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_CanaryToken,
+					Canary:       true,
+					Raw:          []byte("q8w3n5k2r7t1y6u4i9o0p3a5s"),
+					Redacted:     "http://canarytokens.com/static/terms/tags/q8w3n5k2r7t1y6u4i9o0p3a5s/index.html",
+				},
+			},
+		},
+		{
+			name: "dns",
+			data: "backup_host = q8w3n5k2r7t1y6u4i9o0p3a5s.canarytokens.org",
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_CanaryToken,
+					Canary:       true,
+					Raw:          []byte("q8w3n5k2r7t1y6u4i9o0p3a5s"),
+					Redacted:     "q8w3n5k2r7t1y6u4i9o0p3a5s.canarytokens.org",
+				},
+			},
+		},
+		{
+			name: "no token",
+			data: "See https://canarytokens.org/generate for more.",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Scanner{}.FromData(context.Background(), true, []byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("FromData() diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
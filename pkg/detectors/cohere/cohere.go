@@ -0,0 +1,108 @@
+package cohere
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	// Make sure that your group is surrounded in boundary characters such as below to reduce false positives.
+	keyPat = regexp.MustCompile(detectors.PrefixRegex([]string{"cohere", "co_api_key"}) + `\b([a-zA-Z0-9]{40})\b`)
+)
+
+type checkResponse struct {
+	Valid          bool   `json:"valid"`
+	OrganizationID string `json:"organization_id"`
+	OwnerID        string `json:"owner_id"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"cohere", "co_api_key"}
+}
+
+// FromData will find and optionally verify Cohere secrets in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_Cohere,
+			Raw:          []byte(token),
+		}
+
+		if verify {
+			check, err := checkKey(ctx, token)
+			if err == nil && check.Valid {
+				s1.Verified = true
+				s1.ExtraData = map[string]string{
+					"organization_id": check.OrganizationID,
+					"owner_id":        check.OwnerID,
+				}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// checkKey uses the endpoint made for checking keys, which also returns the
+// organization the key belongs to.
+func checkKey(ctx context.Context, token string) (*checkResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.cohere.ai/v1/check-api-key", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Add("Accept", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var check checkResponse
+		if err := json.NewDecoder(res.Body).Decode(&check); err != nil {
+			return nil, err
+		}
+		return &check, nil
+	case http.StatusUnauthorized:
+		return &checkResponse{}, nil
+	default:
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_Cohere
+}
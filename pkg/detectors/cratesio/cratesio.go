@@ -0,0 +1,119 @@
+package cratesio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat = regexp.MustCompile(`\b(cio[a-zA-Z0-9]{32})\b`)
+
+	// Crate names are at most 64 characters, so no crate has this name.
+	nonexistentCrate = "trufflehog-verification-" + strings.Repeat("x", 41)
+)
+
+type errorResponse struct {
+	Errors []struct {
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"cio"}
+}
+
+// FromData will find and optionally verify crates.io API tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_CratesIO,
+			Raw:          []byte(token),
+		}
+
+		if verify {
+			verified, canYank, err := verifyToken(ctx, token)
+			if err == nil && verified {
+				s1.Verified = true
+				// Tokens that can't yank crates may still publish them.
+				s1.ExtraData = map[string]string{"yank_scope": strconv.FormatBool(canYank)}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// verifyToken yanks a crate that can't exist. Tokens can't read the user
+// they belong to, but crates.io checks the token before looking up the
+// crate, and there's nothing to yank.
+func verifyToken(ctx context.Context, token string) (verified bool, canYank bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", "https://crates.io/api/v1/crates/"+nonexistentCrate+"/0.0.0/yank", nil)
+	if err != nil {
+		return false, false, err
+	}
+	req.Header.Add("Authorization", token)
+	res, err := client.Do(req)
+	if err != nil {
+		return false, false, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNotFound:
+		return true, true, nil
+	case http.StatusForbidden:
+		// Both invalid tokens and tokens without the yank scope are
+		// forbidden.
+		var errRes errorResponse
+		if err := json.NewDecoder(res.Body).Decode(&errRes); err != nil {
+			return false, false, err
+		}
+		for _, e := range errRes.Errors {
+			if strings.Contains(e.Detail, "required permissions") {
+				return true, false, nil
+			}
+		}
+		return false, false, nil
+	case http.StatusUnauthorized:
+		return false, false, nil
+	default:
+		return false, false, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_CratesIO
+}
@@ -0,0 +1,292 @@
+package databaseuri
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// Scanner finds database and message broker connection URIs with inline
+// credentials. Verifying them means connecting to hosts taken from the scanned
+// data, so it only happens when live verification is enabled.
+type Scanner struct {
+	liveVerification bool
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithLiveVerification enables verifying credentials by authenticating to
+// the database or broker the URI points to. No queries are run.
+func WithLiveVerification() func(*Scanner) {
+	return func(s *Scanner) {
+		s.liveVerification = true
+	}
+}
+
+// WithLiveVerification returns a copy of the scanner that verifies the URIs
+// it finds.
+func (s Scanner) WithLiveVerification() detectors.Detector {
+	s.liveVerification = true
+	return &s
+}
+
+// Ensure the Scanner satisfies the interfaces at compile time.
+var (
+	_ detectors.Detector     = (*Scanner)(nil)
+	_ detectors.LiveVerifier = (*Scanner)(nil)
+	_ detectors.Verifier     = (*Scanner)(nil)
+)
+
+// verificationTimeout bounds each connection attempt.
+const verificationTimeout = 5 * time.Second
+
+var (
+	// Make sure that your group is surrounded in boundary characters such as below to reduce false positives.
+	keyPat = regexp.MustCompile(`\b(?:postgres(?:ql)?|mysql|mongodb(?:\+srv)?|rediss?|amqps?)://[^\s:@/"'<>]{0,100}:([^\s@/"'<>]{3,100})@[^\s/?#"'<>,;]{1,255}(?:/[^\s?#"'<>,;]*)?(?:\?[^\s#"'<>;]*)?`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"postgres", "mysql", "mongodb", "redis", "amqp"}
+}
+
+// FromData will find and optionally verify connection URIs in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		uri := strings.TrimRight(match[0], ".)")
+		password := match[1]
+
+		if isPlaceholder(password) {
+			continue
+		}
+
+		parsedURL, err := url.Parse(uri)
+		if err != nil || parsedURL.Hostname() == "" {
+			continue
+		}
+		if _, ok := parsedURL.User.Password(); !ok {
+			continue
+		}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_DatabaseConnectionURI,
+			Raw:          []byte(uri),
+			Redacted:     strings.Replace(uri, password, "********", 1),
+		}
+		s1.ExtraData, s1.TypedExtraData = uriDetails(parsedURL)
+
+		if verify && s.liveVerification {
+			s1.Verified = verifyURI(ctx, parsedURL)
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(string(s1.Raw), detectors.DefaultFalsePositives, false) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// Verify reports whether the credentials of the result's URI authenticate,
+// when live verification is enabled.
+func (s Scanner) Verify(ctx context.Context, result detectors.Result) (bool, error) {
+	if !s.liveVerification {
+		return false, nil
+	}
+	parsedURL, err := url.Parse(string(result.Raw))
+	if err != nil {
+		return false, err
+	}
+	return verifyURI(ctx, parsedURL), nil
+}
+
+// isPlaceholder reports whether the password is redacted or a template
+// variable rather than a real credential.
+func isPlaceholder(password string) bool {
+	if strings.Trim(password, "*") == "" {
+		return true
+	}
+	return strings.HasPrefix(password, "$") ||
+		strings.HasPrefix(password, "{{") ||
+		strings.HasPrefix(password, "%s")
+}
+
+func uriDetails(u *url.URL) (map[string]string, *detectorspb.ExtraData) {
+	scheme := strings.ToLower(u.Scheme)
+	extraData := map[string]string{
+		"scheme": scheme,
+		"host":   u.Host,
+	}
+	database := &detectorspb.DatabaseExtraData{Scheme: scheme}
+	typed := &detectorspb.ExtraData{
+		Username: u.User.Username(),
+		Endpoint: u.Host,
+		Family:   &detectorspb.ExtraData_Database{Database: database},
+	}
+	if username := u.User.Username(); username != "" {
+		extraData["username"] = username
+	}
+	if path := strings.TrimPrefix(u.Path, "/"); path != "" {
+		if strings.HasPrefix(scheme, "amqp") {
+			extraData["vhost"] = path
+			database.Vhost = path
+		} else {
+			extraData["database"] = path
+			database.Database = path
+		}
+	}
+	return extraData, typed
+}
+
+// verifyURI reports whether the URI's credentials authenticate. The
+// connection is closed as soon as authentication completes.
+func verifyURI(ctx context.Context, u *url.URL) bool {
+	if err := common.CheckVerificationConnection(ctx, u); err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, verificationTimeout)
+	defer cancel()
+
+	var err error
+	switch strings.ToLower(u.Scheme) {
+	case "postgres", "postgresql":
+		err = verifyPostgres(ctx, u)
+	case "mysql":
+		err = verifyMySQL(ctx, u)
+	case "mongodb", "mongodb+srv":
+		err = verifyMongoDB(ctx, u)
+	case "redis", "rediss":
+		err = verifyRedis(ctx, u)
+	case "amqp", "amqps":
+		err = verifyAMQP(u)
+	default:
+		return false
+	}
+	return err == nil
+}
+
+func verifyPostgres(ctx context.Context, u *url.URL) error {
+	err := connectPostgres(ctx, u.String())
+	if !errors.Is(err, pq.ErrSSLNotSupported) {
+		return err
+	}
+	// The driver requires TLS by default, fall back to a plain connection
+	// for servers that don't support it.
+	plain := *u
+	query := plain.Query()
+	query.Set("sslmode", "disable")
+	plain.RawQuery = query.Encode()
+	return connectPostgres(ctx, plain.String())
+}
+
+func connectPostgres(ctx context.Context, dsn string) error {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return err
+	}
+	conn, err := connector.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func verifyMySQL(ctx context.Context, u *url.URL) error {
+	cfg := mysql.NewConfig()
+	cfg.User = u.User.Username()
+	cfg.Passwd, _ = u.User.Password()
+	cfg.Net = "tcp"
+	cfg.Addr = u.Host
+	if u.Port() == "" {
+		cfg.Addr = net.JoinHostPort(u.Hostname(), "3306")
+	}
+	cfg.DBName = strings.TrimPrefix(u.Path, "/")
+	cfg.Timeout = verificationTimeout
+
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return err
+	}
+	conn, err := connector.Connect(ctx)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func verifyMongoDB(ctx context.Context, u *url.URL) error {
+	opts := options.Client().
+		ApplyURI(u.String()).
+		SetConnectTimeout(verificationTimeout).
+		SetServerSelectionTimeout(verificationTimeout)
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Disconnect(ctx) }()
+	// The driver connects lazily, the ping command forces the handshake and
+	// authentication.
+	return client.Ping(ctx, nil)
+}
+
+func verifyRedis(ctx context.Context, u *url.URL) error {
+	opt, err := redis.ParseURL(u.String())
+	if err != nil {
+		return err
+	}
+	opt.DialTimeout = verificationTimeout
+	opt.ReadTimeout = verificationTimeout
+	opt.WriteTimeout = verificationTimeout
+	opt.MaxRetries = 0
+
+	client := redis.NewClient(opt).WithContext(ctx)
+	defer client.Close()
+	// PING is sent after AUTH on the same connection, so it only succeeds
+	// with valid credentials.
+	return client.Ping().Err()
+}
+
+func verifyAMQP(u *url.URL) error {
+	conn, err := amqp.DialConfig(u.String(), amqp.Config{
+		Dial: amqp.DefaultDial(verificationTimeout),
+	})
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_DatabaseConnectionURI
+}
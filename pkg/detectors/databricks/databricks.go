@@ -0,0 +1,169 @@
+package databricks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given workspace URLs to the list of URLs to check
+// for verification of secrets. They are checked in addition to the workspaces
+// found next to a token.
+func WithVerifierURLs(urls []string) func(*Scanner) {
+	return func(s *Scanner) {
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat = regexp.MustCompile(`\b(dapi[a-f0-9]{32}(?:-\d)?)\b`)
+	// Workspaces on AWS, Azure, and GCP.
+	workspacePat = regexp.MustCompile(`\b([a-z0-9\-]+\.cloud\.databricks\.com|adb-\d+\.\d+\.azuredatabricks\.net|[a-z0-9\-.]+\.gcp\.databricks\.com)\b`)
+)
+
+type meResponse struct {
+	UserName    string `json:"userName"`
+	DisplayName string `json:"displayName"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"dapi"}
+}
+
+// FromData will find and optionally verify Databricks personal access tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	var workspaces []string
+	for _, match := range workspacePat.FindAllStringSubmatch(dataStr, -1) {
+		workspaces = appendUnique(workspaces, "https://"+match[1])
+	}
+	for _, u := range s.verifierURLs {
+		workspaces = appendUnique(workspaces, strings.TrimSuffix(u, "/"))
+	}
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_DatabricksToken,
+			Raw:          []byte(token),
+		}
+		// Tokens are only valid in the workspace they were created in.
+		if len(workspaces) == 1 {
+			s1.RawV2 = []byte(token + workspaces[0])
+			s1.ExtraData = map[string]string{"workspace": workspaces[0]}
+		}
+
+		if verify {
+			for _, workspace := range workspaces {
+				me, err := currentUser(ctx, workspace, token)
+				if err != nil || me == nil {
+					continue
+				}
+				s1.Verified = true
+				s1.RawV2 = []byte(token + workspace)
+				s1.ExtraData = map[string]string{
+					"workspace": workspace,
+					"username":  me.UserName,
+				}
+				break
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// currentUser returns the token's owner, or nil if the workspace doesn't
+// accept the token.
+func currentUser(ctx context.Context, workspace, token string) (*meResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", workspace+"/api/2.0/preview/scim/v2/Me", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var me meResponse
+		if err := json.NewDecoder(res.Body).Decode(&me); err != nil {
+			return nil, err
+		}
+		return &me, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_DatabricksToken
+}
+
+// Endpoints returns the workspaces that tokens are verified against. Tokens
+// are also verified against the workspaces found next to them.
+func (s Scanner) Endpoints() []string {
+	return append(s.verifierURLs[:len(s.verifierURLs):len(s.verifierURLs)],
+		"https://*.cloud.databricks.com",
+		"https://adb-*.azuredatabricks.net",
+		"https://*.gcp.databricks.com",
+	)
+}
@@ -31,6 +31,50 @@ type Versioner interface {
 	Version() int
 }
 
+// Endpointer is an optional interface that a detector can implement to list
+// the endpoints it sends verification requests to. Parts of an endpoint that
+// come from the scanned data are written as a * wildcard.
+type Endpointer interface {
+	Endpoints() []string
+}
+
+// LiveVerifier is an optional interface that a detector can implement when
+// verifying its results connects to hosts found in the scanned data, e.g. the
+// database of a connection URI. Such detectors don't verify unless the engine
+// enables live verification.
+type LiveVerifier interface {
+	// WithLiveVerification returns a copy of the detector that verifies its
+	// results.
+	WithLiveVerification() Detector
+}
+
+// Verifier verifies the secret in a result found by a detector. Verifiers
+// can be registered with the engine to replace or supplement a detector's
+// built-in verification, e.g. to check secrets against an internal inventory
+// instead of the provider's API. Detectors can implement it too, so that the
+// engine verifies the results they found without scanning the data again.
+type Verifier interface {
+	// Verify reports whether the result's secret is valid.
+	Verify(ctx context.Context, result Result) (bool, error)
+}
+
+// VerifierFunc is an adapter to allow the use of ordinary functions as
+// Verifiers.
+type VerifierFunc func(ctx context.Context, result Result) (bool, error)
+
+// Verify calls f(ctx, result).
+func (f VerifierFunc) Verify(ctx context.Context, result Result) (bool, error) {
+	return f(ctx, result)
+}
+
+// Revoker is an optional interface that a detector can implement to revoke
+// the secrets it verifies with their provider, e.g. as soon as they are found
+// leaked.
+type Revoker interface {
+	// Revoke revokes the result's secret.
+	Revoke(ctx context.Context, result Result) error
+}
+
 type Result struct {
 	// DetectorType is the type of Detector.
 	DetectorType detectorspb.DetectorType
@@ -39,6 +83,9 @@ type Result struct {
 	// DecoderType is the type of Decoder.
 	DecoderType detectorspb.DecoderType
 	Verified    bool
+	// Canary is set if the secret is a known canary token, a decoy that
+	// alerts whoever planted it once it's used. Canaries are never verified.
+	Canary bool
 	// Raw contains the raw secret identifier data. Prefer IDs over secrets since it is used for deduping after hashing.
 	Raw []byte
 	// RawV2 contains the raw secret identifier that is a combination of both the ID and the secret.
@@ -46,9 +93,48 @@ type Result struct {
 	RawV2 []byte
 	// Redacted contains the redacted version of the raw secret identification data for display purposes.
 	// A secret ID should be used if available.
-	Redacted       string
-	ExtraData      map[string]string
+	Redacted  string
+	ExtraData map[string]string
+	// TypedExtraData holds the fields of ExtraData that are common to many
+	// detectors, with stable names, and the fields specific to the detector's
+	// family. The detectors of the families it covers set it.
+	TypedExtraData *detectorspb.ExtraData
 	StructuredData *detectorspb.StructuredData
+
+	// verificationError is the error that kept the result from being
+	// verified, e.g. a failed request, so whether the secret is valid is
+	// unknown.
+	verificationError error
+
+	// revocationTried is set once revoking the result's secret was tried,
+	// and revocationError is the error that kept it from being revoked.
+	revocationTried bool
+	revocationError error
+}
+
+// SetVerificationError records the error that kept the result from being
+// verified. A nil error clears it.
+func (r *Result) SetVerificationError(err error) {
+	r.verificationError = err
+}
+
+// VerificationError returns the error that kept the result from being
+// verified, if any.
+func (r Result) VerificationError() error {
+	return r.verificationError
+}
+
+// SetRevocation records that revoking the result's secret was tried, and the
+// error that kept it from being revoked, or nil if it was revoked.
+func (r *Result) SetRevocation(err error) {
+	r.revocationTried = true
+	r.revocationError = err
+}
+
+// Revocation reports whether revoking the result's secret was tried, and the
+// error that kept it from being revoked, if any.
+func (r Result) Revocation() (tried bool, err error) {
+	return r.revocationTried, r.revocationError
 }
 
 type ResultWithMetadata struct {
@@ -60,6 +146,9 @@ type ResultWithMetadata struct {
 	SourceType sourcespb.SourceType
 	// SourceName is the name of the Source.
 	SourceName string
+	// ChunkData is the decoded data of the chunk the result was found in,
+	// which lets the result be verified again later.
+	ChunkData []byte
 	Result
 }
 
@@ -0,0 +1,205 @@
+package docker
+
+import (
+	"context"
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	// Registries in config.json, or .dockercfg, map to base64 encoded
+	// username:password pairs, e.g. {"auths": {"ghcr.io": {"auth": "..."}}}.
+	authPat = regexp.MustCompile(`"([a-zA-Z0-9.\-:/_]+)"\s*:\s*\{[^{}]*?"auth"\s*:\s*"([A-Za-z0-9+/]+={0,2})"`)
+
+	// Docker Hub personal access tokens are used with the account's username.
+	patPat          = regexp.MustCompile(`\b(dckr_pat_[a-zA-Z0-9_\-]{27})`)
+	hubUsernamePats = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)docker[\w.\-]*?(?:user(?:name)?|login|id)["']?\s*[:=]\s*["']?([a-z0-9][a-z0-9_.\-]{1,29})\b`),
+		regexp.MustCompile(`docker login\b[^\n]*?(?:-u|--username)[ =]["']?([a-z0-9][a-z0-9_.\-]{1,29})\b`),
+	}
+
+	// Quay robot accounts are named organization+robot, and their tokens are
+	// 64 upper case alphanumeric characters.
+	robotPat      = regexp.MustCompile(`\b([a-z0-9][a-z0-9_\-]*\+[a-z0-9][a-z0-9_]*)\b`)
+	robotTokenPat = regexp.MustCompile(`\b([A-Z0-9]{64})\b`)
+)
+
+// credential is a registry login found in the data.
+type credential struct {
+	registry string
+	username string
+	password string
+	// source is config, pat, or robot.
+	source string
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{`"auth"`, "dckr_pat_", "quay"}
+}
+
+// FromData will find and optionally verify container registry credentials in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	credentials := configCredentials(dataStr)
+	// Tokens in configs are reported with the config's username.
+	inConfig := map[string]bool{}
+	for _, cred := range credentials {
+		inConfig[cred.password] = true
+	}
+	for _, cred := range append(hubCredentials(dataStr), robotCredentials(dataStr)...) {
+		if !inConfig[cred.password] {
+			credentials = append(credentials, cred)
+		}
+	}
+
+	for _, cred := range credentials {
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_Docker,
+			Raw:          []byte(cred.password),
+			RawV2:        []byte(cred.registry + cred.username + cred.password),
+			Redacted:     cred.registry,
+			ExtraData: map[string]string{
+				"registry": cred.registry,
+				"source":   cred.source,
+			},
+		}
+		if cred.username != "" {
+			s1.Redacted = cred.username + "@" + cred.registry
+			s1.ExtraData["username"] = cred.username
+		}
+
+		if verify && cred.username != "" {
+			host := registryHost(cred.registry)
+			c, err := getChallenge(ctx, host)
+			if err == nil {
+				verified, err := login(ctx, host, c, cred.username, cred.password)
+				if err == nil && verified {
+					s1.Verified = true
+					if repositories, err := pushableRepositories(ctx, host, c, cred.username, cred.password); err == nil && len(repositories) > 0 {
+						s1.ExtraData["push_repositories"] = strings.Join(repositories, ",")
+					}
+				}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(cred.password, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// configCredentials returns the logins stored in Docker configs.
+func configCredentials(data string) []credential {
+	var credentials []credential
+	for _, match := range authPat.FindAllStringSubmatch(data, -1) {
+		decoded, err := base64.StdEncoding.DecodeString(match[2])
+		if err != nil {
+			continue
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok || username == "" || password == "" {
+			continue
+		}
+		credentials = append(credentials, credential{
+			registry: registryName(match[1]),
+			username: username,
+			password: password,
+			source:   "config",
+		})
+	}
+	return credentials
+}
+
+// hubCredentials pairs Docker Hub personal access tokens with the usernames
+// near them. Tokens without a username can't be verified, and are reported
+// on their own.
+func hubCredentials(data string) []credential {
+	var usernames []string
+	for _, pat := range hubUsernamePats {
+		for _, match := range pat.FindAllStringSubmatch(data, -1) {
+			usernames = appendUnique(usernames, match[1])
+		}
+	}
+	if len(usernames) == 0 {
+		usernames = []string{""}
+	}
+
+	var credentials []credential
+	for _, match := range patPat.FindAllStringSubmatch(data, -1) {
+		for _, username := range usernames {
+			credentials = append(credentials, credential{
+				registry: "docker.io",
+				username: username,
+				password: match[1],
+				source:   "pat",
+			})
+		}
+	}
+	return credentials
+}
+
+// robotCredentials pairs Quay robot accounts with robot tokens.
+func robotCredentials(data string) []credential {
+	if !strings.Contains(strings.ToLower(data), "quay") {
+		return nil
+	}
+
+	var robots []string
+	for _, match := range robotPat.FindAllStringSubmatch(data, -1) {
+		robots = appendUnique(robots, match[1])
+	}
+
+	var credentials []credential
+	for _, match := range robotTokenPat.FindAllStringSubmatch(data, -1) {
+		for _, robot := range robots {
+			credentials = append(credentials, credential{
+				registry: "quay.io",
+				username: robot,
+				password: match[1],
+				source:   "robot",
+			})
+		}
+	}
+	return credentials
+}
+
+// registryName shortens the registry URLs Docker uses in configs, e.g.
+// https://index.docker.io/v1/, to the registry's name.
+func registryName(registry string) string {
+	if registryHost(registry) == dockerHubRegistry {
+		return "docker.io"
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://"), "/")
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_Docker
+}
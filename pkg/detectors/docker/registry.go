@@ -0,0 +1,285 @@
+package docker
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	dockerHubRegistry = "registry-1.docker.io"
+	// maxRepositories bounds the repositories checked for push access.
+	maxRepositories = 50
+)
+
+// challengeParamPat matches the parameters of a WWW-Authenticate header, e.g.
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io".
+var challengeParamPat = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// challenge is how a registry asks clients to authenticate.
+// https://distribution.github.io/distribution/spec/auth/token/
+type challenge struct {
+	// scheme is Basic or Bearer.
+	scheme  string
+	realm   string
+	service string
+}
+
+// registryHost returns the host serving the registry API for a registry
+// named in a Docker config.
+func registryHost(registry string) string {
+	registry = strings.TrimPrefix(registry, "https://")
+	registry = strings.TrimPrefix(registry, "http://")
+	registry, _, _ = strings.Cut(registry, "/")
+	switch registry {
+	case "docker.io", "index.docker.io", "registry.hub.docker.com":
+		return dockerHubRegistry
+	}
+	return registry
+}
+
+// getChallenge asks the registry how to authenticate.
+func getChallenge(ctx context.Context, host string) (*challenge, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://"+host+"/v2/", nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized {
+		return nil, fmt.Errorf("registry doesn't require authentication, status %d", res.StatusCode)
+	}
+
+	header := res.Header.Get("WWW-Authenticate")
+	scheme, params, _ := strings.Cut(header, " ")
+	c := &challenge{scheme: strings.ToLower(scheme)}
+	for _, param := range challengeParamPat.FindAllStringSubmatch(params, -1) {
+		switch param[1] {
+		case "realm":
+			c.realm = param[2]
+		case "service":
+			c.service = param[2]
+		}
+	}
+	if c.scheme != "basic" && (c.scheme != "bearer" || c.realm == "") {
+		return nil, fmt.Errorf("unsupported authentication challenge %q", header)
+	}
+	return c, nil
+}
+
+// login authenticates to the registry. Bearer tokens are only returned for
+// valid credentials, and for Basic authentication the credentials are checked
+// against the API directly.
+func login(ctx context.Context, host string, c *challenge, username, password string) (bool, error) {
+	if c.scheme == "basic" {
+		req, err := http.NewRequestWithContext(ctx, "GET", "https://"+host+"/v2/", nil)
+		if err != nil {
+			return false, err
+		}
+		req.SetBasicAuth(username, password)
+		res, err := client.Do(req)
+		if err != nil {
+			return false, err
+		}
+		res.Body.Close()
+		return res.StatusCode == http.StatusOK, nil
+	}
+
+	token, err := fetchToken(ctx, c, username, password)
+	return token != "", err
+}
+
+// fetchToken requests a bearer token for the scopes, e.g.
+// repository:library/alpine:push,pull. An empty token means the credentials
+// were rejected.
+func fetchToken(ctx context.Context, c *challenge, username, password string, scopes ...string) (string, error) {
+	query := url.Values{}
+	if c.service != "" {
+		query.Set("service", c.service)
+	}
+	query.Set("account", username)
+	for _, scope := range scopes {
+		query.Add("scope", scope)
+	}
+	realm, err := url.Parse(c.realm)
+	if err != nil {
+		return "", err
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(username, password)
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var tokenRes struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&tokenRes); err != nil {
+			return "", err
+		}
+		if tokenRes.Token != "" {
+			return tokenRes.Token, nil
+		}
+		return tokenRes.AccessToken, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return "", nil
+	default:
+		return "", fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+// pushableRepositories returns the repositories the credentials can push to.
+// Registries grant the subset of the requested actions the credentials
+// allow. Only registries issuing JWTs say which actions they granted.
+func pushableRepositories(ctx context.Context, host string, c *challenge, username, password string) ([]string, error) {
+	if c.scheme != "bearer" {
+		return nil, nil
+	}
+
+	var repositories []string
+	var err error
+	if host == dockerHubRegistry {
+		repositories, err = dockerHubRepositories(ctx, username)
+	} else {
+		repositories, err = catalog(ctx, host, c, username, password)
+	}
+	if err != nil || len(repositories) == 0 {
+		return nil, err
+	}
+	if len(repositories) > maxRepositories {
+		repositories = repositories[:maxRepositories]
+	}
+
+	scopes := make([]string, 0, len(repositories))
+	for _, repository := range repositories {
+		scopes = append(scopes, "repository:"+repository+":push,pull")
+	}
+	token, err := fetchToken(ctx, c, username, password, scopes...)
+	if err != nil || token == "" {
+		return nil, err
+	}
+	access, err := grantedAccess(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var pushable []string
+	for _, a := range access {
+		if a.Type != "repository" {
+			continue
+		}
+		for _, action := range a.Actions {
+			if action == "push" || action == "*" {
+				pushable = append(pushable, a.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(pushable)
+	return pushable, nil
+}
+
+// dockerHubRepositories lists the repositories in the user's namespace. Docker
+// Hub doesn't support the catalog API.
+func dockerHubRepositories(ctx context.Context, namespace string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://hub.docker.com/v2/repositories/"+url.PathEscape(namespace)+"/?page_size=100", nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	var page struct {
+		Results []struct {
+			Namespace string `json:"namespace"`
+			Name      string `json:"name"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+	repositories := make([]string, 0, len(page.Results))
+	for _, r := range page.Results {
+		repositories = append(repositories, r.Namespace+"/"+r.Name)
+	}
+	return repositories, nil
+}
+
+// catalog lists the registry's repositories the credentials can see.
+func catalog(ctx context.Context, host string, c *challenge, username, password string) ([]string, error) {
+	token, err := fetchToken(ctx, c, username, password, "registry:catalog:*")
+	if err != nil || token == "" {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://%s/v2/_catalog?n=%d", host, maxRepositories), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	var catalog struct {
+		Repositories []string `json:"repositories"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&catalog); err != nil {
+		return nil, err
+	}
+	return catalog.Repositories, nil
+}
+
+type access struct {
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Actions []string `json:"actions"`
+}
+
+// grantedAccess reads the access claim of a registry JWT.
+func grantedAccess(token string) ([]access, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token isn't a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, err
+	}
+	var claims struct {
+		Access []access `json:"access"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	return claims.Access, nil
+}
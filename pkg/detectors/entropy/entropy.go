@@ -0,0 +1,222 @@
+package entropy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// Charset is a class of characters that strings are checked in, and the
+// Shannon entropy a string of those characters needs to be reported.
+type Charset struct {
+	Name string
+	// Threshold is in bits per character.
+	Threshold float64
+	pattern   string
+}
+
+// The thresholds of base64 and hex are the ones trufflehog v2 used.
+var (
+	Base64       = Charset{Name: "base64", Threshold: 4.5, pattern: `A-Za-z0-9+/=`}
+	Base64URL    = Charset{Name: "base64url", Threshold: 4.5, pattern: `A-Za-z0-9_\-=`}
+	Hex          = Charset{Name: "hex", Threshold: 3.0, pattern: `0-9A-Fa-f`}
+	Alphanumeric = Charset{Name: "alphanumeric", Threshold: 4.2, pattern: `A-Za-z0-9`}
+
+	charsets = []Charset{Base64, Base64URL, Hex, Alphanumeric}
+)
+
+const (
+	defaultMinLength = 20
+	defaultMaxLength = 128
+)
+
+// ParseCharsets returns the named charsets with their thresholds, e.g.
+// {"base64": "4.8", "hex": "3"}. An empty threshold keeps the default.
+func ParseCharsets(thresholds map[string]string) ([]Charset, error) {
+	parsed := make(map[string]float64, len(thresholds))
+	for name, value := range thresholds {
+		if value == "" {
+			parsed[name] = 0
+			continue
+		}
+		threshold, err := strconv.ParseFloat(value, 64)
+		if err != nil || threshold <= 0 {
+			return nil, fmt.Errorf("invalid entropy threshold %q for charset %s", value, name)
+		}
+		parsed[name] = threshold
+	}
+	return CharsetsWithThresholds(parsed)
+}
+
+// CharsetsWithThresholds returns the named charsets with their thresholds. A
+// zero threshold keeps the default.
+func CharsetsWithThresholds(thresholds map[string]float64) ([]Charset, error) {
+	names := make([]string, 0, len(thresholds))
+	for name := range thresholds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	charsets := make([]Charset, 0, len(names))
+	for _, name := range names {
+		charset, ok := charsetByName(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown charset %q", name)
+		}
+		switch threshold := thresholds[name]; {
+		case threshold < 0:
+			return nil, fmt.Errorf("invalid entropy threshold %v for charset %s", threshold, name)
+		case threshold > 0:
+			charset.Threshold = threshold
+		}
+		charsets = append(charsets, charset)
+	}
+	return charsets, nil
+}
+
+func charsetByName(name string) (Charset, bool) {
+	for _, charset := range charsets {
+		if charset.Name == strings.ToLower(name) {
+			return charset, true
+		}
+	}
+	return Charset{}, false
+}
+
+type Scanner struct {
+	charsets  []Charset
+	minLength int
+	maxLength int
+	keywords  []string
+	// patterns match the strings of each charset.
+	patterns []*regexp.Regexp
+	// opts are the options the scanner was created with.
+	opts []func(*Scanner)
+}
+
+// New creates a new Scanner with the given options. By default, base64 and
+// hex strings of 20 to 128 characters are checked anywhere in the data.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		charsets:  []Charset{Base64, Hex},
+		minLength: defaultMinLength,
+		maxLength: defaultMaxLength,
+		opts:      opts,
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+	for _, charset := range scanner.charsets {
+		scanner.patterns = append(scanner.patterns, regexp.MustCompile(fmt.Sprintf(`[%s]{%d,}`, charset.pattern, scanner.minLength)))
+	}
+
+	return scanner
+}
+
+// With returns a new Scanner with the options of s, followed by opts.
+func (s Scanner) With(opts ...func(*Scanner)) *Scanner {
+	return New(append(append([]func(*Scanner){}, s.opts...), opts...)...)
+}
+
+// WithCharsets sets the charsets strings are checked in.
+func WithCharsets(charsets ...Charset) func(*Scanner) {
+	return func(s *Scanner) {
+		if len(charsets) > 0 {
+			s.charsets = charsets
+		}
+	}
+}
+
+// WithLength sets the minimum and maximum length of the strings checked.
+// Zero keeps the default.
+func WithLength(minLength, maxLength int) func(*Scanner) {
+	return func(s *Scanner) {
+		if minLength > 0 {
+			s.minLength = minLength
+		}
+		if maxLength > 0 {
+			s.maxLength = maxLength
+		}
+	}
+}
+
+// WithKeywords only reports strings on lines containing one of the keywords,
+// e.g. key or token. Chunks without them aren't scanned at all.
+func WithKeywords(keywords ...string) func(*Scanner) {
+	return func(s *Scanner) {
+		s.keywords = append(s.keywords, keywords...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+// Keywords are used for efficiently pre-filtering chunks. Without context
+// keywords, every chunk is scanned.
+func (s Scanner) Keywords() []string {
+	return s.keywords
+}
+
+// FromData will find high entropy strings in a given set of bytes. Results
+// are never verified.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	seen := map[string]struct{}{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if !s.hasKeyword(line) {
+			continue
+		}
+		for i, pattern := range s.patterns {
+			charset := s.charsets[i]
+			for _, match := range pattern.FindAllString(line, -1) {
+				if len(match) > s.maxLength {
+					continue
+				}
+				if _, ok := seen[match]; ok {
+					continue
+				}
+				entropy := detectors.ShannonEntropy(match)
+				if entropy < charset.Threshold {
+					continue
+				}
+				if detectors.IsKnownFalsePositive(match, detectors.DefaultFalsePositives, false) {
+					continue
+				}
+				seen[match] = struct{}{}
+
+				results = append(results, detectors.Result{
+					DetectorType: detectorspb.DetectorType_Entropy,
+					Raw:          []byte(match),
+					ExtraData: map[string]string{
+						"charset": charset.Name,
+						"entropy": strconv.FormatFloat(entropy, 'f', 2, 64),
+					},
+				})
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (s Scanner) hasKeyword(line string) bool {
+	if len(s.keywords) == 0 {
+		return true
+	}
+	lower := strings.ToLower(line)
+	for _, keyword := range s.keywords {
+		if strings.Contains(lower, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_Entropy
+}
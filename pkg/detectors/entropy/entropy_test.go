@@ -0,0 +1,117 @@
+//go:build detectors
+// +build detectors
+
+package entropy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestEntropy_FromData(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       *Scanner
+		data    string
+		want    []detectors.Result
+		wantErr bool
+	}{
+		{
+			name: "base64",
+			s:    New(),
+			data: "signing_key: Zm9vYmFyLWJhei1xdXV4LTEyMzQ1Njc4OTAtQUJDREVGR0g=",
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Entropy,
+					Raw:          []byte("Zm9vYmFyLWJhei1xdXV4LTEyMzQ1Njc4OTAtQUJDREVGR0g="),
+					ExtraData:    map[string]string{"charset": "base64", "entropy": "5.13"},
+				},
+			},
+		},
+		{
+			name: "hex",
+			s:    New(),
+			data: "digest = 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Entropy,
+					Raw:          []byte("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"),
+					ExtraData:    map[string]string{"charset": "hex", "entropy": "3.79"},
+				},
+			},
+		},
+		{
+			name: "low entropy",
+			s:    New(),
+			data: "path = aaaabbbbccccddddeeeeffffgggghhhh",
+			want: nil,
+		},
+		{
+			name: "too long",
+			s:    New(WithLength(0, 32)),
+			data: "digest = 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			want: nil,
+		},
+		{
+			name: "higher threshold",
+			s:    New(WithCharsets(Charset{Name: "hex", Threshold: 3.9, pattern: Hex.pattern})),
+			data: "digest = 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			want: nil,
+		},
+		{
+			name: "keyword on another line",
+			s:    New(WithKeywords("token")),
+			data: "token:\n  digest = 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.FromData(context.Background(), false, []byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Entropy.FromData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("Entropy.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestParseCharsets(t *testing.T) {
+	got, err := ParseCharsets(map[string]string{"hex": "3.5", "base64url": ""})
+	if err != nil {
+		t.Fatalf("ParseCharsets() error = %v", err)
+	}
+	want := []Charset{{Name: "base64url", Threshold: 4.5, pattern: Base64URL.pattern}, {Name: "hex", Threshold: 3.5, pattern: Hex.pattern}}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("ParseCharsets() diff: (-got +want)\n%s", diff)
+	}
+
+	for _, thresholds := range []map[string]string{{"octal": ""}, {"hex": "high"}, {"hex": "-1"}} {
+		if _, err := ParseCharsets(thresholds); err == nil {
+			t.Errorf("ParseCharsets(%v) expected an error", thresholds)
+		}
+	}
+}
+
+func BenchmarkFromData(benchmark *testing.B) {
+	ctx := context.Background()
+	s := New()
+	for name, data := range detectors.MustGetBenchmarkData() {
+		benchmark.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, err := s.FromData(ctx, false, data)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
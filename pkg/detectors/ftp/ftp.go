@@ -9,6 +9,7 @@ import (
 
 	"github.com/jlaffaye/ftp"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -90,6 +91,9 @@ func verifyFTP(ctx context.Context, u *url.URL) bool {
 	if !strings.Contains(host, ":") {
 		host = host + ":21"
 	}
+	if err := common.CheckVerificationConnection(ctx, &url.URL{Scheme: "ftp", Host: host}); err != nil {
+		return false
+	}
 
 	c, err := ftp.Dial(host, ftp.DialWithTimeout(5*time.Second))
 	if err != nil {
@@ -5,9 +5,13 @@ package ftp
 
 import (
 	"context"
+	"net"
+	"sync/atomic"
 	"testing"
 
 	"github.com/kylelemons/godebug/pretty"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -103,6 +107,46 @@ func TestFTP_FromChunk(t *testing.T) {
 	}
 }
 
+func TestFTP_DryRun(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	var connections int32
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&connections, 1)
+			conn.Close()
+		}
+	}()
+
+	common.SetVerificationDryRun(true)
+	defer common.SetVerificationDryRun(false)
+
+	planned := &common.PlannedRequests{}
+	ctx := common.WithPlannedRequests(context.Background(), planned)
+	data := []byte("ftp://user:s3cr3tpass@" + listener.Addr().String() + "/files")
+	results, err := Scanner{}.FromData(ctx, true, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Verified {
+		t.Errorf("expected one unverified result, got %v", results)
+	}
+	if n := atomic.LoadInt32(&connections); n != 0 {
+		t.Errorf("expected no connections during a dry run, got %d", n)
+	}
+	want := "ftp://" + listener.Addr().String()
+	if got := planned.Requests(); len(got) != 1 || got[0].Endpoint != want {
+		t.Errorf("planned requests: got %v, want one to %s", got, want)
+	}
+}
+
 func BenchmarkFromData(benchmark *testing.B) {
 	ctx := context.Background()
 	s := Scanner{}
@@ -3,11 +3,18 @@ package gcp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 )
 
@@ -87,14 +94,17 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 
 		if verify {
 			credBytes, _ := json.Marshal(creds)
+			// The token is fetched with the client of the context.
+			ctx := context.WithValue(ctx, oauth2.HTTPClient, common.SaneHttpClient())
 			credentials, err := google.CredentialsFromJSON(ctx, credBytes, "https://www.googleapis.com/auth/cloud-platform")
 			if err != nil {
 				continue
 			}
 			if credentials != nil {
-				_, err = credentials.TokenSource.Token()
+				token, err := credentials.TokenSource.Token()
 				if err == nil {
 					s.Verified = true
+					s.ExtraData, s.TypedExtraData = keyDetails(ctx, creds, token.AccessToken)
 				}
 			}
 		}
@@ -105,6 +115,86 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 	return
 }
 
+// keyDetails looks up when the key was created and which roles the service
+// account is granted on its project, to help prioritize the finding. Each
+// lookup needs IAM permissions the service account often doesn't have, so
+// failed lookups are skipped.
+func keyDetails(ctx context.Context, creds gcpKey, accessToken string) (map[string]string, *detectorspb.ExtraData) {
+	client := common.SaneHttpClient()
+	extraData := map[string]string{"project": creds.ProjectID}
+	family := &detectorspb.GCPExtraData{}
+	typed := &detectorspb.ExtraData{
+		Account:  creds.ProjectID,
+		Username: creds.ClientEmail,
+		Family:   &detectorspb.ExtraData_Gcp{Gcp: family},
+	}
+
+	keyURL := fmt.Sprintf("https://iam.googleapis.com/v1/projects/%s/serviceAccounts/%s/keys/%s",
+		url.PathEscape(creds.ProjectID), url.PathEscape(creds.ClientEmail), url.PathEscape(creds.PrivateKeyID))
+	var key struct {
+		ValidAfterTime  string `json:"validAfterTime"`
+		ValidBeforeTime string `json:"validBeforeTime"`
+	}
+	if err := callAPI(ctx, client, http.MethodGet, keyURL, accessToken, &key); err == nil {
+		extraData["key_created"] = key.ValidAfterTime
+		extraData["key_expires"] = key.ValidBeforeTime
+		family.KeyCreationTimestamp = unixTime(key.ValidAfterTime)
+		typed.ExpirationTimestamp = unixTime(key.ValidBeforeTime)
+	}
+
+	policyURL := fmt.Sprintf("https://cloudresourcemanager.googleapis.com/v1/projects/%s:getIamPolicy", url.PathEscape(creds.ProjectID))
+	var policy struct {
+		Bindings []struct {
+			Role    string   `json:"role"`
+			Members []string `json:"members"`
+		} `json:"bindings"`
+	}
+	if err := callAPI(ctx, client, http.MethodPost, policyURL, accessToken, &policy); err == nil {
+		member := "serviceAccount:" + creds.ClientEmail
+		var roles []string
+		for _, binding := range policy.Bindings {
+			for _, m := range binding.Members {
+				if m == member {
+					roles = append(roles, binding.Role)
+					break
+				}
+			}
+		}
+		sort.Strings(roles)
+		extraData["roles"] = strings.Join(roles, ",")
+		typed.Scopes = roles
+	}
+
+	return extraData, typed
+}
+
+// unixTime returns the Unix time of an RFC 3339 timestamp, or 0 if it's
+// invalid.
+func unixTime(timestamp string) int64 {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+func callAPI(ctx context.Context, client *http.Client, method, endpoint, accessToken string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_GCP
 }
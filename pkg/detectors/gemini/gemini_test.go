@@ -116,7 +116,7 @@ func TestGemini_FromChunk(t *testing.T) {
 				}
 				got[i].Raw = nil
 			}
-			ignoreOpts := cmpopts.IgnoreFields(detectors.Result{}, "RawV2")
+			ignoreOpts := cmp.Options{cmpopts.IgnoreFields(detectors.Result{}, "RawV2"), cmpopts.IgnoreUnexported(detectors.Result{})}
 			if diff := cmp.Diff(tt.want, got, ignoreOpts); diff != "" {
 				t.Errorf("Gemini.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
 			}
@@ -13,7 +13,9 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
 
-func New() Scanner {
+// New creates a new Scanner with the given options. Configuration files are
+// parsed by default, see WithStructuredParsing.
+func New(opts ...func(*Scanner)) Scanner {
 	excludePatterns := []string{
 		`[0-9A-Fa-f]{8}(?:-[0-9A-Fa-f]{4}){3}-[0-9A-Fa-f]{12}`,                                    // UUID
 		`[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}`, // UUIDv4
@@ -38,13 +40,30 @@ func New() Scanner {
 		excludeMatchers = append(excludeMatchers, regexp.MustCompile(pat))
 	}
 
-	return Scanner{
-		excludeMatchers: excludeMatchers,
+	scanner := Scanner{
+		excludeMatchers:   excludeMatchers,
+		structuredParsing: true,
+	}
+	for _, opt := range opts {
+		opt(&scanner)
+	}
+
+	return scanner
+}
+
+// WithStructuredParsing sets whether chunks holding JSON, YAML, TOML, .env or
+// INI files are parsed. The key/value pairs of parsed files are checked
+// instead of the raw text, so only values of keys that name a secret, like
+// password or api_key, are reported.
+func WithStructuredParsing(enabled bool) func(*Scanner) {
+	return func(s *Scanner) {
+		s.structuredParsing = enabled
 	}
 }
 
 type Scanner struct {
-	excludeMatchers []*regexp.Regexp
+	excludeMatchers   []*regexp.Regexp
+	structuredParsing bool
 }
 
 // Ensure the Scanner satisfies the interface at compile time.
@@ -67,6 +86,12 @@ func (s Scanner) Keywords() []string {
 func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
 	dataStr := string(data)
 
+	if s.structuredParsing {
+		if fields, format, ok := parseFields(dataStr); ok {
+			return s.fromFields(fields, format), nil
+		}
+	}
+
 	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
 
 	for _, match := range matches {
@@ -106,6 +131,25 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 	return
 }
 
+// fromFields reports the fields of a configuration file holding secrets.
+func (s Scanner) fromFields(fields []field, format string) []detectors.Result {
+	var results []detectors.Result
+	for _, f := range fields {
+		if !isSecretKey(f.key) || !s.isSecretValue(f.value) {
+			continue
+		}
+		results = append(results, detectors.Result{
+			DetectorType: detectorspb.DetectorType_Generic,
+			Raw:          []byte(f.value),
+			ExtraData: map[string]string{
+				"key":    f.key,
+				"format": format,
+			},
+		})
+	}
+	return results
+}
+
 func hasReMatch(matchers []*regexp.Regexp, token string) bool {
 	for _, m := range matchers {
 		if m.MatchString(token) {
@@ -7,6 +7,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/kylelemons/godebug/pretty"
+
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 )
 
@@ -47,6 +49,79 @@ func TestGeneric_FromChunk(t *testing.T) {
 	}
 }
 
+func TestGeneric_Structured(t *testing.T) {
+	ctx := context.Background()
+	s := New()
+
+	tests := []struct {
+		name string
+		data string
+		want map[string]string
+	}{
+		{
+			name: "yaml",
+			data: "database:\n  host: db.internal\n  user: loader\n  password: Wq8#zL2m!Tr5vB\n",
+			want: map[string]string{"database.password": "Wq8#zL2m!Tr5vB"},
+		},
+		{
+			name: "json",
+			data: `{"service": {"api_key": "k7QpZ2xR9mVt4LwB", "region": "us-east-1"}}`,
+			want: map[string]string{"service.api_key": "k7QpZ2xR9mVt4LwB"},
+		},
+		{
+			name: "env",
+			data: "# settings\nexport BUILD_ID=a8Kd93jf0qPzR2\nDB_PASSWORD=\"34t98hofi2309pr230\"\n",
+			want: map[string]string{"DB_PASSWORD": "34t98hofi2309pr230"},
+		},
+		{
+			name: "ini",
+			data: "[default]\nregion = us-east-1\naws_secret_access_key = Jq8vT2nLw5Rz9KpXe3Hc\n",
+			want: map[string]string{"default.aws_secret_access_key": "Jq8vT2nLw5Rz9KpXe3Hc"},
+		},
+		{
+			name: "toml",
+			data: "[server]\nport = 8080\ntoken = \"Zt4Rk9wQ2mLx7Vb\" # rotated monthly\n",
+			want: map[string]string{"server.token": "Zt4Rk9wQ2mLx7Vb"},
+		},
+		{
+			name: "placeholders and references",
+			data: "password: ${DB_PASSWORD}\napi_key: \"{{ .Values.apiKey }}\"\ntoken_url: https://auth.internal/token\npassword_file: /run/secrets/db\nsecret: <your-secret-here>\n",
+			want: map[string]string{},
+		},
+		{
+			name: "secret outside a secret key",
+			data: "description: the secret is 34t98hofi2309pr230\n",
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.FromData(ctx, false, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("Generic.FromData() error = %v", err)
+			}
+			found := map[string]string{}
+			for _, r := range got {
+				found[r.ExtraData["key"]] = string(r.Raw)
+			}
+			if diff := pretty.Compare(found, tt.want); diff != "" {
+				t.Errorf("Generic.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestGeneric_WithoutStructuredParsing(t *testing.T) {
+	s := New(WithStructuredParsing(false))
+	got, err := s.FromData(context.Background(), false, []byte("description: the secret is 34t98hofi2309pr230\n"))
+	if err != nil {
+		t.Fatalf("Generic.FromData() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ExtraData != nil {
+		t.Errorf("Generic.FromData() expected a raw text match, got %v", got)
+	}
+}
+
 func BenchmarkFromData(benchmark *testing.B) {
 	ctx := context.Background()
 	s := New()
@@ -0,0 +1,192 @@
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// field is a key/value pair read from a configuration file. Nested keys are
+// joined with dots, e.g. database.primary.password.
+type field struct {
+	key   string
+	value string
+}
+
+const (
+	minFieldSecretLength = 8
+	maxFieldSecretLength = 256
+	// minFieldSecretEntropy rejects values like passwords that spell a word.
+	minFieldSecretEntropy = 3.0
+)
+
+var (
+	// secretKeyNames are matched against the last part of a field's key,
+	// lower cased and without separators.
+	secretKeyNames = []string{"password", "passwd", "pwd", "secret", "token", "apikey", "accesskey", "privatekey", "credential", "auth"}
+	// nonSecretKeySuffixes name fields that describe a secret rather than
+	// hold it, e.g. token_url or password_file.
+	nonSecretKeySuffixes = []string{"url", "uri", "endpoint", "file", "path", "name", "ref", "type", "length", "expiry", "expires", "policy", "header", "method", "provider", "mode", "enabled", "required"}
+
+	// placeholderPat matches values that reference a secret kept elsewhere,
+	// e.g. ${DB_PASSWORD}, {{ .Values.token }}, %(password)s or <your-key>.
+	placeholderPat = regexp.MustCompile(`^(?:\$\{[^}]*\}|\$\(?[A-Za-z_][A-Za-z0-9_]*\)?|\{\{.*\}\}|%\([^)]*\)s|<[^>]*>|ENC\[.*\]|(?:env|secret|vault):.*)$`)
+
+	sectionPat    = regexp.MustCompile(`^\[\[?\s*([^\[\]]+?)\s*\]\]?$`)
+	assignmentPat = regexp.MustCompile(`^(?:export\s+)?([A-Za-z0-9_.\-"']+)\s*=\s*(.*)$`)
+)
+
+// parseFields reads data as a JSON, YAML, TOML, .env or INI file, and returns
+// its string fields. ok is false if data isn't in one of those formats, e.g.
+// because it's source code or the chunk cut a file in two.
+func parseFields(data string) (fields []field, format string, ok bool) {
+	trimmed := strings.TrimSpace(data)
+	if trimmed == "" {
+		return nil, "", false
+	}
+
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var doc any
+		if err := json.Unmarshal([]byte(trimmed), &doc); err == nil {
+			return flatten("", doc, nil), "json", true
+		}
+	}
+
+	// TOML tables and key/value pairs are read like INI sections, which is
+	// enough to find the strings in them.
+	if fields, format, ok := parseLines(trimmed); ok {
+		return fields, format, true
+	}
+
+	var doc any
+	if err := yaml.Unmarshal([]byte(trimmed), &doc); err == nil {
+		// A plain sentence is valid YAML, but it's not a configuration file.
+		if _, isMap := doc.(map[string]any); isMap {
+			return flatten("", doc, nil), "yaml", true
+		}
+	}
+
+	return nil, "", false
+}
+
+// parseLines reads .env and INI style files, where every line is a comment,
+// a section header or a key=value assignment.
+func parseLines(data string) ([]field, string, bool) {
+	var fields []field
+	format := "env"
+	section := ""
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if match := sectionPat.FindStringSubmatch(line); match != nil {
+			format = "ini"
+			section = strings.Trim(match[1], `"'`)
+			continue
+		}
+		match := assignmentPat.FindStringSubmatch(line)
+		if match == nil {
+			return nil, "", false
+		}
+		key := strings.Trim(match[1], `"'`)
+		if section != "" {
+			key = section + "." + key
+		}
+		fields = append(fields, field{key: key, value: unquote(match[2])})
+	}
+	if len(fields) == 0 {
+		return nil, "", false
+	}
+	return fields, format, true
+}
+
+// unquote removes the quotes around a value, or the comment after an unquoted
+// one.
+func unquote(value string) string {
+	value = strings.TrimSpace(value)
+	for _, quote := range []string{`"""`, `'''`, `"`, `'`} {
+		if len(value) >= 2*len(quote) && strings.HasPrefix(value, quote) {
+			if end := strings.Index(value[len(quote):], quote); end >= 0 {
+				return value[len(quote) : len(quote)+end]
+			}
+		}
+	}
+	if i := strings.Index(value, " #"); i >= 0 {
+		value = value[:i]
+	}
+	return strings.TrimSpace(value)
+}
+
+// flatten returns the string fields of a decoded JSON or YAML document.
+func flatten(prefix string, node any, fields []field) []field {
+	switch v := node.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			fields = flatten(key, v[k], fields)
+		}
+	case []any:
+		for i, item := range v {
+			fields = flatten(fmt.Sprintf("%s[%d]", prefix, i), item, fields)
+		}
+	case string:
+		fields = append(fields, field{key: prefix, value: v})
+	}
+	return fields
+}
+
+// isSecretKey reports whether a field's key names a secret.
+func isSecretKey(key string) bool {
+	if i := strings.LastIndexAny(key, ".]"); i >= 0 {
+		key = key[i+1:]
+	}
+	name := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, suffix := range nonSecretKeySuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	for _, secretName := range secretKeyNames {
+		if strings.Contains(name, secretName) {
+			// Words like author contain auth.
+			if secretName == "auth" && strings.Contains(name, "author") {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretValue reports whether a value looks like a secret rather than a
+// placeholder, a reference or a plain word.
+func (s Scanner) isSecretValue(value string) bool {
+	if len(value) < minFieldSecretLength || len(value) > maxFieldSecretLength {
+		return false
+	}
+	if strings.ContainsAny(value, " \t\n") || placeholderPat.MatchString(value) {
+		return false
+	}
+	if detectors.ShannonEntropy(value) < minFieldSecretEntropy {
+		return false
+	}
+	if detectors.IsKnownFalsePositive(value, detectors.DefaultFalsePositives, true) {
+		return false
+	}
+	return !hasReMatch(s.excludeMatchers, value)
+}
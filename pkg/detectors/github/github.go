@@ -1,11 +1,16 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/crc32"
 	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
@@ -15,6 +20,10 @@ import (
 
 const defaultURL = "https://api.github.com"
 
+// revokeURL is GitHub's credential revocation API. Tokens of GitHub
+// Enterprise Server can't be revoked through it.
+var revokeURL = defaultURL + "/credentials/revoke"
+
 type Scanner struct {
 	verifierURLs []string
 }
@@ -45,6 +54,9 @@ func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
 // Ensure the Scanner satisfies the interfaces at compile time.
 var _ detectors.Detector = (*Scanner)(nil)
 var _ detectors.Versioner = (*Scanner)(nil)
+var _ detectors.OfflineValidator = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+var _ detectors.Revoker = (*Scanner)(nil)
 
 func (s Scanner) Version() int { return 2 }
 
@@ -91,38 +103,35 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 		token := match[1]
 
 		s1 := detectors.Result{
-			DetectorType: detectorspb.DetectorType_Github,
-			Raw:          []byte(token),
+			DetectorType:   detectorspb.DetectorType_Github,
+			Raw:            []byte(token),
+			ExtraData:      map[string]string{"token_type": tokenType(token)},
+			TypedExtraData: &detectorspb.ExtraData{TokenType: tokenType(token)},
 		}
 
 		if verify {
 			client := common.SaneHttpClient()
-			// https://developer.github.com/v3/users/#get-the-authenticated-user
 			for _, url := range s.verifierURLs {
-				req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/user", url), nil)
-				if err != nil {
+				var verified bool
+				var extraData map[string]string
+				var typed *detectorspb.ExtraData
+				switch s1.ExtraData["token_type"] {
+				case "installation":
+					verified, extraData, typed = verifyInstallationToken(ctx, client, url, token)
+				case "refresh":
+					// Refresh tokens can only be exchanged for a new token
+					// together with the app's client secret.
 					continue
+				default:
+					verified, extraData, typed = verifyUserToken(ctx, client, url, token)
 				}
-				req.Header.Add("Content-Type", "application/json; charset=utf-8")
-				req.Header.Add("Authorization", fmt.Sprintf("token %s", token))
-				res, err := client.Do(req)
-				if err == nil {
-					if res.StatusCode >= 200 && res.StatusCode < 300 {
-						var userResponse userRes
-						err = json.NewDecoder(res.Body).Decode(&userResponse)
-						res.Body.Close()
-						if err == nil {
-							s1.Verified = true
-							s1.ExtraData = map[string]string{
-								"username":     userResponse.Login,
-								"url":          userResponse.UserURL,
-								"account_type": userResponse.Type,
-								"site_admin":   fmt.Sprintf("%t", userResponse.SiteAdmin),
-								"name":         userResponse.Name,
-								"company":      userResponse.Company,
-							}
-						}
+				if verified {
+					s1.Verified = true
+					for k, v := range extraData {
+						s1.ExtraData[k] = v
 					}
+					typed.TokenType = s1.TypedExtraData.TokenType
+					s1.TypedExtraData = typed
 				}
 			}
 		}
@@ -137,6 +146,220 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 	return
 }
 
+// tokenTypes maps token prefixes to the kind of token they identify.
+var tokenTypes = map[string]string{
+	"ghp":        "personal_access_token",
+	"github_pat": "fine_grained_personal_access_token",
+	"gho":        "oauth",
+	"ghu":        "user_to_server",
+	"ghs":        "installation",
+	"ghr":        "refresh",
+}
+
+// tokenType returns the kind of token based on its prefix.
+func tokenType(token string) string {
+	if strings.HasPrefix(token, "github_pat_") {
+		return tokenTypes["github_pat"]
+	}
+	prefix, _, _ := strings.Cut(token, "_")
+	return tokenTypes[prefix]
+}
+
+// verifyUserToken verifies tokens that act on behalf of a user and records
+// the user's details and the token's scopes.
+func verifyUserToken(ctx context.Context, client *http.Client, baseURL, token string) (bool, map[string]string, *detectorspb.ExtraData) {
+	// https://developer.github.com/v3/users/#get-the-authenticated-user
+	res, err := get(ctx, client, baseURL+"/user", token)
+	if err != nil {
+		return false, nil, nil
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return false, nil, nil
+	}
+	var userResponse userRes
+	if err := json.NewDecoder(res.Body).Decode(&userResponse); err != nil {
+		return false, nil, nil
+	}
+	extraData := map[string]string{
+		"username":     userResponse.Login,
+		"url":          userResponse.UserURL,
+		"account_type": userResponse.Type,
+		"site_admin":   fmt.Sprintf("%t", userResponse.SiteAdmin),
+		"name":         userResponse.Name,
+		"company":      userResponse.Company,
+	}
+	user := &detectorspb.GitHubExtraData{
+		AccountType: userResponse.Type,
+		SiteAdmin:   userResponse.SiteAdmin,
+		Name:        userResponse.Name,
+		Company:     userResponse.Company,
+	}
+	typed := &detectorspb.ExtraData{
+		Username: userResponse.Login,
+		Endpoint: baseURL,
+		Family:   &detectorspb.ExtraData_Github{Github: user},
+	}
+	// Classic tokens list their scopes and tokens with an expiration date
+	// report it in the response headers.
+	if scopes := res.Header.Get("X-OAuth-Scopes"); scopes != "" {
+		extraData["scopes"] = scopes
+		for _, scope := range strings.Split(scopes, ",") {
+			typed.Scopes = append(typed.Scopes, strings.TrimSpace(scope))
+		}
+	}
+	if expiry := res.Header.Get("GitHub-Authentication-Token-Expiration"); expiry != "" {
+		extraData["expiry"] = expiry
+		if t, err := time.Parse("2006-01-02 15:04:05 MST", expiry); err == nil {
+			typed.ExpirationTimestamp = t.Unix()
+		}
+	}
+	// Fine-grained tokens don't have scopes, they are limited to selected
+	// repositories instead.
+	if strings.HasPrefix(token, "github_pat_") {
+		if repos, err := listRepositories(ctx, client, baseURL+"/user/repos?per_page=100", token); err == nil {
+			extraData["repositories"] = strings.Join(repos, ",")
+			user.Repositories = repos
+		}
+	}
+	return true, extraData, typed
+}
+
+// verifyInstallationToken verifies GitHub App installation tokens, which
+// can't access the /user endpoint, and records the repositories the
+// installation can access.
+func verifyInstallationToken(ctx context.Context, client *http.Client, baseURL, token string) (bool, map[string]string, *detectorspb.ExtraData) {
+	// https://docs.github.com/en/rest/apps/installations#list-repositories-accessible-to-the-app-installation
+	res, err := get(ctx, client, baseURL+"/installation/repositories?per_page=100", token)
+	if err != nil {
+		return false, nil, nil
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return false, nil, nil
+	}
+	var installationResponse struct {
+		TotalCount   int       `json:"total_count"`
+		Repositories []repoRes `json:"repositories"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&installationResponse); err != nil {
+		return false, nil, nil
+	}
+	repos := repoNames(installationResponse.Repositories)
+	extraData := map[string]string{
+		"repository_count": strconv.Itoa(installationResponse.TotalCount),
+		"repositories":     strings.Join(repos, ","),
+	}
+	typed := &detectorspb.ExtraData{
+		Endpoint: baseURL,
+		Family: &detectorspb.ExtraData_Github{Github: &detectorspb.GitHubExtraData{
+			Repositories:    repos,
+			RepositoryCount: int64(installationResponse.TotalCount),
+		}},
+	}
+	return true, extraData, typed
+}
+
+type repoRes struct {
+	FullName string `json:"full_name"`
+}
+
+// listRepositories returns the full names of the first page of repositories
+// listed at the URL.
+func listRepositories(ctx context.Context, client *http.Client, url, token string) ([]string, error) {
+	res, err := get(ctx, client, url, token)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	var repos []repoRes
+	if err := json.NewDecoder(res.Body).Decode(&repos); err != nil {
+		return nil, err
+	}
+	return repoNames(repos), nil
+}
+
+func repoNames(repos []repoRes) []string {
+	names := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		names = append(names, repo.FullName)
+	}
+	return names
+}
+
+func get(ctx context.Context, client *http.Client, url, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", token))
+	return client.Do(req)
+}
+
+// ValidateOffline checks the CRC32 checksum encoded in the last six
+// characters of prefixed tokens. Fine-grained tokens don't have a documented
+// checksum, so they are always considered valid.
+func (s Scanner) ValidateOffline(result detectors.Result) bool {
+	token := string(result.Raw)
+	if strings.HasPrefix(token, "github_pat_") {
+		return true
+	}
+	_, body, ok := strings.Cut(token, "_")
+	if !ok || len(body) != 36 {
+		return false
+	}
+	return body[30:] == tokenChecksum(body[:30])
+}
+
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// tokenChecksum returns the base62 encoded, zero padded CRC32 checksum GitHub
+// appends to the random part of a token.
+func tokenChecksum(random string) string {
+	checksum := crc32.ChecksumIEEE([]byte(random))
+	encoded := make([]byte, 6)
+	for i := len(encoded) - 1; i >= 0; i-- {
+		encoded[i] = base62Alphabet[checksum%62]
+		checksum /= 62
+	}
+	return string(encoded)
+}
+
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_Github
 }
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.verifierURLs
+}
+
+// Revoke submits the token to GitHub's credential revocation API, which
+// doesn't need authentication. GitHub accepts the request and revokes the
+// token asynchronously.
+// https://docs.github.com/en/rest/credentials/revoke
+func (s Scanner) Revoke(ctx context.Context, result detectors.Result) error {
+	body, err := json.Marshal(map[string][]string{"credentials": {string(result.Raw)}})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", revokeURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Accept", "application/vnd.github+json")
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	res, err := common.SaneHttpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -51,8 +51,10 @@ func TestGitHub_FromChunk(t *testing.T) {
 			},
 			want: []detectors.Result{
 				{
-					DetectorType: detectorspb.DetectorType_Github,
-					Verified:     false,
+					DetectorType:   detectorspb.DetectorType_Github,
+					Verified:       false,
+					ExtraData:      map[string]string{"token_type": "personal_access_token"},
+					TypedExtraData: &detectorspb.ExtraData{TokenType: "personal_access_token"},
 				},
 			},
 			wantErr: false,
@@ -67,8 +69,10 @@ func TestGitHub_FromChunk(t *testing.T) {
 			},
 			want: []detectors.Result{
 				{
-					DetectorType: detectorspb.DetectorType_Github,
-					Verified:     false,
+					DetectorType:   detectorspb.DetectorType_Github,
+					Verified:       false,
+					ExtraData:      map[string]string{"token_type": "oauth"},
+					TypedExtraData: &detectorspb.ExtraData{TokenType: "oauth"},
 				},
 			},
 			wantErr: false,
@@ -83,8 +87,10 @@ func TestGitHub_FromChunk(t *testing.T) {
 			},
 			want: []detectors.Result{
 				{
-					DetectorType: detectorspb.DetectorType_Github,
-					Verified:     false,
+					DetectorType:   detectorspb.DetectorType_Github,
+					Verified:       false,
+					ExtraData:      map[string]string{"token_type": "user_to_server"},
+					TypedExtraData: &detectorspb.ExtraData{TokenType: "user_to_server"},
 				},
 			},
 			wantErr: false,
@@ -99,8 +105,10 @@ func TestGitHub_FromChunk(t *testing.T) {
 			},
 			want: []detectors.Result{
 				{
-					DetectorType: detectorspb.DetectorType_Github,
-					Verified:     false,
+					DetectorType:   detectorspb.DetectorType_Github,
+					Verified:       false,
+					ExtraData:      map[string]string{"token_type": "installation"},
+					TypedExtraData: &detectorspb.ExtraData{TokenType: "installation"},
 				},
 			},
 			wantErr: false,
@@ -115,8 +123,10 @@ func TestGitHub_FromChunk(t *testing.T) {
 			},
 			want: []detectors.Result{
 				{
-					DetectorType: detectorspb.DetectorType_Github,
-					Verified:     false,
+					DetectorType:   detectorspb.DetectorType_Github,
+					Verified:       false,
+					ExtraData:      map[string]string{"token_type": "refresh"},
+					TypedExtraData: &detectorspb.ExtraData{TokenType: "refresh"},
 				},
 			},
 			wantErr: false,
@@ -131,8 +141,10 @@ func TestGitHub_FromChunk(t *testing.T) {
 			},
 			want: []detectors.Result{
 				{
-					DetectorType: detectorspb.DetectorType_Github,
-					Verified:     false,
+					DetectorType:   detectorspb.DetectorType_Github,
+					Verified:       false,
+					ExtraData:      map[string]string{"token_type": "personal_access_token"},
+					TypedExtraData: &detectorspb.ExtraData{TokenType: "personal_access_token"},
 				},
 			},
 			wantErr: false,
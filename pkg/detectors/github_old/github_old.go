@@ -44,6 +44,7 @@ func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
 // Ensure the Scanner satisfies the interfaces at compile time.
 var _ detectors.Detector = (*Scanner)(nil)
 var _ detectors.Versioner = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
 
 func (s Scanner) Version() int { return 1 }
 
@@ -132,3 +133,8 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_Github
 }
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.verifierURLs
+}
@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// User is the subset of the /api/v4/user response recorded for verified
+// tokens.
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	WebURL   string `json:"web_url"`
+}
+
+// TokenInfo is the subset of the /api/v4/personal_access_tokens/self response
+// recorded for verified tokens.
+type TokenInfo struct {
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt string   `json:"expires_at"`
+}
+
+// botUserPat matches the usernames of the bot users GitLab creates for
+// project and group access tokens.
+var botUserPat = regexp.MustCompile(`^(project|group)_\d+_bot`)
+
+// TokenType returns whether a token is a personal, project, or group access
+// token based on the username of its user.
+func TokenType(username string) string {
+	if match := botUserPat.FindStringSubmatch(username); match != nil {
+		return match[1]
+	}
+	return "personal"
+}
+
+// ExpirationTimestamp returns the Unix time of a token's expiration date, or 0
+// if it doesn't expire.
+func ExpirationTimestamp(expiresAt string) int64 {
+	t, err := time.Parse("2006-01-02", expiresAt)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// FetchTokenInfo returns the scopes and expiry of a valid token. Any token
+// can read its own details regardless of its scopes.
+func FetchTokenInfo(ctx context.Context, client *http.Client, baseURL, token string) (TokenInfo, error) {
+	var info TokenInfo
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/api/v4/personal_access_tokens/self", nil)
+	if err != nil {
+		return info, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	res, err := client.Do(req)
+	if err != nil {
+		return info, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return info, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	err = json.NewDecoder(res.Body).Decode(&info)
+	return info, err
+}
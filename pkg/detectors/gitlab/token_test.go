@@ -0,0 +1,33 @@
+package gitlab
+
+import "testing"
+
+func TestTokenType(t *testing.T) {
+	tests := map[string]string{
+		"project_123_bot":                  "project",
+		"project_123_bot_4f0b1c2d3e4f5a6b": "project",
+		"group_45_bot":                     "group",
+		"group_45_bot_9a8b7c6d5e4f3a2b":    "group",
+		"jdoe":                             "personal",
+		"project_bot":                      "personal",
+		"my_project_123_bot":               "personal",
+	}
+	for username, want := range tests {
+		if got := TokenType(username); got != want {
+			t.Errorf("%s: got %q, want %q", username, got, want)
+		}
+	}
+}
+
+func TestExpirationTimestamp(t *testing.T) {
+	tests := map[string]int64{
+		"2024-05-01": 1714521600,
+		"":           0,
+		"never":      0,
+	}
+	for expiresAt, want := range tests {
+		if got := ExpirationTimestamp(expiresAt); got != want {
+			t.Errorf("%q: got %d, want %d", expiresAt, got, want)
+		}
+	}
+}
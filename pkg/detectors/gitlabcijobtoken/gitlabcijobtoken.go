@@ -0,0 +1,154 @@
+package gitlabcijobtoken
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+const defaultURL = "https://gitlab.com"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given URLs to the list of URLs to check for
+// verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	// Newer job tokens are prefixed with glcbt-. Older ones are only
+	// recognizable by the variable or header they're assigned to, as in
+	// .gitlab-ci.yml files and scripts calling the API.
+	keyPat     = regexp.MustCompile(`\b(glcbt-[a-zA-Z0-9_\-]{20,})\b`)
+	contextPat = regexp.MustCompile(`(?i)(?:CI_JOB_TOKEN|JOB-TOKEN|job_token)["']?\s*[:=]\s*["']?([a-zA-Z0-9_\-]{20,})\b`)
+)
+
+// jobRes is the subset of the /api/v4/job response recorded for verified
+// tokens.
+type jobRes struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	WebURL  string `json:"web_url"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"glcbt-", "ci_job_token", "job-token", "job_token"}
+}
+
+// FromData will find and optionally verify GitLab CI job tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+	matches = append(matches, contextPat.FindAllStringSubmatch(dataStr, -1)...)
+
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		token := match[1]
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_GitlabCIJobToken,
+			Raw:          []byte(token),
+		}
+
+		// Job tokens are only valid while their job is running, so most
+		// leaked tokens won't verify.
+		if verify {
+			client := common.SaneHttpClient()
+			for _, baseURL := range s.urls() {
+				req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/api/v4/job", nil)
+				if err != nil {
+					continue
+				}
+				req.Header.Add("JOB-TOKEN", token)
+				res, err := client.Do(req)
+				if err == nil {
+					if res.StatusCode == http.StatusOK {
+						s1.Verified = true
+						var job jobRes
+						if err := json.NewDecoder(res.Body).Decode(&job); err == nil {
+							s1.ExtraData = map[string]string{
+								"job_id":  strconv.Itoa(job.ID),
+								"job":     job.Name,
+								"project": job.Project.PathWithNamespace,
+								"url":     job.WebURL,
+							}
+							s1.TypedExtraData = &detectorspb.ExtraData{
+								Account:  job.Project.PathWithNamespace,
+								Endpoint: baseURL,
+							}
+						}
+					}
+					res.Body.Close()
+					if s1.Verified {
+						break
+					}
+				}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// urls returns the GitLab instances to verify tokens against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_GitlabCIJobToken
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
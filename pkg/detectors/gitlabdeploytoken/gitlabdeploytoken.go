@@ -0,0 +1,141 @@
+package gitlabdeploytoken
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+const defaultURL = "https://gitlab.com"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given URLs to the list of URLs to check for
+// verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	keyPat = regexp.MustCompile(`\b(gldt-[a-zA-Z0-9_\-]{20})\b`)
+	// Deploy tokens authenticate together with a generated username.
+	usernamePat = regexp.MustCompile(`\b(gitlab\+deploy-token-\d+)\b`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"gldt-"}
+}
+
+// FromData will find and optionally verify GitLab deploy tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+	var usernames []string
+	for _, match := range usernamePat.FindAllStringSubmatch(dataStr, -1) {
+		usernames = append(usernames, match[1])
+	}
+	// The token can't be verified without its username, so report it on its
+	// own if there is none.
+	if len(usernames) == 0 {
+		usernames = []string{""}
+	}
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		for _, username := range usernames {
+			s1 := detectors.Result{
+				DetectorType: detectorspb.DetectorType_GitlabDeployToken,
+				Raw:          []byte(token),
+			}
+			if username != "" {
+				s1.RawV2 = []byte(token + username)
+				s1.ExtraData = map[string]string{"username": username}
+				s1.TypedExtraData = &detectorspb.ExtraData{Username: username}
+			}
+
+			if verify && username != "" {
+				client := common.SaneHttpClient()
+				for _, baseURL := range s.urls() {
+					// Deploy tokens can't use the REST API, but they can
+					// authenticate to the container registry.
+					req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/jwt/auth?service=container_registry", nil)
+					if err != nil {
+						continue
+					}
+					req.SetBasicAuth(username, token)
+					res, err := client.Do(req)
+					if err == nil {
+						res.Body.Close()
+						// 200 means the credentials are valid, 401 means
+						// they aren't.
+						if res.StatusCode == http.StatusOK {
+							s1.Verified = true
+							s1.ExtraData["url"] = baseURL
+							s1.TypedExtraData.Endpoint = baseURL
+							break
+						}
+					}
+				}
+			}
+
+			if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+				continue
+			}
+
+			results = append(results, s1)
+		}
+	}
+
+	return results, nil
+}
+
+// urls returns the GitLab instances to verify tokens against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_GitlabDeployToken
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
@@ -0,0 +1,129 @@
+package gitlabfeedtoken
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+const defaultURL = "https://gitlab.com"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given URLs to the list of URLs to check for
+// verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	// Feed tokens start with glft-, incoming email tokens with glimt-.
+	keyPat = regexp.MustCompile(`\b((?:glft|glimt)-[a-zA-Z0-9_\-]{20,})\b`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"glft-", "glimt-"}
+}
+
+// FromData will find and optionally verify GitLab feed and incoming email tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_GitlabFeedToken,
+			Raw:          []byte(token),
+			ExtraData:    map[string]string{"token_type": "feed"},
+		}
+		s1.TypedExtraData = &detectorspb.ExtraData{TokenType: "feed"}
+
+		// Incoming email tokens are only accepted as part of an email
+		// address, so they can't be verified over HTTP.
+		if strings.HasPrefix(token, "glimt-") {
+			s1.ExtraData["token_type"] = "incoming_email"
+			s1.TypedExtraData.TokenType = "incoming_email"
+		} else if verify {
+			client := common.SaneHttpClient()
+			for _, baseURL := range s.urls() {
+				req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/dashboard/projects.atom?feed_token="+token, nil)
+				if err != nil {
+					continue
+				}
+				res, err := client.Do(req)
+				if err == nil {
+					res.Body.Close()
+					// Invalid tokens are redirected to the sign in page
+					// instead of receiving the feed.
+					if res.StatusCode == http.StatusOK && strings.Contains(res.Header.Get("Content-Type"), "atom") {
+						s1.Verified = true
+						s1.ExtraData["url"] = baseURL
+						s1.TypedExtraData.Endpoint = baseURL
+						break
+					}
+				}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// urls returns the GitLab instances to verify tokens against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_GitlabFeedToken
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
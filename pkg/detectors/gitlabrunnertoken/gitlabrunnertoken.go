@@ -0,0 +1,134 @@
+package gitlabrunnertoken
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+const defaultURL = "https://gitlab.com"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given URLs to the list of URLs to check for
+// verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+const registrationTokenPrefix = "GR1348941"
+
+var (
+	// Runner authentication tokens start with glrt-, runner registration
+	// tokens with GR1348941.
+	keyPat = regexp.MustCompile(`\b((?:glrt-|` + registrationTokenPrefix + `)[a-zA-Z0-9_\-]{20,})\b`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"glrt-", registrationTokenPrefix}
+}
+
+// FromData will find and optionally verify GitLab runner tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_GitlabRunnerToken,
+			Raw:          []byte(token),
+			ExtraData:    map[string]string{"token_type": "authentication"},
+		}
+		s1.TypedExtraData = &detectorspb.ExtraData{TokenType: "authentication"}
+
+		// The only endpoint that accepts registration tokens registers a new
+		// runner, so they are never verified.
+		if strings.HasPrefix(token, registrationTokenPrefix) {
+			s1.ExtraData["token_type"] = "registration"
+			s1.TypedExtraData.TokenType = "registration"
+		} else if verify {
+			client := common.SaneHttpClient()
+			for _, baseURL := range s.urls() {
+				form := url.Values{"token": {token}}
+				req, err := http.NewRequestWithContext(ctx, "POST", baseURL+"/api/v4/runners/verify", strings.NewReader(form.Encode()))
+				if err != nil {
+					continue
+				}
+				req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+				res, err := client.Do(req)
+				if err == nil {
+					res.Body.Close()
+					// 200 means the token is valid, 403 means it isn't.
+					if res.StatusCode == http.StatusOK {
+						s1.Verified = true
+						s1.ExtraData["url"] = baseURL
+						s1.TypedExtraData.Endpoint = baseURL
+						break
+					}
+				}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// urls returns the GitLab instances to verify tokens against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_GitlabRunnerToken
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
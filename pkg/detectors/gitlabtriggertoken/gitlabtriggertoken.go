@@ -0,0 +1,150 @@
+package gitlabtriggertoken
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+const defaultURL = "https://gitlab.com"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given URLs to the list of URLs to check for
+// verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	keyPat = regexp.MustCompile(`\b(glptt-[a-f0-9]{40})\b`)
+	// Trigger tokens belong to a single project, usually referenced in the
+	// trigger URL next to the token.
+	projectPat = regexp.MustCompile(`projects/(\d+)/trigger`)
+)
+
+// verificationRef is not a valid git ref name, so triggering a pipeline for
+// it never starts one.
+const verificationRef = "trufflehog..verification"
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"glptt-"}
+}
+
+// FromData will find and optionally verify GitLab pipeline trigger tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+	var projects []string
+	for _, match := range projectPat.FindAllStringSubmatch(dataStr, -1) {
+		projects = append(projects, match[1])
+	}
+	// The token can't be verified without its project, so report it on its
+	// own if there is none.
+	if len(projects) == 0 {
+		projects = []string{""}
+	}
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		for _, project := range projects {
+			s1 := detectors.Result{
+				DetectorType: detectorspb.DetectorType_GitlabTriggerToken,
+				Raw:          []byte(token),
+			}
+			if project != "" {
+				s1.RawV2 = []byte(token + project)
+				s1.ExtraData = map[string]string{"project_id": project}
+				s1.TypedExtraData = &detectorspb.ExtraData{Account: project}
+			}
+
+			if verify && project != "" {
+				client := common.SaneHttpClient()
+				for _, baseURL := range s.urls() {
+					form := url.Values{"token": {token}, "ref": {verificationRef}}
+					endpoint := fmt.Sprintf("%s/api/v4/projects/%s/trigger/pipeline", baseURL, project)
+					req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+					if err != nil {
+						continue
+					}
+					req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+					res, err := client.Do(req)
+					if err == nil {
+						res.Body.Close()
+						// 400 means the token is valid but the ref doesn't
+						// exist, 404 means the token isn't valid for the
+						// project.
+						if res.StatusCode == http.StatusBadRequest {
+							s1.Verified = true
+							s1.ExtraData["url"] = baseURL
+							s1.TypedExtraData.Endpoint = baseURL
+							break
+						}
+					}
+				}
+			}
+
+			if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+				continue
+			}
+
+			results = append(results, s1)
+		}
+	}
+
+	return results, nil
+}
+
+// urls returns the GitLab instances to verify tokens against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_GitlabTriggerToken
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
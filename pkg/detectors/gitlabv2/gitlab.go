@@ -2,12 +2,17 @@ package gitlabv2
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlab"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
 
@@ -43,6 +48,8 @@ func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
 // Ensure the Scanner satisfies the interfaces at compile time.
 var _ detectors.Detector = (*Scanner)(nil)
 var _ detectors.Versioner = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+var _ detectors.Revoker = (*Scanner)(nil)
 
 func (*Scanner) Version() int { return 2 }
 
@@ -87,13 +94,57 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 				req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", match[1]))
 				res, err := client.Do(req)
 				if err == nil {
-					res.Body.Close() // The request body is unused.
-
 					// 200 means good key and has `read_user` scope
 					// 403 means good key but not the right scope
 					// 401 is bad key
-					if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusForbidden {
+					switch res.StatusCode {
+					case http.StatusOK:
 						secret.Verified = true
+						var user gitlab.User
+						if err := json.NewDecoder(res.Body).Decode(&user); err == nil {
+							secret.ExtraData = map[string]string{
+								"user_id":    strconv.Itoa(user.ID),
+								"username":   user.Username,
+								"name":       user.Name,
+								"url":        user.WebURL,
+								"token_type": gitlab.TokenType(user.Username),
+							}
+							secret.TypedExtraData = &detectorspb.ExtraData{
+								Username:  user.Username,
+								Endpoint:  baseURL,
+								TokenType: gitlab.TokenType(user.Username),
+								Family: &detectorspb.ExtraData_Gitlab{Gitlab: &detectorspb.GitLabExtraData{
+									UserId: strconv.Itoa(user.ID),
+									Name:   user.Name,
+								}},
+							}
+						}
+					case http.StatusForbidden:
+						secret.Verified = true
+					}
+					res.Body.Close()
+
+					if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusForbidden {
+						if token, err := gitlab.FetchTokenInfo(ctx, client, baseURL, match[1]); err == nil {
+							if secret.ExtraData == nil {
+								secret.ExtraData = map[string]string{}
+							}
+							secret.ExtraData["token_name"] = token.Name
+							secret.ExtraData["scopes"] = strings.Join(token.Scopes, ",")
+							secret.ExtraData["expires_at"] = token.ExpiresAt
+							if token.ExpiresAt == "" {
+								secret.ExtraData["expires_at"] = "never"
+							}
+							if secret.TypedExtraData == nil {
+								secret.TypedExtraData = &detectorspb.ExtraData{
+									Endpoint: baseURL,
+									Family:   &detectorspb.ExtraData_Gitlab{Gitlab: &detectorspb.GitLabExtraData{}},
+								}
+							}
+							secret.TypedExtraData.Scopes = token.Scopes
+							secret.TypedExtraData.ExpirationTimestamp = gitlab.ExpirationTimestamp(token.ExpiresAt)
+							secret.TypedExtraData.GetGitlab().TokenName = token.Name
+						}
 					}
 				}
 			}
@@ -113,3 +164,33 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_Gitlab
 }
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.verifierURLs
+}
+
+// Revoke revokes the token with the instance it's valid for. Any token can
+// revoke itself regardless of its scopes.
+func (s Scanner) Revoke(ctx context.Context, result detectors.Result) error {
+	client := common.SaneHttpClient()
+	var errs []error
+	for _, baseURL := range s.verifierURLs {
+		req, err := http.NewRequestWithContext(ctx, "DELETE", baseURL+"/api/v4/personal_access_tokens/self", nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", result.Raw))
+		res, err := client.Do(req)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", baseURL, err))
+			continue
+		}
+		res.Body.Close()
+		if res.StatusCode == http.StatusNoContent {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("%s: unexpected HTTP response status %d", baseURL, res.StatusCode))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,143 @@
+package huggingface
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat = regexp.MustCompile(`\b(hf_[a-zA-Z0-9]{34})\b`)
+)
+
+type whoamiResponse struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Orgs []struct {
+		Name string `json:"name"`
+		// RoleInOrg is the user's role, e.g. admin or read.
+		RoleInOrg string `json:"roleInOrg"`
+	} `json:"orgs"`
+	Auth struct {
+		AccessToken struct {
+			DisplayName string `json:"displayName"`
+			// Role is read, write, or fineGrained.
+			Role string `json:"role"`
+		} `json:"accessToken"`
+	} `json:"auth"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"hf_"}
+}
+
+// FromData will find and optionally verify Hugging Face secrets in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_HuggingFace,
+			Raw:          []byte(token),
+		}
+
+		if verify {
+			whoami, err := whoami(ctx, token)
+			if err == nil && whoami != nil {
+				s1.Verified = true
+				s1.ExtraData = whoami.extraData()
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// whoami returns the token's owner, or nil if the token isn't valid.
+func whoami(ctx context.Context, token string) (*whoamiResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://huggingface.co/api/whoami-v2", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var whoami whoamiResponse
+		if err := json.NewDecoder(res.Body).Decode(&whoami); err != nil {
+			return nil, err
+		}
+		return &whoami, nil
+	case http.StatusUnauthorized:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+// extraData describes the token's owner and what it can access. Write tokens
+// can push to every repository of the user and their organizations.
+func (w *whoamiResponse) extraData() map[string]string {
+	extraData := map[string]string{
+		"username":   w.Name,
+		"token_role": w.Auth.AccessToken.Role,
+	}
+	if w.Type != "" {
+		extraData["account_type"] = w.Type
+	}
+	if w.Auth.AccessToken.DisplayName != "" {
+		extraData["token_name"] = w.Auth.AccessToken.DisplayName
+	}
+	if len(w.Orgs) > 0 {
+		orgs := make([]string, 0, len(w.Orgs))
+		for _, org := range w.Orgs {
+			if org.RoleInOrg != "" {
+				orgs = append(orgs, fmt.Sprintf("%s (%s)", org.Name, org.RoleInOrg))
+			} else {
+				orgs = append(orgs, org.Name)
+			}
+		}
+		sort.Strings(orgs)
+		extraData["orgs"] = strings.Join(orgs, ",")
+	}
+	return extraData
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_HuggingFace
+}
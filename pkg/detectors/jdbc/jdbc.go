@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -19,6 +21,9 @@ var _ detectors.Detector = (*Scanner)(nil)
 
 var (
 	keyPat = regexp.MustCompile(`(?i)jdbc:[\w]{3,10}:[^\s"']{0,512}`)
+	// Oracle puts the credentials before the host, e.g.
+	// jdbc:oracle:thin:scott/tiger@//db:1521/orcl
+	oracleUserPassPat = regexp.MustCompile(`(?i)^jdbc:oracle:\w+:[^/@:]+/([^@]+)@`)
 )
 
 // Keywords are used for efficiently pre-filtering chunks.
@@ -43,14 +48,11 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 
 		if verify {
 			s.Verified = false
-			j, err := newJDBC(jdbcConn)
-			if err != nil {
-				continue
+			// Connections we can't verify, e.g. Oracle or a malformed
+			// subname, are still reported unverified.
+			if j, err := newJDBC(jdbcConn); err == nil {
+				s.Verified = verifyJDBC(ctx, j)
 			}
-			ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-			defer cancel()
-			s.Verified = j.ping(ctx)
-			// TODO: specialized redaction
 		}
 
 		if !s.Verified && detectors.IsKnownFalsePositive(string(s.Raw), detectors.DefaultFalsePositives, false) {
@@ -64,6 +66,9 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 }
 
 func tryRedactAnonymousJDBC(conn string) string {
+	if s, ok := tryRedactOracle(conn); ok {
+		return s
+	}
 	if s, ok := tryRedactBasicAuth(conn); ok {
 		return s
 	}
@@ -79,6 +84,16 @@ func tryRedactAnonymousJDBC(conn string) string {
 	return conn
 }
 
+// Oracle "jdbc:oracle:thin:username/password@host" style
+func tryRedactOracle(conn string) (string, bool) {
+	match := oracleUserPassPat.FindStringSubmatchIndex(conn)
+	if match == nil {
+		return "", false
+	}
+	start, end := match[2], match[3]
+	return conn[:start] + strings.Repeat("*", end-start) + conn[end:], true
+}
+
 // Basic authentication "username:password@host" style
 func tryRedactBasicAuth(conn string) (string, bool) {
 	userPass, postfix, found := strings.Cut(conn, "@")
@@ -154,6 +169,9 @@ func tryRedactRegex(conn string) (string, bool) {
 	return newConn, true
 }
 
+// supportedSubprotocols are the subprotocols whose connections are verified.
+// Oracle connections are redacted but always reported unverified, since no
+// Oracle driver is built in.
 var supportedSubprotocols = map[string]func(string) (jdbc, error){
 	"sqlite":     parseSqlite,
 	"mysql":      parseMySQL,
@@ -163,6 +181,9 @@ var supportedSubprotocols = map[string]func(string) (jdbc, error){
 
 type jdbc interface {
 	ping(context.Context) bool
+	// endpoint is the server the connection is made to, or nil for local
+	// databases.
+	endpoint() *url.URL
 }
 
 func newJDBC(conn string) (jdbc, error) {
@@ -183,6 +204,19 @@ func newJDBC(conn string) (jdbc, error) {
 	return parser(subname)
 }
 
+// verifyJDBC pings the database of the connection, giving up after a few
+// seconds.
+func verifyJDBC(ctx context.Context, j jdbc) bool {
+	if endpoint := j.endpoint(); endpoint != nil {
+		if err := common.CheckVerificationConnection(ctx, endpoint); err != nil {
+			return false
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	return j.ping(ctx)
+}
+
 func ping(ctx context.Context, driverName, conn string) bool {
 	if err := pingErr(ctx, driverName, conn); err != nil {
 		return false
@@ -131,6 +131,38 @@ func TestJdbc_FromChunk(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "sqlserver user and password properties, unverified",
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(`jdbc:sqlserver://db.internal:1433;databaseName=orders;user=app;password=Tr0ub4dor3`),
+				verify: false,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_JDBC,
+					Verified:     false,
+					Redacted:     "jdbc:sqlserver://db.internal:1433;databaseName=orders;user=app;password=**********",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "oracle, unverified",
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(`url=jdbc:oracle:thin:scott/Tr0ub4dor3@//db.internal:1521/orcl`),
+				verify: true,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_JDBC,
+					Verified:     false,
+					Redacted:     "jdbc:oracle:thin:scott/**********@//db.internal:1521/orcl",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -170,3 +202,29 @@ func BenchmarkFromData(benchmark *testing.B) {
 		})
 	}
 }
+
+func TestParseCredentialProperties(t *testing.T) {
+	j, err := parseMySQL("//db.internal:3306/orders?user=app&password=Tr0ub4dor3&useSSL=false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := j.(*mysqlJDBC).build(), "app:Tr0ub4dor3@tcp(db.internal:3306)/orders?useSSL=false"; got != want {
+		t.Errorf("mysql: got %q, want %q", got, want)
+	}
+
+	j, err = parseSqlServer("//db.internal:1433;databaseName=orders;user=app;password=Tr0ub4dor3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := j.(*sqlServerJDBC).params
+	want := map[string]string{
+		"server":   "db.internal",
+		"port":     "1433",
+		"database": "orders",
+		"user id":  "app",
+		"password": "Tr0ub4dor3",
+	}
+	if diff := pretty.Compare(params, want); diff != "" {
+		t.Errorf("sqlserver: (-got +want)\n%s", diff)
+	}
+}
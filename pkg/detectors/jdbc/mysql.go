@@ -3,6 +3,7 @@ package jdbc
 import (
 	"context"
 	"errors"
+	"net/url"
 	"strings"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -29,6 +30,15 @@ func (s *mysqlJDBC) ping(ctx context.Context) bool {
 	return ping(ctx, "mysql", s.build())
 }
 
+func (s *mysqlJDBC) endpoint() *url.URL {
+	addr, ok := strings.CutPrefix(s.host, "tcp(")
+	if !ok {
+		// Unix sockets are local.
+		return nil
+	}
+	return &url.URL{Scheme: "mysql", Host: strings.TrimSuffix(addr, ")")}
+}
+
 func (s *mysqlJDBC) build() string {
 	conn := s.host + "/" + s.database
 	if s.userPass != "" {
@@ -56,6 +66,14 @@ func parseMySQL(subname string) (jdbc, error) {
 	if !found {
 		return nil, errors.New("expected host and database to be separated by /")
 	}
+	if !strings.Contains(host, "(") {
+		host = "tcp(" + host + ")"
+	}
+	// Credentials can also be passed as properties, which the Go driver
+	// doesn't accept.
+	if userPass == "" {
+		userPass, params = credentialsFromParams(params)
+	}
 	return &mysqlJDBC{
 		conn:     subname[2:],
 		userPass: userPass,
@@ -64,3 +82,28 @@ func parseMySQL(subname string) (jdbc, error) {
 		params:   params,
 	}, nil
 }
+
+// credentialsFromParams moves the user and password properties out of the
+// query string and returns them in "user:password" form along with the
+// remaining parameters.
+func credentialsFromParams(params string) (string, string) {
+	var user, password string
+	var rest []string
+	for _, param := range strings.Split(params, "&") {
+		key, value, _ := strings.Cut(param, "=")
+		switch strings.ToLower(key) {
+		case "user":
+			user = value
+		case "password":
+			password = value
+		default:
+			if param != "" {
+				rest = append(rest, param)
+			}
+		}
+	}
+	if user == "" {
+		return "", params
+	}
+	return user + ":" + password, strings.Join(rest, "&")
+}
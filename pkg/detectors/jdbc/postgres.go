@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 
 	_ "github.com/lib/pq"
@@ -48,6 +49,10 @@ func (s *postgresJDBC) ping(ctx context.Context) bool {
 	return false
 }
 
+func (s *postgresJDBC) endpoint() *url.URL {
+	return &url.URL{Scheme: "postgres", Host: s.params["host"]}
+}
+
 func joinKeyValues(m map[string]string, sep string) string {
 	var data []string
 	for k, v := range m {
@@ -3,6 +3,7 @@ package jdbc
 import (
 	"context"
 	"errors"
+	"net/url"
 	"strings"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -22,6 +23,11 @@ func (s *sqliteJDBC) ping(ctx context.Context) bool {
 	return ping(ctx, "sqlite3", s.filename)
 }
 
+// endpoint is nil since sqlite databases are local files.
+func (s *sqliteJDBC) endpoint() *url.URL {
+	return nil
+}
+
 func parseSqlite(subname string) (jdbc, error) {
 	filename, params, _ := strings.Cut(subname, "?")
 	if filename == "" {
@@ -3,6 +3,8 @@ package jdbc
 import (
 	"context"
 	"errors"
+	"net"
+	"net/url"
 	"strings"
 
 	_ "github.com/denisenkom/go-mssqldb"
@@ -24,20 +26,46 @@ func (s *sqlServerJDBC) ping(ctx context.Context) bool {
 	return ping(ctx, "mssql", "sqlserver://"+s.conn)
 }
 
+func (s *sqlServerJDBC) endpoint() *url.URL {
+	host := s.params["server"]
+	if port := s.params["port"]; port != "" {
+		host = net.JoinHostPort(host, port)
+	}
+	return &url.URL{Scheme: "sqlserver", Host: host}
+}
+
 func parseSqlServer(subname string) (jdbc, error) {
 	if !strings.HasPrefix(subname, "//") {
 		return nil, errors.New("expected connection to start with //")
 	}
+	// expected form: //HOST[:PORT][;key=value[;key=value]]
 	conn := strings.TrimPrefix(subname, "//")
 	params := map[string]string{
 		"user id":  "sa",
 		"database": "master",
 	}
-	for _, param := range strings.Split(conn, ";") {
+	hostPort, properties, _ := strings.Cut(conn, ";")
+	if host, port, found := strings.Cut(hostPort, ":"); found {
+		params["server"] = host
+		params["port"] = port
+	} else if hostPort != "" {
+		params["server"] = hostPort
+	}
+	for _, param := range strings.Split(properties, ";") {
 		key, value, found := strings.Cut(param, "=")
 		if !found {
 			continue
 		}
+		// Translate the JDBC driver's property names to the ones the Go
+		// driver understands.
+		switch strings.ToLower(key) {
+		case "user", "username":
+			params["user id"] = value
+			continue
+		case "databasename":
+			params["database"] = value
+			continue
+		}
 		params[key] = value
 		if key != "password" && strings.Contains(strings.ToLower(key), "password") {
 			params["password"] = value
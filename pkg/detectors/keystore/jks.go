@@ -0,0 +1,159 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+const (
+	jksMagic   = 0xFEEDFEED
+	jceksMagic = 0xCECECECE
+
+	jksPrivateKeyTag  = 1
+	jksTrustedCertTag = 2
+)
+
+// jksEntry is an entry of a Java keystore. Certificates are stored
+// unencrypted, only private keys are protected by a password.
+type jksEntry struct {
+	alias        string
+	isPrivateKey bool
+	// certificates holds the DER encoded certificate chain of a private key
+	// entry, or the trusted certificate.
+	certificates [][]byte
+}
+
+// jksKeystore is a parsed JKS or JCEKS keystore.
+type jksKeystore struct {
+	entries []jksEntry
+	// signed is the data covered by the integrity digest.
+	signed []byte
+	digest []byte
+}
+
+// parseJKS parses the JKS and JCEKS keystore formats.
+// https://github.com/openjdk/jdk/blob/master/src/java.base/share/classes/sun/security/provider/JavaKeyStore.java
+func parseJKS(data []byte) (*jksKeystore, error) {
+	if len(data) < 12+sha1.Size {
+		return nil, errors.New("keystore too short")
+	}
+	signed, digest := data[:len(data)-sha1.Size], data[len(data)-sha1.Size:]
+	r := bytes.NewReader(signed)
+
+	var header struct {
+		Magic   uint32
+		Version uint32
+		Count   uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, err
+	}
+	if header.Magic != jksMagic && header.Magic != jceksMagic {
+		return nil, errors.New("not a Java keystore")
+	}
+	if header.Version != 1 && header.Version != 2 {
+		return nil, fmt.Errorf("unsupported keystore version %d", header.Version)
+	}
+
+	ks := &jksKeystore{signed: signed, digest: digest}
+	for i := uint32(0); i < header.Count; i++ {
+		var tag uint32
+		if err := binary.Read(r, binary.BigEndian, &tag); err != nil {
+			return nil, err
+		}
+		alias, err := readUTF(r)
+		if err != nil {
+			return nil, err
+		}
+		// Skip the creation timestamp.
+		if _, err := r.Seek(8, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+
+		entry := jksEntry{alias: alias}
+		switch tag {
+		case jksPrivateKeyTag:
+			entry.isPrivateKey = true
+			if _, err := readBytes(r); err != nil {
+				return nil, err
+			}
+			var chainLength uint32
+			if err := binary.Read(r, binary.BigEndian, &chainLength); err != nil {
+				return nil, err
+			}
+			for j := uint32(0); j < chainLength; j++ {
+				cert, err := readCertificate(r, header.Version)
+				if err != nil {
+					return nil, err
+				}
+				entry.certificates = append(entry.certificates, cert)
+			}
+		case jksTrustedCertTag:
+			cert, err := readCertificate(r, header.Version)
+			if err != nil {
+				return nil, err
+			}
+			entry.certificates = [][]byte{cert}
+		default:
+			// JCEKS secret key entries are serialized Java objects.
+			return nil, fmt.Errorf("unsupported keystore entry type %d", tag)
+		}
+		ks.entries = append(ks.entries, entry)
+	}
+	return ks, nil
+}
+
+// checkPassword reports whether the password matches the keystore's
+// integrity digest.
+func (ks *jksKeystore) checkPassword(password string) bool {
+	h := sha1.New()
+	for _, c := range utf16.Encode([]rune(password)) {
+		h.Write([]byte{byte(c >> 8), byte(c)})
+	}
+	h.Write([]byte("Mighty Aphrodite"))
+	h.Write(ks.signed)
+	return bytes.Equal(h.Sum(nil), ks.digest)
+}
+
+func readCertificate(r *bytes.Reader, version uint32) ([]byte, error) {
+	if version == 2 {
+		// Version 2 keystores name the certificate type, e.g. X.509.
+		if _, err := readUTF(r); err != nil {
+			return nil, err
+		}
+	}
+	return readBytes(r)
+}
+
+// readUTF reads a string written by Java's DataOutput.writeUTF.
+func readUTF(r *bytes.Reader) (string, error) {
+	var length uint16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if int64(length) > int64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
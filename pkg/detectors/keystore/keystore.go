@@ -0,0 +1,198 @@
+package keystore
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	// The keystore handler armors binary keystores with these block types.
+	keystorePat = regexp.MustCompile(`-----BEGIN (PKCS12|JAVA KEYSTORE)-----[\s\S]*?-----END (?:PKCS12|JAVA KEYSTORE)-----`)
+
+	// commonPasswords are tried to open keystores. Java's default truststore
+	// password is changeit.
+	commonPasswords = []string{"", "changeit", "changeme", "password", "secret", "123456", "keystore", "storepass", "pass"}
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"BEGIN PKCS12", "BEGIN JAVA KEYSTORE"}
+}
+
+// FromData will find PKCS#12 and Java keystores holding private keys in a
+// given set of bytes. Keystores are opened with a list of common passwords,
+// since a private key behind one of those is as exposed as a plaintext key.
+// Results are never verified.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keystorePat.FindAllString(dataStr, -1)
+
+	for _, match := range matches {
+		block, _ := pem.Decode([]byte(match))
+		if block == nil {
+			continue
+		}
+
+		var info *keystoreInfo
+		switch block.Type {
+		case "PKCS12":
+			info = inspectPKCS12(block.Bytes)
+		case "JAVA KEYSTORE":
+			info = inspectJKS(block.Bytes)
+		}
+		if info == nil {
+			continue
+		}
+
+		results = append(results, detectors.Result{
+			DetectorType: detectorspb.DetectorType_Keystore,
+			Raw:          []byte(match),
+			Redacted:     strings.Join(info.subjects, ";"),
+			ExtraData:    info.extraData(),
+		})
+	}
+
+	return results, nil
+}
+
+// keystoreInfo describes a keystore holding private keys.
+type keystoreInfo struct {
+	format string
+	// cracked reports whether one of the common passwords opened the
+	// keystore, in which case password holds it.
+	cracked  bool
+	password string
+	// privateKeys is the number of private keys, if known.
+	privateKeys int
+	aliases     []string
+	subjects    []string
+}
+
+// extraData returns the keystore's properties for a result's ExtraData.
+func (k *keystoreInfo) extraData() map[string]string {
+	extraData := map[string]string{
+		"format":           k.format,
+		"password_cracked": strconv.FormatBool(k.cracked),
+	}
+	if k.cracked {
+		extraData["password"] = k.password
+	}
+	if k.privateKeys > 0 {
+		extraData["private_keys"] = strconv.Itoa(k.privateKeys)
+	}
+	if len(k.aliases) > 0 {
+		extraData["aliases"] = strings.Join(k.aliases, ",")
+	}
+	if len(k.subjects) > 0 {
+		extraData["subjects"] = strings.Join(k.subjects, ";")
+	}
+	return extraData
+}
+
+// inspectPKCS12 opens a PKCS#12 file with the common passwords. Everything in
+// it is encrypted, so files that can't be opened are reported without
+// details, in case they hold a private key. Damaged files aren't reported.
+func inspectPKCS12(data []byte) *keystoreInfo {
+	info := &keystoreInfo{format: "pkcs12"}
+	for _, password := range commonPasswords {
+		blocks, err := pkcs12.ToPEM(data, password)
+		if errors.Is(err, pkcs12.ErrIncorrectPassword) || errors.Is(err, pkcs12.ErrDecryption) {
+			continue
+		}
+		var notImplemented pkcs12.NotImplementedError
+		if errors.As(err, &notImplemented) {
+			// Unsupported encryption, e.g. AES, which another password won't
+			// fix.
+			return info
+		}
+		if err != nil {
+			// A damaged file.
+			return nil
+		}
+
+		info.cracked = true
+		info.password = password
+		for _, block := range blocks {
+			switch block.Type {
+			case "PRIVATE KEY":
+				info.privateKeys++
+			case "CERTIFICATE":
+				if subject := certificateSubject(block.Bytes); subject != "" {
+					info.subjects = append(info.subjects, subject)
+				}
+			}
+		}
+		if info.privateKeys == 0 {
+			// Certificates alone aren't secret.
+			return nil
+		}
+		return info
+	}
+	return info
+}
+
+// inspectJKS reports a Java keystore if it holds private keys. Certificates
+// and aliases are stored in clear, and the common passwords are checked
+// against the keystore's integrity digest.
+func inspectJKS(data []byte) *keystoreInfo {
+	ks, err := parseJKS(data)
+	if err != nil {
+		return nil
+	}
+
+	info := &keystoreInfo{format: "jks"}
+	for _, entry := range ks.entries {
+		if !entry.isPrivateKey {
+			continue
+		}
+		info.privateKeys++
+		info.aliases = append(info.aliases, entry.alias)
+		if len(entry.certificates) > 0 {
+			if subject := certificateSubject(entry.certificates[0]); subject != "" {
+				info.subjects = append(info.subjects, subject)
+			}
+		}
+	}
+	if info.privateKeys == 0 {
+		// Truststores only hold certificates.
+		return nil
+	}
+
+	for _, password := range commonPasswords {
+		if ks.checkPassword(password) {
+			info.cracked = true
+			info.password = password
+			break
+		}
+	}
+	return info
+}
+
+func certificateSubject(der []byte) string {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return ""
+	}
+	return cert.Subject.String()
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_Keystore
+}
@@ -0,0 +1,282 @@
+//go:build detectors
+// +build detectors
+
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/kylelemons/godebug/pretty"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// pkcs12File was created with:
+//
+//	openssl req -x509 -newkey ec -pkeyopt ec_paramgen_curve:P-256 -nodes -keyout key.pem -out cert.pem -subj "/CN=payments.corp.io/O=Corp"
+//	openssl pkcs12 -export -legacy -inkey key.pem -in cert.pem -passout pass:changeit
+const pkcs12File = `
+MIIDqgIBAzCCA3AGCSqGSIb3DQEHAaCCA2EEggNdMIIDWTCCAk8GCSqGSIb3DQEHBqCCAkAwggI8
+AgEAMIICNQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQYwDgQIF1iZeBS9mfMCAggAgIICCEi1AOHq
+NgMpqWa8FQF1xCjOaAqQ0ixI1M1fqJuMY2itBTLIB13m6tV+0sqVekiXnw3gHdJFF64W6o+eQAFi
+t6zzZvRdCdthfz5TS3Al+hd5pOBj8VONa3VZIheMJauO68d6quIsQs9HPcePksuOe22UiK0OuMYY
+Si7c0M9R5g6N3Ve9rzrkRxTW8I2XzlT0FdOxF6OH2VEhN2qeTPbx9lIs1hhonDmK5kxIhWcgYlqG
++Oi407Z7QrPJ4XRAt8skU5w8nGDrNu2KIuRE1lLYJrrl2bNuF1j8Sj+XocrNhd4D5lsBVJlqfy9l
+FU6JwS3YHkRj17MtWmxcov66gEl+KKPlNUMQ8bTEdtqv4dBrtiLRUvD5jVADz2M+AyeP6N4F6HRL
+v+QvtHncw4Z/AskKlJQskHz+TmJ3fwcmUbA/A1TbDE2JCXLxXTEndpuQ/g5RIwZf1JJNYtrbScUS
+vu3KOOpm7elRaCNSOD/5qp8okhkq868vuzHU+Vkfd4sqp0UNmMfr+9SVymJxbNRywKuOmT5I8jzX
+z+iLXuSeR/wIeY+D7GLQ3TbZT7bVs88VCBKWiG2FpfiJP5yczWmLA8lcIlfPHdKh4LRFxXcPWr34
+PZAROQ9/O5iGScS615hEqtcQMHk9mN4/L7tju+TVUfSCv6BKPv1efZdj7zjVInMgWLsBjp6afafG
+DCEwggECBgkqhkiG9w0BBwGggfQEgfEwge4wgesGCyqGSIb3DQEMCgECoIG0MIGxMBwGCiqGSIb3
+DQEMAQMwDgQIDMDjmbOcE1MCAggABIGQ0ek1q/QQgySU64TMk4Ne8C9nqekymK3a69MshQU5ItBc
+UoMxbGOkWDw5g2opA6R3EHVOanh65uojbMU4bWRmX6G6gSl0gLwW7SSa1bdbDp9n1jgY867yZR5M
+PlDvNfA/a6K666fa20Zelc647NEWffFDEKRJDl6H2Ari/rvwfHB7rFpSGp9iFwRj4HJ+NV7PMSUw
+IwYJKoZIhvcNAQkVMRYEFFlgOP7CkvihQl+0bgjrCieEwpinMDEwITAJBgUrDgMCGgUABBQPsr1q
+YCAlyWN1g3o+l4xa1x50swQIkJalDiAr1SYCAggA`
+
+// testEntry is a keystore entry for newJKS.
+type testEntry struct {
+	alias      string
+	privateKey bool
+	cert       []byte
+}
+
+// newJKS builds a version 2 JKS keystore. Private keys aren't parsed by the
+// detector, so their protected bytes are random.
+func newJKS(t *testing.T, password string, entries ...testEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	write := func(v interface{}) {
+		if err := binary.Write(&buf, binary.BigEndian, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeUTF := func(s string) {
+		write(uint16(len(s)))
+		buf.WriteString(s)
+	}
+	writeBytes := func(b []byte) {
+		write(uint32(len(b)))
+		buf.Write(b)
+	}
+
+	write(uint32(jksMagic))
+	write(uint32(2))
+	write(uint32(len(entries)))
+	for _, entry := range entries {
+		if entry.privateKey {
+			write(uint32(jksPrivateKeyTag))
+		} else {
+			write(uint32(jksTrustedCertTag))
+		}
+		writeUTF(entry.alias)
+		write(uint64(time.Now().UnixMilli()))
+		if entry.privateKey {
+			protected := make([]byte, 64)
+			_, _ = rand.Read(protected)
+			writeBytes(protected)
+			write(uint32(1))
+		}
+		writeUTF("X.509")
+		writeBytes(entry.cert)
+	}
+
+	h := sha1.New()
+	for _, c := range utf16.Encode([]rune(password)) {
+		h.Write([]byte{byte(c >> 8), byte(c)})
+	}
+	h.Write([]byte("Mighty Aphrodite"))
+	h.Write(buf.Bytes())
+	buf.Write(h.Sum(nil))
+	return buf.Bytes()
+}
+
+func newCert(t *testing.T, commonName string) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Corp"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func armor(blockType string, data []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}))
+}
+
+func TestKeystore_FromChunk(t *testing.T) {
+	p12, err := base64.StdEncoding.DecodeString(pkcs12File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverCert := newCert(t, "api.corp.io")
+	caCert := newCert(t, "Corp Root CA")
+
+	type args struct {
+		ctx    context.Context
+		data   []byte
+		verify bool
+	}
+	tests := []struct {
+		name    string
+		s       Scanner
+		args    args
+		want    []detectors.Result
+		wantErr bool
+	}{
+		{
+			name: "found, PKCS#12 with common password",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(fmt.Sprintf("payments.p12\n%s", armor("PKCS12", p12))),
+				verify: true,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Keystore,
+					Redacted:     "CN=payments.corp.io,O=Corp",
+					ExtraData: map[string]string{
+						"format":           "pkcs12",
+						"password_cracked": "true",
+						"password":         "changeit",
+						"private_keys":     "1",
+						"subjects":         "CN=payments.corp.io,O=Corp",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "found, JKS with empty password",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(armor("JAVA KEYSTORE", newJKS(t, "", testEntry{alias: "server", privateKey: true, cert: serverCert}))),
+				verify: false,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Keystore,
+					Redacted:     "CN=api.corp.io,O=Corp",
+					ExtraData: map[string]string{
+						"format":           "jks",
+						"password_cracked": "true",
+						"password":         "",
+						"private_keys":     "1",
+						"aliases":          "server",
+						"subjects":         "CN=api.corp.io,O=Corp",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "found, JKS with strong password",
+			s:    Scanner{},
+			args: args{
+				ctx: context.Background(),
+				data: []byte(armor("JAVA KEYSTORE", newJKS(t, "Tr0ub4dor&3",
+					testEntry{alias: "ca", cert: caCert},
+					testEntry{alias: "server", privateKey: true, cert: serverCert},
+				))),
+				verify: false,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Keystore,
+					Redacted:     "CN=api.corp.io,O=Corp",
+					ExtraData: map[string]string{
+						"format":           "jks",
+						"password_cracked": "false",
+						"private_keys":     "1",
+						"aliases":          "server",
+						"subjects":         "CN=api.corp.io,O=Corp",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found, truststore",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(armor("JAVA KEYSTORE", newJKS(t, "changeit", testEntry{alias: "ca", cert: caCert}))),
+				verify: false,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte("-----BEGIN PKCS12-----\nnot a keystore\n-----END PKCS12-----"),
+				verify: false,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.FromData(tt.args.ctx, tt.args.verify, tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Keystore.FromData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for i := range got {
+				if len(got[i].Raw) == 0 {
+					t.Fatalf("no raw secret present: \n %+v", got[i])
+				}
+				got[i].Raw = nil
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("Keystore.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func BenchmarkFromData(benchmark *testing.B) {
+	ctx := context.Background()
+	s := Scanner{}
+	for name, data := range detectors.MustGetBenchmarkData() {
+		benchmark.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, err := s.FromData(ctx, false, data)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
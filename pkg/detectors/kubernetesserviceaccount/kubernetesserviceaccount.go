@@ -0,0 +1,203 @@
+package kubernetesserviceaccount
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given API server URLs to the list of URLs to
+// check for verification of secrets. There is no default API server, so
+// tokens are only verified if at least one is configured.
+func WithVerifierURLs(urls []string) func(*Scanner) {
+	return func(s *Scanner) {
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	keyPat = regexp.MustCompile(`\b(eyJhbGciOi[A-Za-z0-9_\-]*\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+)`)
+)
+
+// claims holds the service account claims of both legacy secret based tokens
+// and bound tokens.
+type claims struct {
+	Subject string `json:"sub"`
+
+	LegacyNamespace      string `json:"kubernetes.io/serviceaccount/namespace"`
+	LegacyServiceAccount string `json:"kubernetes.io/serviceaccount/service-account.name"`
+
+	Kubernetes *struct {
+		Namespace      string `json:"namespace"`
+		ServiceAccount struct {
+			Name string `json:"name"`
+		} `json:"serviceaccount"`
+		Pod *struct {
+			Name string `json:"name"`
+		} `json:"pod"`
+	} `json:"kubernetes.io"`
+}
+
+type tokenReviewRes struct {
+	Status struct {
+		Authenticated bool `json:"authenticated"`
+	} `json:"status"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"eyJhbGciOi"}
+}
+
+// FromData will find and optionally verify Kubernetes service account tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		extraData, ok := serviceAccount(token)
+		if !ok {
+			continue
+		}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_KubernetesServiceAccountToken,
+			Raw:          []byte(token),
+			Redacted:     extraData["namespace"] + "/" + extraData["service_account"],
+			ExtraData:    extraData,
+		}
+
+		if verify {
+			client := common.SaneHttpClient()
+			for _, baseURL := range s.verifierURLs {
+				if verifyToken(ctx, client, baseURL, token) {
+					s1.Verified = true
+					s1.ExtraData["api_server"] = baseURL
+					break
+				}
+			}
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// serviceAccount returns the namespace and name of the service account the
+// token belongs to, or false if it isn't a service account token.
+func serviceAccount(token string) (map[string]string, bool) {
+	parts := strings.Split(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, false
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, false
+	}
+
+	extraData := map[string]string{}
+	switch {
+	case c.Kubernetes != nil:
+		extraData["namespace"] = c.Kubernetes.Namespace
+		extraData["service_account"] = c.Kubernetes.ServiceAccount.Name
+		extraData["token_type"] = "bound"
+		if c.Kubernetes.Pod != nil {
+			extraData["pod"] = c.Kubernetes.Pod.Name
+		}
+	case c.LegacyServiceAccount != "":
+		extraData["namespace"] = c.LegacyNamespace
+		extraData["service_account"] = c.LegacyServiceAccount
+		extraData["token_type"] = "legacy"
+	default:
+		return nil, false
+	}
+	if !strings.HasPrefix(c.Subject, "system:serviceaccount:") {
+		return nil, false
+	}
+	return extraData, true
+}
+
+// verifyToken creates a TokenReview for the token, authenticating with the
+// token itself. Service accounts are rarely allowed to create TokenReviews,
+// but the API server only checks authorization after authenticating the
+// token, so a forbidden response still proves the token is valid.
+func verifyToken(ctx context.Context, client *http.Client, baseURL, token string) bool {
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "authentication.k8s.io/v1",
+		"kind":       "TokenReview",
+		"spec":       map[string]string{"token": token},
+	})
+	if err != nil {
+		return false
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/apis/authentication.k8s.io/v1/tokenreviews", bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusCreated, http.StatusOK:
+		var review tokenReviewRes
+		if err := json.NewDecoder(res.Body).Decode(&review); err != nil {
+			return false
+		}
+		return review.Status.Authenticated
+	case http.StatusForbidden:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_KubernetesServiceAccountToken
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.verifierURLs
+}
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -47,7 +49,11 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 
 		if verify {
 			func() {
-				ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+				u, err := url.Parse(resMatch)
+				if err != nil || common.CheckVerificationConnection(ctx, u) != nil {
+					return
+				}
+				ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
 				defer cancel()
 				client, err := mongo.Connect(ctx, options.Client().ApplyURI(resMatch))
 				if err != nil {
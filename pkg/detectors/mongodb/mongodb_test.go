@@ -98,7 +98,7 @@ func TestMongoDB_FromChunk(t *testing.T) {
 				}
 				got[i].Raw = nil
 			}
-			ignoreOpts := cmpopts.IgnoreFields(detectors.Result{}, "RawV2")
+			ignoreOpts := cmp.Options{cmpopts.IgnoreFields(detectors.Result{}, "RawV2"), cmpopts.IgnoreUnexported(detectors.Result{})}
 			if diff := cmp.Diff(tt.want, got, ignoreOpts); diff != "" {
 				t.Errorf("MongoDB.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
 			}
@@ -2,9 +2,14 @@ package npmtokenv2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
@@ -50,31 +55,125 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 		}
 
 		if verify {
-			req, err := http.NewRequestWithContext(ctx, "GET", "https://registry.npmjs.org/-/whoami", nil)
-			if err != nil {
-				continue
-			}
-			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", resMatch))
-			res, err := client.Do(req)
-			if err == nil {
-				defer res.Body.Close()
-				if res.StatusCode >= 200 && res.StatusCode < 300 {
-					s1.Verified = true
-				} else {
-					// This function will check false positives for common test words, but also it will make sure the key appears 'random' enough to be a real key.
-					if detectors.IsKnownFalsePositive(resMatch, detectors.DefaultFalsePositives, true) {
-						continue
-					}
+			username, err := whoami(ctx, resMatch)
+			if err == nil && username != "" {
+				s1.Verified = true
+				s1.ExtraData = map[string]string{"username": username}
+				if token, err := tokenDetails(ctx, resMatch); err == nil && token != nil {
+					s1.ExtraData["readonly"] = strconv.FormatBool(token.Readonly)
+					s1.ExtraData["automation"] = strconv.FormatBool(token.Automation)
+				}
+				if packages, err := writablePackages(ctx, resMatch, username); err == nil && len(packages) > 0 {
+					s1.ExtraData["packages"] = strings.Join(packages, ",")
 				}
 			}
 		}
 
+		// This function will check false positives for common test words, but also it will make sure the key appears 'random' enough to be a real key.
+		if !s1.Verified && detectors.IsKnownFalsePositive(resMatch, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
 		results = append(results, s1)
 	}
 
 	return
 }
 
+// whoami returns the token's owner, or an empty string if the token isn't
+// valid.
+func whoami(ctx context.Context, token string) (string, error) {
+	res, err := get(ctx, "https://registry.npmjs.org/-/whoami", token)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var whoami struct {
+			Username string `json:"username"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&whoami); err != nil {
+			return "", err
+		}
+		return whoami.Username, nil
+	case http.StatusUnauthorized:
+		return "", nil
+	default:
+		return "", fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+type tokenInfo struct {
+	// Token is the start and end of the token, e.g. npm_ab...yz.
+	Token      string `json:"token"`
+	Readonly   bool   `json:"readonly"`
+	Automation bool   `json:"automation"`
+}
+
+// tokenDetails finds the token among the user's tokens, which are listed with
+// only their start and end. Granular tokens can't list tokens.
+func tokenDetails(ctx context.Context, token string) (*tokenInfo, error) {
+	res, err := get(ctx, "https://registry.npmjs.org/-/npm/v1/tokens", token)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	var tokens struct {
+		Objects []tokenInfo `json:"objects"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&tokens); err != nil {
+		return nil, err
+	}
+	for _, t := range tokens.Objects {
+		start, end, ok := strings.Cut(strings.ReplaceAll(t.Token, "…", "..."), "...")
+		if ok && start != "" && strings.HasPrefix(token, start) && strings.HasSuffix(token, end) {
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
+
+// writablePackages returns the packages the token's owner can publish.
+func writablePackages(ctx context.Context, token, username string) ([]string, error) {
+	res, err := get(ctx, "https://registry.npmjs.org/-/user/"+url.PathEscape(username)+"/package", token)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	// The packages are mapped to the access the user has, read or write.
+	var access map[string]string
+	if err := json.NewDecoder(res.Body).Decode(&access); err != nil {
+		return nil, err
+	}
+	var packages []string
+	for name, permission := range access {
+		if permission == "write" {
+			packages = append(packages, name)
+		}
+	}
+	sort.Strings(packages)
+	return packages, nil
+}
+
+func get(ctx context.Context, endpoint, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	return client.Do(req)
+}
+
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_NpmToken
 }
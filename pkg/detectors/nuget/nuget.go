@@ -0,0 +1,78 @@
+package nuget
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat = regexp.MustCompile(`\b(oy2[a-z0-9]{43})\b`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"oy2"}
+}
+
+// FromData will find and optionally verify NuGet API keys in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		key := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_NuGet,
+			Raw:          []byte(key),
+		}
+
+		if verify {
+			// Push an empty package. NuGet checks the key and its push scope
+			// first, and rejects the package after, so nothing is published.
+			// The packages a key can push to can't be listed.
+			req, err := http.NewRequestWithContext(ctx, "PUT", "https://www.nuget.org/api/v2/package", nil)
+			if err != nil {
+				continue
+			}
+			req.Header.Add("X-NuGet-ApiKey", key)
+			res, err := client.Do(req)
+			if err == nil {
+				defer res.Body.Close()
+				// Invalid keys, and keys that can't push, are forbidden.
+				if res.StatusCode == http.StatusBadRequest {
+					s1.Verified = true
+				}
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(key, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_NuGet
+}
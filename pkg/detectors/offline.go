@@ -0,0 +1,66 @@
+package detectors
+
+import (
+	"math"
+)
+
+// OfflineValidator is an optional interface that a detector can implement to
+// check whether a result is structurally valid (e.g. by its checksum) without
+// network access.
+type OfflineValidator interface {
+	ValidateOffline(result Result) bool
+}
+
+// OfflineConfidence estimates how likely a result is to be a real secret
+// using only local heuristics: the detector's structural validation, the
+// entropy of the secret, and placeholders like the sample keys of providers'
+// documentation. It returns a value between 0 and 1 and is intended for scans
+// that can't verify over the network.
+func OfflineConfidence(d Detector, result Result) float64 {
+	secret := string(result.Raw)
+	if len(result.RawV2) > 0 {
+		secret = string(result.RawV2)
+	}
+
+	confidence := 0.5
+	if v, ok := d.(OfflineValidator); ok {
+		if !v.ValidateOffline(result) {
+			return 0
+		}
+		confidence += 0.3
+	}
+
+	switch entropy := ShannonEntropy(secret); {
+	case entropy >= 4:
+		confidence += 0.2
+	case entropy >= 3:
+		confidence += 0.1
+	default:
+		confidence -= 0.2
+	}
+
+	if IsPlaceholder(secret) {
+		confidence -= 0.4
+	}
+
+	return math.Max(0, math.Min(1, confidence))
+}
+
+// ShannonEntropy returns the Shannon entropy of s in bits per character.
+func ShannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
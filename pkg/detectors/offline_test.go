@@ -0,0 +1,82 @@
+//go:build detectors
+// +build detectors
+
+package detectors
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type plainDetector struct{}
+
+func (plainDetector) FromData(context.Context, bool, []byte) ([]Result, error) { return nil, nil }
+func (plainDetector) Keywords() []string                                       { return nil }
+func (plainDetector) Type() detectorspb.DetectorType                           { return detectorspb.DetectorType_CustomRegex }
+
+type offlineDetector struct {
+	plainDetector
+	valid bool
+}
+
+func (d offlineDetector) ValidateOffline(Result) bool { return d.valid }
+
+func TestOfflineConfidence(t *testing.T) {
+	tests := []struct {
+		name     string
+		detector Detector
+		raw      string
+		want     float64
+	}{
+		{
+			name:     "structurally valid random secret",
+			detector: offlineDetector{valid: true},
+			raw:      "gq8Zr2Kx7PvWm4Nt9LbYc3Hd",
+			want:     1,
+		},
+		{
+			name:     "structurally invalid",
+			detector: offlineDetector{valid: false},
+			raw:      "gq8Zr2Kx7PvWm4Nt9LbYc3Hd",
+			want:     0,
+		},
+		{
+			name:     "no validator, random secret",
+			detector: plainDetector{},
+			raw:      "gq8Zr2Kx7PvWm4Nt9LbYc3Hd",
+			want:     0.7,
+		},
+		{
+			name:     "no validator, placeholder",
+			detector: plainDetector{},
+			raw:      "xxxxxxxxxxxxxxxxxxxx",
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := OfflineConfidence(tt.detector, Result{Raw: []byte(tt.raw)})
+			if math.Abs(got-tt.want) > 0.001 {
+				t.Errorf("got %.2f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	tests := map[string]float64{
+		"":         0,
+		"aaaa":     0,
+		"abab":     1,
+		"abcd":     2,
+		"abcdefgh": 3,
+	}
+	for s, want := range tests {
+		if got := ShannonEntropy(s); math.Abs(got-want) > 0.001 {
+			t.Errorf("%q: got %.3f, want %.3f", s, got, want)
+		}
+	}
+}
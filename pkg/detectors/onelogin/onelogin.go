@@ -6,8 +6,8 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
-	"time"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -25,7 +25,7 @@ var (
 
 	apiDomains = []string{"api.us.onelogin.com", "api.eu.onelogin.com"}
 
-	client = http.Client{Timeout: time.Second * 5}
+	client = common.SaneHttpClientTimeOut(5)
 )
 
 // Keywords are used for efficiently pre-filtering chunks.
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
@@ -19,11 +20,19 @@ type Scanner struct{}
 // Ensure the Scanner satisfies the interface at compile time.
 var _ detectors.Detector = (*Scanner)(nil)
 
+var client = common.SaneHttpClient()
+
 var (
 	keyPat = regexp.MustCompile(`\b((?:sk)-[a-zA-Z0-9]{48})\b`)
+	// Project keys embed T3BlbkFJ, base64 for OpenAI.
+	projectKeyPat = regexp.MustCompile(`\b(sk-proj-[a-zA-Z0-9_\-]{20,}T3BlbkFJ[a-zA-Z0-9_\-]{20,})`)
 )
 
 // TODO: Add secret context?? Information about access, ownership etc
+type meResponse struct {
+	Orgs orgResponse `json:"orgs"`
+}
+
 type orgResponse struct {
 	Data []organization `json:"data"`
 }
@@ -49,6 +58,7 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 	dataStr := string(data)
 
 	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+	matches = append(matches, projectKeyPat.FindAllStringSubmatch(dataStr, -1)...)
 
 	for _, match := range matches {
 		// First match is entire regex, second is the first group.
@@ -58,43 +68,21 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 
 		token := match[1]
 
+		prefix := "sk-"
+		if strings.HasPrefix(token, "sk-proj-") {
+			prefix = "sk-proj-"
+		}
 		s1 := detectors.Result{
 			DetectorType: detectorspb.DetectorType_OpenAI,
-			Redacted:     token[:3] + "..." + token[47:],
+			Redacted:     prefix + "..." + token[len(token)-4:],
 			Raw:          []byte(token),
 		}
 
 		if verify {
-			client := common.SaneHttpClient()
-			// Undocumented API
-			// https://api.openai.com/v1/organizations
-			req, err := http.NewRequestWithContext(ctx, "GET", "https://api.openai.com/v1/organizations", nil)
-			if err != nil {
-				continue
-			}
-			req.Header.Add("Content-Type", "application/json; charset=utf-8")
-			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
-			res, err := client.Do(req)
+			verified, extraData, err := verifyToken(ctx, token)
 			if err == nil {
-				if res.StatusCode >= 200 && res.StatusCode < 300 {
-					var orgs orgResponse
-					err = json.NewDecoder(res.Body).Decode(&orgs)
-					res.Body.Close()
-					if err == nil {
-						s1.Verified = true
-						org := orgs.Data[0]
-						s1.ExtraData = map[string]string{
-							"id":          org.Id,
-							"title":       org.Title,
-							"user":        org.User,
-							"description": org.Description,
-							"role":        org.Role,
-							"is_personal": strconv.FormatBool(org.Personal),
-							"is_default":  strconv.FormatBool(org.Default),
-							"total_orgs":  fmt.Sprintf("%d", len(orgs.Data)),
-						}
-					}
-				}
+				s1.Verified = verified
+				s1.ExtraData = extraData
 			}
 		}
 
@@ -108,6 +96,81 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 	return
 }
 
+// verifyToken lists models, which every key that isn't restricted can do.
+// Project and service account keys can't read the user's organizations, so
+// the organization that a key bills to is taken from the response headers,
+// and its details are added when the key can read them.
+func verifyToken(ctx context.Context, token string) (bool, map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.openai.com/v1/models", nil)
+	if err != nil {
+		return false, nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	res, err := client.Do(req)
+	if err != nil {
+		return false, nil, err
+	}
+	defer res.Body.Close()
+	// Restricted keys without the models permission are still valid.
+	if res.StatusCode == http.StatusUnauthorized {
+		return false, nil, nil
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusForbidden {
+		return false, nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	extraData := map[string]string{}
+	if org := res.Header.Get("openai-organization"); org != "" {
+		extraData["organization"] = org
+	}
+	if project := res.Header.Get("openai-project"); project != "" {
+		extraData["project"] = project
+	}
+
+	// Undocumented API
+	// https://api.openai.com/v1/me
+	if orgs, err := userOrganizations(ctx, token); err == nil && len(orgs) > 0 {
+		org := orgs[0]
+		for _, o := range orgs {
+			if o.Default {
+				org = o
+				break
+			}
+		}
+		extraData["id"] = org.Id
+		extraData["title"] = org.Title
+		extraData["user"] = org.User
+		extraData["description"] = org.Description
+		extraData["role"] = org.Role
+		extraData["is_personal"] = strconv.FormatBool(org.Personal)
+		extraData["is_default"] = strconv.FormatBool(org.Default)
+		extraData["total_orgs"] = fmt.Sprintf("%d", len(orgs))
+	}
+	return true, extraData, nil
+}
+
+func userOrganizations(ctx context.Context, token string) ([]organization, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.openai.com/v1/me", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+	var me meResponse
+	if err := json.NewDecoder(res.Body).Decode(&me); err != nil {
+		return nil, err
+	}
+	return me.Orgs.Data, nil
+}
+
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_OpenAI
 }
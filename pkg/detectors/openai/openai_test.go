@@ -73,6 +73,23 @@ func TestOpenAI_FromChunk(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Found, unverified OpenAI project key sk-proj-",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte("OPENAI_API_KEY=sk-proj-Qm9vTfZr2uWkP7x_LcY1dH8sJ3nVg6tRb4eKq9wMz0TjXh5-FpNa2LcRs7UvT3BlbkFJ4yWk8dN1qZ3mHx6rTp0vLs9cGb2eJf5KuMa7nRw_8YtDh1QzXs4VbPj6oLg"),
+				verify: false,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_OpenAI,
+					Redacted:     "sk-proj-...6oLg",
+					Verified:     false,
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "not found",
 			s:    Scanner{},
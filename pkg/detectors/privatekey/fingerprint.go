@@ -7,8 +7,9 @@ import (
 	"crypto/sha1"
 	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
 	"errors"
-	"strings"
+	"strconv"
 
 	"golang.org/x/crypto/ssh"
 )
@@ -18,19 +19,115 @@ var (
 	ErrEncryptedKey = errors.New("key is encrypted")
 )
 
-func FingerprintPEMKey(in []byte) (string, error) {
-	parsedKey, err := ssh.ParseRawPrivateKey(in)
-	if err != nil && strings.Contains(err.Error(), "private key is passphrase protected") {
-		parsedKey, err = crack(in)
+// keyInfo describes a parsed private key.
+type keyInfo struct {
+	// keyType is one of rsa, ecdsa, ed25519 or dsa, or empty if the key is
+	// encrypted and its format doesn't reveal the type.
+	keyType string
+	// bits is the key size, if known.
+	bits int
+	// encrypted reports whether the key is passphrase protected.
+	encrypted bool
+	// cracked reports whether the passphrase of an encrypted key was found
+	// in the crack list.
+	cracked bool
+	// rawKey is the private key, nil if it is encrypted and wasn't cracked.
+	rawKey interface{}
+	// publicKey is nil if the key is encrypted, wasn't cracked, and its
+	// format doesn't store the public key unencrypted.
+	publicKey ssh.PublicKey
+}
+
+// parseKey parses a PEM or OpenSSH encoded private key. Encrypted keys are
+// cracked with a list of common passphrases, and are still described if that
+// fails.
+func parseKey(in []byte) (*keyInfo, error) {
+	info := &keyInfo{}
+	rawKey, err := ssh.ParseRawPrivateKey(in)
+	var missing *ssh.PassphraseMissingError
+	switch {
+	case errors.As(err, &missing):
+		info.encrypted = true
+		info.publicKey = missing.PublicKey
+		if rawKey, err = crack(in); err == nil {
+			info.cracked = true
+		} else {
+			rawKey = nil
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	if rawKey != nil {
+		signer, err := ssh.NewSignerFromKey(rawKey)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-	} else if err != nil {
-		return "", err
+		info.rawKey = rawKey
+		info.publicKey = signer.PublicKey()
+	}
+
+	if info.publicKey != nil {
+		info.keyType = sshKeyTypes[info.publicKey.Type()]
+		if cryptoKey, ok := info.publicKey.(ssh.CryptoPublicKey); ok {
+			switch pub := cryptoKey.CryptoPublicKey().(type) {
+			case *rsa.PublicKey:
+				info.bits = pub.N.BitLen()
+			case *ecdsa.PublicKey:
+				info.bits = pub.Curve.Params().BitSize
+			}
+		}
+	} else if block, _ := pem.Decode(in); block != nil {
+		// Legacy encrypted PEM keys name the key type in the block type.
+		info.keyType = pemKeyTypes[block.Type]
+	}
+	return info, nil
+}
+
+var sshKeyTypes = map[string]string{
+	ssh.KeyAlgoRSA:      "rsa",
+	ssh.KeyAlgoDSA:      "dsa",
+	ssh.KeyAlgoECDSA256: "ecdsa",
+	ssh.KeyAlgoECDSA384: "ecdsa",
+	ssh.KeyAlgoECDSA521: "ecdsa",
+	ssh.KeyAlgoED25519:  "ed25519",
+}
+
+var pemKeyTypes = map[string]string{
+	"RSA PRIVATE KEY": "rsa",
+	"EC PRIVATE KEY":  "ecdsa",
+	"DSA PRIVATE KEY": "dsa",
+}
+
+// extraData returns the key's properties for a result's ExtraData.
+func (k *keyInfo) extraData() map[string]string {
+	extraData := map[string]string{
+		"encrypted": strconv.FormatBool(k.encrypted),
+	}
+	if k.encrypted {
+		extraData["passphrase_cracked"] = strconv.FormatBool(k.cracked)
+	}
+	if k.keyType != "" {
+		extraData["key_type"] = k.keyType
+	}
+	if k.bits > 0 {
+		extraData["bits"] = strconv.Itoa(k.bits)
+	}
+	if k.publicKey != nil {
+		extraData["fingerprint"] = ssh.FingerprintSHA256(k.publicKey)
+	}
+	return extraData
+}
+
+// pkixFingerprint returns the hex encoded SHA-1 hash of the key's PKIX
+// encoded public key, which certificates and GitHub SSH keys are looked up by.
+func (k *keyInfo) pkixFingerprint() (string, error) {
+	if k.rawKey == nil {
+		return "", ErrEncryptedKey
 	}
 
 	var pubKey interface{}
-	switch privateKey := parsedKey.(type) {
+	switch privateKey := k.rawKey.(type) {
 	case *rsa.PrivateKey:
 		pubKey = &privateKey.PublicKey
 	case *ecdsa.PrivateKey:
@@ -53,3 +150,14 @@ func FingerprintPEMKey(in []byte) (string, error) {
 	publicKeyFingerprintInHex := hex.EncodeToString(publicKeyFingerprint[:])
 	return publicKeyFingerprintInHex, nil
 }
+
+func FingerprintPEMKey(in []byte) (string, error) {
+	info, err := parseKey(in)
+	if err != nil {
+		return "", err
+	}
+	if info.encrypted && !info.cracked {
+		return "", ErrUncrackable
+	}
+	return info.pkixFingerprint()
+}
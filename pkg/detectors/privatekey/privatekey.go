@@ -22,7 +22,7 @@ var _ detectors.Detector = (*Scanner)(nil)
 
 var (
 	// TODO: add base64 encoded key support
-	client = common.RetryableHttpClient()
+	client = common.SaneHttpClient()
 	keyPat = regexp.MustCompile(`(?i)-----\s*?BEGIN[ A-Z0-9_-]*?PRIVATE KEY\s*?-----[\s\S]*?----\s*?END[ A-Z0-9_-]*? PRIVATE KEY\s*?-----`)
 )
 
@@ -47,25 +47,30 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) ([]dete
 			continue
 		}
 
+		info, err := parseKey([]byte(token))
+		if err != nil {
+			continue
+		}
+
 		secret := detectors.Result{
 			DetectorType: detectorspb.DetectorType_PrivateKey,
 			Raw:          []byte(token),
 			Redacted:     token[0:64],
+			ExtraData:    info.extraData(),
 		}
 
-		fingerprint, err := FingerprintPEMKey([]byte(token))
-		if err != nil {
-			continue
-		}
-
-		if verify {
-			data, err := lookupFingerprint(fingerprint, s.IncludeExpired)
-			if err == nil {
-				secret.StructuredData = data
-				if data != nil {
-					secret.Verified = true
+		// Keys that are still encrypted can't be used to authenticate.
+		if verify && info.rawKey != nil {
+			if fingerprint, err := info.pkixFingerprint(); err == nil {
+				data, err := lookupFingerprint(fingerprint, s.IncludeExpired)
+				if err == nil {
+					secret.StructuredData = data
+					if data != nil {
+						secret.Verified = true
+					}
 				}
 			}
+			verifyGitHosts(ctx, info, &secret)
 		}
 
 		results = append(results, secret)
@@ -74,6 +79,35 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) ([]dete
 	return results, nil
 }
 
+// verifyGitHosts checks whether GitHub or GitLab accept the key, and records
+// the account or repository it grants access to.
+func verifyGitHosts(ctx context.Context, info *keyInfo, secret *detectors.Result) {
+	if user, err := verifyGitHubUser(ctx, info.rawKey); err == nil && user != "" {
+		secret.Verified = true
+		if secret.StructuredData == nil {
+			secret.StructuredData = &detectorspb.StructuredData{}
+		}
+		if !hasGitHubUser(secret.StructuredData, user) {
+			secret.StructuredData.GithubSshKey = append(secret.StructuredData.GithubSshKey, &detectorspb.GitHubSSHKey{
+				User: user,
+			})
+		}
+	}
+	if user, err := verifyGitLabUser(ctx, info.rawKey); err == nil && user != "" {
+		secret.Verified = true
+		secret.ExtraData["gitlab_user"] = user
+	}
+}
+
+func hasGitHubUser(data *detectorspb.StructuredData, user string) bool {
+	for _, key := range data.GithubSshKey {
+		if key.User == user {
+			return true
+		}
+	}
+	return false
+}
+
 func lookupFingerprint(publicKeyFingerprintInHex string, includeExpired bool) (data *detectorspb.StructuredData, err error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("https://keychecker.trufflesecurity.com/fingerprint/%s", publicKeyFingerprintInHex), nil)
 	if err != nil {
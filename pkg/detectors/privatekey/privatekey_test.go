@@ -5,6 +5,10 @@ package privatekey
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"os"
 	"reflect"
@@ -15,6 +19,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestPrivatekey_FromChunk(t *testing.T) {
@@ -208,6 +213,7 @@ func TestPrivatekey_FromChunk(t *testing.T) {
 					t.Fatal("no raw secret present")
 				}
 				got[i].Raw = nil
+				got[i].ExtraData = nil
 			}
 			if diff := pretty.Compare(got, tt.want); diff != "" {
 				t.Errorf("PrivatekeyCI.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
@@ -267,3 +273,75 @@ func Test_lookupFingerprint(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der := x509.MarshalPKCS1PrivateKey(rsaKey)
+	plain := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: der})
+	encryptedBlock, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", der, []byte("Tr0ub4dor&3-horse-battery"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := pem.EncodeToMemory(encryptedBlock)
+	publicKey, err := ssh.NewPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		in   []byte
+		want map[string]string
+	}{
+		{
+			name: "unencrypted",
+			in:   plain,
+			want: map[string]string{
+				"encrypted":   "false",
+				"key_type":    "rsa",
+				"bits":        "2048",
+				"fingerprint": ssh.FingerprintSHA256(publicKey),
+			},
+		},
+		{
+			name: "encrypted",
+			in:   encrypted,
+			want: map[string]string{
+				"encrypted":          "true",
+				"passphrase_cracked": "false",
+				"key_type":           "rsa",
+			},
+		},
+		{
+			name: "encrypted with a common passphrase",
+			in:   testEncryptedKey,
+			want: map[string]string{
+				"encrypted":          "true",
+				"passphrase_cracked": "true",
+				"key_type":           "rsa",
+				"bits":               "3072",
+				"fingerprint":        "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := parseKey(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := info.extraData()
+			if tt.want["fingerprint"] == "" && got["fingerprint"] != "" {
+				// The fingerprint of the fixed test key isn't interesting,
+				// only that it is present.
+				tt.want["fingerprint"] = got["fingerprint"]
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("parseKey() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
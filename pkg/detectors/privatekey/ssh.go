@@ -0,0 +1,108 @@
+package privatekey
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+)
+
+// sshTimeout bounds the whole SSH exchange with a git host.
+const sshTimeout = 10 * time.Second
+
+var (
+	// GitHub and GitLab greet users who authenticate with a key registered
+	// to their account, e.g. "Hi octocat! You've successfully authenticated"
+	// or "Welcome to GitLab, @octocat!".
+	githubGreetingPat = regexp.MustCompile(`Hi ([\w\-/]+)! You've successfully authenticated`)
+	gitlabGreetingPat = regexp.MustCompile(`Welcome to GitLab, @([\w\-.]+)!`)
+)
+
+// verifyGitHubUser returns the GitHub user the key is registered to, or an
+// empty string if GitHub doesn't accept the key. Deploy keys are reported
+// with the repository they grant access to.
+func verifyGitHubUser(ctx context.Context, rawKey interface{}) (string, error) {
+	return sshGreeting(ctx, rawKey, "github.com:22", githubGreetingPat)
+}
+
+// verifyGitLabUser returns the GitLab user the key is registered to, or an
+// empty string if GitLab doesn't accept the key.
+func verifyGitLabUser(ctx context.Context, rawKey interface{}) (string, error) {
+	return sshGreeting(ctx, rawKey, "gitlab.com:22", gitlabGreetingPat)
+}
+
+// sshGreeting authenticates to the git host with the key and matches the
+// greeting it sends instead of a shell. No git commands are run.
+func sshGreeting(ctx context.Context, rawKey interface{}, addr string, greetingPat *regexp.Regexp) (string, error) {
+	if err := common.CheckVerificationConnection(ctx, &url.URL{Scheme: "ssh", Host: addr}); err != nil {
+		return "", err
+	}
+	signer, err := ssh.NewSignerFromKey(rawKey)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sshTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	config := &ssh.ClientConfig{
+		User: "git",
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		// Only the greeting is read and no data is sent, so the host key
+		// isn't checked.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		if isAuthFailure(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	client := ssh.NewClient(sshConn, chans, reqs)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	var output bytes.Buffer
+	session.Stdout = &output
+	session.Stderr = &output
+	if err := session.Shell(); err != nil {
+		return "", err
+	}
+	// The host closes the session right after the greeting.
+	_ = session.Wait()
+
+	match := greetingPat.FindSubmatch(output.Bytes())
+	if match == nil {
+		return "", nil
+	}
+	return string(match[1]), nil
+}
+
+// isAuthFailure reports whether the handshake failed because the server
+// rejected the key.
+func isAuthFailure(err error) bool {
+	return strings.Contains(err.Error(), "unable to authenticate")
+}
@@ -0,0 +1,175 @@
+package pulumi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+const defaultURL = "https://api.pulumi.com"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given self-hosted Pulumi Cloud API URLs to the
+// list of URLs to check for verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	keyPat = regexp.MustCompile(`\b(pul-[a-f0-9]{40})\b`)
+)
+
+type userResponse struct {
+	GitHubLogin   string `json:"githubLogin"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	Organizations []struct {
+		GitHubLogin string `json:"githubLogin"`
+	} `json:"organizations"`
+}
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"pul-"}
+}
+
+// FromData will find and optionally verify Pulumi access tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_Pulumi,
+			Raw:          []byte(token),
+		}
+
+		if verify {
+			for _, baseURL := range s.urls() {
+				user, err := currentUser(ctx, baseURL, token)
+				if err != nil || user == nil {
+					continue
+				}
+				s1.Verified = true
+				s1.ExtraData = user.extraData()
+				if baseURL != defaultURL {
+					s1.ExtraData["url"] = baseURL
+				}
+				break
+			}
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(token, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// currentUser returns the token's owner, or nil if the token isn't valid.
+// Organization and team tokens belong to a machine user named after them.
+func currentUser(ctx context.Context, baseURL, token string) (*userResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/api/user", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("token %s", token))
+	req.Header.Add("Accept", "application/vnd.pulumi+8")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var user userResponse
+		if err := json.NewDecoder(res.Body).Decode(&user); err != nil {
+			return nil, err
+		}
+		return &user, nil
+	case http.StatusUnauthorized:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
+func (u *userResponse) extraData() map[string]string {
+	extraData := map[string]string{
+		"username": u.GitHubLogin,
+	}
+	if u.Email != "" {
+		extraData["email"] = u.Email
+	}
+	if len(u.Organizations) > 0 {
+		orgs := make([]string, 0, len(u.Organizations))
+		for _, org := range u.Organizations {
+			orgs = append(orgs, org.GitHubLogin)
+		}
+		sort.Strings(orgs)
+		extraData["organizations"] = strings.Join(orgs, ",")
+	}
+	return extraData
+}
+
+// urls returns the Pulumi Cloud APIs to verify tokens against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_Pulumi
+}
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
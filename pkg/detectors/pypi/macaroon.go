@@ -0,0 +1,141 @@
+package pypi
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Field types of the macaroon V2 binary format.
+// https://github.com/rescrv/libmacaroons/blob/master/doc/format.txt
+const (
+	fieldEOS        = 0
+	fieldIdentifier = 2
+
+	// projectNameCaveat is the tag of caveats restricting the token to
+	// projects by name.
+	projectNameCaveat = 1
+)
+
+var errMalformedMacaroon = errors.New("malformed macaroon")
+
+// tokenScope describes what a PyPI API token can upload to.
+type tokenScope struct {
+	// projects is empty for tokens scoped to the user, which can upload to
+	// all of the user's projects.
+	projects []string
+}
+
+// parseScope decodes the token's macaroon and reads its caveats. Caveats only
+// restrict the token, so a token without project caveats is user scoped.
+func parseScope(token string) (*tokenScope, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(strings.TrimPrefix(token, "pypi-"), "="))
+	if err != nil {
+		return nil, err
+	}
+	caveats, err := macaroonCaveats(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &tokenScope{}
+	for _, caveat := range caveats {
+		scope.projects = append(scope.projects, caveatProjects(caveat)...)
+	}
+	return scope, nil
+}
+
+// macaroonCaveats returns the identifiers of the first party caveats of a
+// V2 binary macaroon.
+func macaroonCaveats(raw []byte) ([]string, error) {
+	if len(raw) == 0 || raw[0] != 2 {
+		return nil, errMalformedMacaroon
+	}
+	r := &fieldReader{data: raw[1:]}
+
+	// The macaroon's location and identifier.
+	if err := r.skipSection(); err != nil {
+		return nil, err
+	}
+
+	var caveats []string
+	for {
+		fields, err := r.readSection()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			// The caveats end with an empty section.
+			return caveats, nil
+		}
+		if id, ok := fields[fieldIdentifier]; ok {
+			caveats = append(caveats, string(id))
+		}
+	}
+}
+
+type fieldReader struct {
+	data []byte
+}
+
+// readSection reads fields up to the end of section marker.
+func (r *fieldReader) readSection() (map[byte][]byte, error) {
+	fields := map[byte][]byte{}
+	for {
+		if len(r.data) == 0 {
+			return nil, errMalformedMacaroon
+		}
+		fieldType := r.data[0]
+		r.data = r.data[1:]
+		if fieldType == fieldEOS {
+			return fields, nil
+		}
+
+		length, n := binary.Uvarint(r.data)
+		if n <= 0 || uint64(len(r.data)-n) < length {
+			return nil, errMalformedMacaroon
+		}
+		fields[fieldType] = r.data[n : n+int(length)]
+		r.data = r.data[n+int(length):]
+	}
+}
+
+func (r *fieldReader) skipSection() error {
+	_, err := r.readSection()
+	return err
+}
+
+// caveatProjects returns the projects a caveat restricts the token to. Older
+// tokens use JSON objects, e.g. {"version": 1, "permissions": {"projects":
+// ["name"]}}, newer ones tagged lists, e.g. [1, ["name"]].
+func caveatProjects(caveat string) []string {
+	var legacy struct {
+		Permissions json.RawMessage `json:"permissions"`
+	}
+	if err := json.Unmarshal([]byte(caveat), &legacy); err == nil && legacy.Permissions != nil {
+		var permissions struct {
+			Projects []string `json:"projects"`
+		}
+		// The permissions of user scoped tokens are the string "user".
+		if err := json.Unmarshal(legacy.Permissions, &permissions); err == nil {
+			return permissions.Projects
+		}
+		return nil
+	}
+
+	var tagged []json.RawMessage
+	if err := json.Unmarshal([]byte(caveat), &tagged); err != nil || len(tagged) != 2 {
+		return nil
+	}
+	var tag int
+	if err := json.Unmarshal(tagged[0], &tag); err != nil || tag != projectNameCaveat {
+		return nil
+	}
+	var projects []string
+	if err := json.Unmarshal(tagged[1], &projects); err != nil {
+		return nil
+	}
+	return projects
+}
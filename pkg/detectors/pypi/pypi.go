@@ -0,0 +1,136 @@
+package pypi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+var (
+	client = common.SaneHttpClient()
+
+	// Tokens are base64 encoded macaroons, which start with the location,
+	// pypi.org or test.pypi.org.
+	keyPat = regexp.MustCompile(`\b(pypi-(?:AgEIcHlwaS5vcmc|AgENdGVzdC5weXBpLm9yZw)[A-Za-z0-9_\-]{50,})`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"pypi-AgE"}
+}
+
+// FromData will find and optionally verify PyPI API tokens in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	matches := keyPat.FindAllStringSubmatch(dataStr, -1)
+
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		token := match[1]
+
+		// Tokens that aren't macaroons are cut off or made up.
+		scope, err := parseScope(token)
+		if err != nil {
+			continue
+		}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_PyPI,
+			Raw:          []byte(token),
+			ExtraData:    map[string]string{"scope": "user"},
+		}
+		if len(scope.projects) > 0 {
+			s1.ExtraData["scope"] = "project"
+			s1.ExtraData["projects"] = strings.Join(scope.projects, ",")
+		}
+
+		uploadURL := "https://upload.pypi.org/legacy/"
+		if strings.HasPrefix(token, "pypi-AgENdGVzdC5weXBpLm9yZw") {
+			uploadURL = "https://test.pypi.org/legacy/"
+			s1.ExtraData["index"] = "test.pypi.org"
+		}
+
+		if verify {
+			verified, err := verifyToken(ctx, uploadURL, token)
+			if err == nil {
+				s1.Verified = verified
+			}
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// verifyToken attempts to upload a file that isn't a package. PyPI checks the
+// token before the upload, and rejects the upload either way, so nothing is
+// published.
+func verifyToken(ctx context.Context, uploadURL, token string) (bool, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for field, value := range map[string]string{
+		":action":          "file_upload",
+		"protocol_version": "1",
+		"metadata_version": "2.1",
+		"name":             "trufflehog-verification",
+		"version":          "0.0.0",
+		"filetype":         "sdist",
+		"pyversion":        "source",
+	} {
+		if err := w.WriteField(field, value); err != nil {
+			return false, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", uploadURL, &body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.SetBasicAuth("__token__", token)
+	res, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	// Invalid tokens are rejected with 403 and a message about the
+	// authentication, project scoped tokens with 403 and a message about the
+	// project, and valid tokens with 400 because there's no file.
+	if res.StatusCode == http.StatusBadRequest {
+		return true, nil
+	}
+	if res.StatusCode == http.StatusForbidden {
+		message, err := io.ReadAll(io.LimitReader(res.Body, 4096))
+		if err != nil {
+			return false, err
+		}
+		return strings.Contains(string(message), "isn't allowed to upload"), nil
+	}
+	return false, nil
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_PyPI
+}
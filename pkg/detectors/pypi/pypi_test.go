@@ -0,0 +1,204 @@
+//go:build detectors
+// +build detectors
+
+package pypi
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestPyPI_FromChunk(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	testSecrets, err := common.GetSecret(ctx, "trufflehog-testing", "detectors4")
+	if err != nil {
+		t.Fatalf("could not get test secrets from GCP: %s", err)
+	}
+	secret := testSecrets.MustGetField("PYPI")
+	inactiveSecret := testSecrets.MustGetField("PYPI_INACTIVE")
+
+	type args struct {
+		ctx    context.Context
+		data   []byte
+		verify bool
+	}
+	tests := []struct {
+		name    string
+		s       Scanner
+		args    args
+		want    []detectors.Result
+		wantErr bool
+	}{
+		{
+			name: "found, verified",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(fmt.Sprintf("You can find a PyPI token %s within", secret)),
+				verify: true,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_PyPI,
+					Verified:     true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "found, unverified",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(fmt.Sprintf("You can find a PyPI token %s within but not valid", inactiveSecret)), // the secret would satisfy the regex but not pass validation
+				verify: true,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_PyPI,
+					Verified:     false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte("You cannot find the secret within"),
+				verify: true,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.FromData(tt.args.ctx, tt.args.verify, tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PyPI.FromData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for i := range got {
+				if len(got[i].Raw) == 0 {
+					t.Fatalf("no raw secret present: \n %+v", got[i])
+				}
+				got[i].Raw = nil
+				got[i].ExtraData = nil
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("PyPI.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+// newToken builds a PyPI token with the given caveats.
+func newToken(location string, caveats ...string) string {
+	var buf bytes.Buffer
+	field := func(fieldType byte, value []byte) {
+		buf.WriteByte(fieldType)
+		length := make([]byte, binary.MaxVarintLen64)
+		buf.Write(length[:binary.PutUvarint(length, uint64(len(value)))])
+		buf.Write(value)
+	}
+	buf.WriteByte(2)
+	field(1, []byte(location))
+	field(2, []byte("32c1f0bd7e4a4f6e9b1d3c8a5e2f7b90"))
+	buf.WriteByte(fieldEOS)
+	for _, caveat := range caveats {
+		field(2, []byte(caveat))
+		buf.WriteByte(fieldEOS)
+	}
+	buf.WriteByte(fieldEOS)
+	field(6, bytes.Repeat([]byte{0x5c}, 32))
+	return "pypi-" + base64.RawURLEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestPyPI_Scope(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  []detectors.Result
+	}{
+		{
+			name:  "user scoped",
+			token: newToken("pypi.org", `{"version": 1, "permissions": "user"}`),
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_PyPI,
+					ExtraData:    map[string]string{"scope": "user"},
+				},
+			},
+		},
+		{
+			name:  "project scoped, legacy caveat",
+			token: newToken("pypi.org", `{"version": 1, "permissions": {"projects": ["fastq-tools"]}}`),
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_PyPI,
+					ExtraData:    map[string]string{"scope": "project", "projects": "fastq-tools"},
+				},
+			},
+		},
+		{
+			name:  "project scoped, test index",
+			token: newToken("test.pypi.org", `[0, 1893456000, 1700000000]`, `[1, ["fastq-tools", "fastq-cli"]]`),
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_PyPI,
+					ExtraData:    map[string]string{"scope": "project", "projects": "fastq-tools,fastq-cli", "index": "test.pypi.org"},
+				},
+			},
+		},
+		{
+			name:  "truncated",
+			token: newToken("pypi.org", `{"version": 1, "permissions": "user"}`)[:80],
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Scanner{}.FromData(context.Background(), false, []byte(fmt.Sprintf("password = %s", tt.token)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range got {
+				if string(got[i].Raw) != tt.token {
+					t.Fatalf("raw secret = %s, want %s", got[i].Raw, tt.token)
+				}
+				got[i].Raw = nil
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("PyPI.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func BenchmarkFromData(benchmark *testing.B) {
+	ctx := context.Background()
+	s := Scanner{}
+	for name, data := range detectors.MustGetBenchmarkData() {
+		benchmark.Run(name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, err := s.FromData(ctx, false, data)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
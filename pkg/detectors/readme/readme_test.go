@@ -6,6 +6,7 @@ package readme
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -103,6 +104,26 @@ func TestReadMe_FromChunk(t *testing.T) {
 	}
 }
 
+func TestReadMe_DryRun(t *testing.T) {
+	common.SetVerificationDryRun(true)
+	defer common.SetVerificationDryRun(false)
+
+	planned := &common.PlannedRequests{}
+	ctx := common.WithPlannedRequests(context.Background(), planned)
+	data := []byte("rdme_" + strings.Repeat("q8zr2kx7pv", 7))
+	results, err := Scanner{}.FromData(ctx, true, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Verified {
+		t.Errorf("expected one unverified result, got %v", results)
+	}
+	want := "https://dash.readme.com/api/v1"
+	if got := planned.Requests(); len(got) != 1 || got[0].Endpoint != want {
+		t.Errorf("planned requests: got %v, want one to %s", got, want)
+	}
+}
+
 func BenchmarkFromData(benchmark *testing.B) {
 	ctx := context.Background()
 	s := Scanner{}
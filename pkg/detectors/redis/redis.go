@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-redis/redis"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
@@ -80,6 +81,9 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 }
 
 func verifyRedis(ctx context.Context, u *url.URL) bool {
+	if err := common.CheckVerificationConnection(ctx, u); err != nil {
+		return false
+	}
 	opt, err := redis.ParseURL(u.String())
 	if err != nil {
 		return false
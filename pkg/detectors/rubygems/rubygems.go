@@ -2,8 +2,11 @@ package rubygems
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
@@ -20,7 +23,7 @@ var (
 	client = common.SaneHttpClient()
 
 	// Make sure that your group is surrounded in boundary characters such as below to reduce false positives.
-	keyPat = regexp.MustCompile(`\b(rubygems_[a-zA0-9]{48})\b`)
+	keyPat = regexp.MustCompile(`\b(rubygems_[a-zA-Z0-9]{48})\b`)
 )
 
 // Keywords are used for efficiently pre-filtering chunks.
@@ -47,32 +50,64 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 		}
 
 		if verify {
-			req, err := http.NewRequestWithContext(ctx, "GET", "https://rubygems.org/api/v1/gems", nil)
-			if err != nil {
-				continue
-			}
-			req.Header.Add("Accept", "*/*")
-			req.Header.Add("Authorization", resMatch)
-			res, err := client.Do(req)
+			verified, gems, err := verifyKey(ctx, resMatch)
 			if err == nil {
-				defer res.Body.Close()
-				if res.StatusCode >= 200 && res.StatusCode < 300 {
-					s1.Verified = true
-				} else {
-					// This function will check false positives for common test words, but also it will make sure the key appears 'random' enough to be a real key.
-					if detectors.IsKnownFalsePositive(resMatch, detectors.DefaultFalsePositives, true) {
-						continue
-					}
+				s1.Verified = verified
+				if len(gems) > 0 {
+					s1.ExtraData = map[string]string{"gems": strings.Join(gems, ",")}
 				}
 			}
 		}
 
+		// This function will check false positives for common test words, but also it will make sure the key appears 'random' enough to be a real key.
+		if !s1.Verified && detectors.IsKnownFalsePositive(resMatch, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
 		results = append(results, s1)
 	}
 
 	return results, nil
 }
 
+// verifyKey lists the gems the key's owner can push. Keys are scoped, and keys
+// without the index scope are valid but can't list gems.
+func verifyKey(ctx context.Context, key string) (bool, []string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://rubygems.org/api/v1/gems.json", nil)
+	if err != nil {
+		return false, nil, err
+	}
+	req.Header.Add("Accept", "*/*")
+	req.Header.Add("Authorization", key)
+	res, err := client.Do(req)
+	if err != nil {
+		return false, nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		var gems []struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&gems); err != nil {
+			return false, nil, err
+		}
+		names := make([]string, 0, len(gems))
+		for _, gem := range gems {
+			names = append(names, gem.Name)
+		}
+		sort.Strings(names)
+		return true, names, nil
+	case http.StatusForbidden:
+		return true, nil, nil
+	case http.StatusUnauthorized:
+		return false, nil, nil
+	default:
+		return false, nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+}
+
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_RubyGems
 }
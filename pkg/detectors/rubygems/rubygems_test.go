@@ -95,6 +95,7 @@ func TestRubyGems_FromChunk(t *testing.T) {
 					t.Fatalf("no raw secret present: \n %+v", got[i])
 				}
 				got[i].Raw = nil
+				got[i].ExtraData = nil
 			}
 			if diff := pretty.Compare(got, tt.want); diff != "" {
 				t.Errorf("RubyGems.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
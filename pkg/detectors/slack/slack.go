@@ -17,6 +17,7 @@ type Scanner struct{}
 
 // Check that the Slack scanner implements the SecretScanner interface at compile time.
 var _ detectors.Detector = Scanner{}
+var _ detectors.Revoker = Scanner{}
 
 var (
 	tokenPats = map[string]*regexp.Regexp{
@@ -26,6 +27,7 @@ var (
 		"Slack Workspace Refresh Token": regexp.MustCompile(`xoxr\-[0-9]{10,13}\-[0-9]{10,13}[a-zA-Z0-9\-]*`),
 	}
 	verifyURL = "https://slack.com/api/auth.test"
+	revokeURL = "https://slack.com/api/auth.revoke"
 )
 
 type authRes struct {
@@ -39,6 +41,12 @@ type authRes struct {
 	Error  string `json:"error"`
 }
 
+type revokeRes struct {
+	Ok      bool   `json:"ok"`
+	Revoked bool   `json:"revoked"`
+	Error   string `json:"error"`
+}
+
 // Keywords are used for efficiently pre-filtering chunks.
 // Use identifiers in the secret preferably, or the provider name.
 func (s Scanner) Keywords() []string {
@@ -86,3 +94,30 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_Slack
 }
+
+// Revoke revokes the token with auth.revoke. Refresh tokens can't revoke
+// themselves.
+func (s Scanner) Revoke(ctx context.Context, result detectors.Result) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", revokeURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", result.Raw))
+	res, err := common.SaneHttpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	var revokeResponse revokeRes
+	if err := json.NewDecoder(res.Body).Decode(&revokeResponse); err != nil {
+		return err
+	}
+	if !revokeResponse.Ok {
+		return fmt.Errorf("could not revoke the token: %s", revokeResponse.Error)
+	}
+	if !revokeResponse.Revoked {
+		return fmt.Errorf("the token was not revoked")
+	}
+	return nil
+}
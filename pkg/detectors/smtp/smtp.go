@@ -0,0 +1,249 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type Scanner struct{}
+
+// Ensure the Scanner satisfies the interface at compile time.
+var _ detectors.Detector = (*Scanner)(nil)
+
+// verificationTimeout bounds the whole handshake with the mail server.
+const verificationTimeout = 5 * time.Second
+
+var (
+	// Make sure that your group is surrounded in boundary characters such as below to reduce false positives.
+	uriPat = regexp.MustCompile(`\bsmtps?://[^\s:@/"']{1,100}:[^\s@/"']{3,100}@[a-zA-Z0-9.\-]+(?::\d{2,5})?`)
+
+	// Settings as they appear in config files and environment variables,
+	// e.g. SMTP_HOST, spring.mail.username or Django's EMAIL_HOST_PASSWORD.
+	hostPat     = regexp.MustCompile(`(?i)\b(?:smtp|e?mail)[_.\-]?(?:host|server)["']?\s*[:=]\s*["']?([a-z0-9][a-z0-9.\-]*\.[a-z]{2,})(?::(\d{2,5}))?\b`)
+	portPat     = regexp.MustCompile(`(?i)\b(?:smtp|e?mail)[_.\-]?(?:host[_.\-]?)?port["']?\s*[:=]\s*["']?(\d{2,5})\b`)
+	usernamePat = regexp.MustCompile(`(?i)\b(?:smtp|e?mail)[_.\-]?(?:host[_.\-]?|server[_.\-]?)?(?:user(?:name)?|login)["']?\s*[:=]\s*["']?([^\s"',;]{3,100})`)
+	passwordPat = regexp.MustCompile(`(?i)\b(?:smtp|e?mail)[_.\-]?(?:host[_.\-]?|server[_.\-]?)?pass(?:word)?["']?\s*[:=]\s*["']?([^\s"',;]{6,100})`)
+)
+
+// Keywords are used for efficiently pre-filtering chunks.
+// Use identifiers in the secret preferably, or the provider name.
+func (s Scanner) Keywords() []string {
+	return []string{"smtp"}
+}
+
+type credential struct {
+	host     string
+	port     string
+	username string
+	password string
+}
+
+// FromData will find and optionally verify SMTP credentials in a given set of bytes.
+func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
+	dataStr := string(data)
+
+	for _, cred := range append(uriCredentials(dataStr), settingCredentials(dataStr)...) {
+		if isPlaceholder(cred.password) {
+			continue
+		}
+
+		s1 := detectors.Result{
+			DetectorType: detectorspb.DetectorType_SMTP,
+			Raw:          []byte(cred.username),
+			RawV2:        []byte(cred.username + cred.password + cred.host),
+			Redacted:     cred.username + "@" + net.JoinHostPort(cred.host, cred.port),
+			ExtraData: map[string]string{
+				"host": cred.host,
+				"port": cred.port,
+			},
+		}
+		if provider := provider(cred.host); provider != "" {
+			s1.ExtraData["provider"] = provider
+		}
+
+		if verify {
+			s1.Verified = verifySMTP(ctx, cred)
+		}
+
+		if !s1.Verified && detectors.IsKnownFalsePositive(cred.password, detectors.DefaultFalsePositives, false) {
+			continue
+		}
+
+		results = append(results, s1)
+	}
+
+	return results, nil
+}
+
+// uriCredentials returns the credentials in smtp:// and smtps:// URIs.
+func uriCredentials(data string) []credential {
+	var creds []credential
+	for _, match := range uriPat.FindAllString(data, -1) {
+		u, err := url.Parse(match)
+		if err != nil {
+			continue
+		}
+		password, ok := u.User.Password()
+		if !ok {
+			continue
+		}
+		port := u.Port()
+		if port == "" {
+			port = "587"
+			if strings.EqualFold(u.Scheme, "smtps") {
+				port = "465"
+			}
+		}
+		creds = append(creds, credential{
+			host:     u.Hostname(),
+			port:     port,
+			username: u.User.Username(),
+			password: password,
+		})
+	}
+	return creds
+}
+
+// settingCredentials pairs the host, username, and password settings found
+// in the data. Without a host setting, the host of the provider the username
+// and password belong to is used.
+func settingCredentials(data string) []credential {
+	defaultPort := "587"
+	if match := portPat.FindStringSubmatch(data); match != nil {
+		defaultPort = match[1]
+	}
+
+	type server struct{ host, port string }
+	var servers []server
+	for _, match := range hostPat.FindAllStringSubmatch(data, -1) {
+		port := match[2]
+		if port == "" {
+			port = defaultPort
+		}
+		servers = append(servers, server{strings.ToLower(match[1]), port})
+	}
+
+	var creds []credential
+	for _, usernameMatch := range usernamePat.FindAllStringSubmatch(data, -1) {
+		username := usernameMatch[1]
+		for _, passwordMatch := range passwordPat.FindAllStringSubmatch(data, -1) {
+			password := passwordMatch[1]
+			candidates := servers
+			if len(candidates) == 0 {
+				host := providerHost(username, password)
+				if host == "" {
+					continue
+				}
+				candidates = []server{{host, defaultPort}}
+			}
+			for _, srv := range candidates {
+				creds = append(creds, credential{
+					host:     srv.host,
+					port:     srv.port,
+					username: username,
+					password: password,
+				})
+			}
+		}
+	}
+	return creds
+}
+
+// providerHost returns the SMTP relay of the provider whose credential format
+// the username and password match.
+func providerHost(username, password string) string {
+	switch {
+	case username == "apikey" && strings.HasPrefix(password, "SG."):
+		// SendGrid authenticates with the literal username "apikey" and an
+		// API key as the password.
+		return "smtp.sendgrid.net"
+	case strings.HasPrefix(username, "postmaster@"):
+		return "smtp.mailgun.org"
+	}
+	return ""
+}
+
+func provider(host string) string {
+	switch {
+	case strings.HasSuffix(host, "sendgrid.net"):
+		return "sendgrid"
+	case strings.HasSuffix(host, "mailgun.org"):
+		return "mailgun"
+	}
+	return ""
+}
+
+// isPlaceholder reports whether the password is redacted or a template
+// variable rather than a real credential.
+func isPlaceholder(password string) bool {
+	if strings.Trim(password, "*") == "" {
+		return true
+	}
+	return strings.HasPrefix(password, "$") ||
+		strings.HasPrefix(password, "{{") ||
+		strings.HasPrefix(password, "%") ||
+		strings.HasPrefix(password, "<")
+}
+
+// verifySMTP authenticates to the server with an EHLO and AUTH handshake.
+// No mail is sent, the connection is closed right after authenticating.
+func verifySMTP(ctx context.Context, cred credential) bool {
+	endpoint := &url.URL{Scheme: "smtp", Host: net.JoinHostPort(cred.host, cred.port)}
+	if err := common.CheckVerificationConnection(ctx, endpoint); err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, verificationTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint.Host)
+	if err != nil {
+		return false
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	tlsConfig := &tls.Config{ServerName: cred.host}
+	if cred.port == "465" {
+		// Port 465 uses implicit TLS instead of STARTTLS.
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, cred.host)
+	if err != nil {
+		conn.Close()
+		return false
+	}
+	defer client.Close()
+
+	if err := client.Hello("localhost"); err != nil {
+		return false
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return false
+		}
+	}
+	// PlainAuth refuses to send the password over an unencrypted connection
+	// to anything but localhost.
+	if err := client.Auth(smtp.PlainAuth("", cred.username, cred.password, cred.host)); err != nil {
+		return false
+	}
+	_ = client.Quit()
+	return true
+}
+
+func (s Scanner) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_SMTP
+}
@@ -0,0 +1,212 @@
+//go:build detectors
+// +build detectors
+
+package snowflake
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestSnowflake_FromChunk(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	testSecrets, err := common.GetSecret(ctx, "trufflehog-testing", "detectors4")
+	if err != nil {
+		t.Fatalf("could not get test secrets from GCP: %s", err)
+	}
+	account := testSecrets.MustGetField("SNOWFLAKE_ACCOUNT")
+	username := testSecrets.MustGetField("SNOWFLAKE_USERNAME")
+	secret := testSecrets.MustGetField("SNOWFLAKE_PASSWORD")
+	inactiveSecret := testSecrets.MustGetField("SNOWFLAKE_PASSWORD_INACTIVE")
+
+	type args struct {
+		ctx    context.Context
+		data   []byte
+		verify bool
+	}
+	tests := []struct {
+		name    string
+		s       Scanner
+		args    args
+		want    []detectors.Result
+		wantErr bool
+	}{
+		{
+			name: "found, verified",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(fmt.Sprintf("SNOWFLAKE_ACCOUNT=%s\nSNOWFLAKE_USER=%s\nSNOWFLAKE_PASSWORD=%s\n", account, username, secret)),
+				verify: true,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Snowflake,
+					Verified:     true,
+					Redacted:     username + "@" + strings.ToLower(account),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "found, unverified",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte(fmt.Sprintf("SNOWFLAKE_ACCOUNT=%s\nSNOWFLAKE_USER=%s\nSNOWFLAKE_PASSWORD=%s\n", account, username, inactiveSecret)), // the secret would satisfy the regex but not pass validation
+				verify: true,
+			},
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Snowflake,
+					Verified:     false,
+					Redacted:     username + "@" + strings.ToLower(account),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			s:    Scanner{},
+			args: args{
+				ctx:    context.Background(),
+				data:   []byte("You cannot find the secret within"),
+				verify: true,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.FromData(tt.args.ctx, tt.args.verify, tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Snowflake.FromData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for i := range got {
+				if len(got[i].Raw) == 0 {
+					t.Fatalf("no raw secret present: \n %+v", got[i])
+				}
+				got[i].Raw = nil
+				got[i].RawV2 = nil
+				got[i].ExtraData = nil
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("Snowflake.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestSnowflake_Pattern(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	tests := []struct {
+		name string
+		data string
+		want []detectors.Result
+	}{
+		{
+			name: "connection settings",
+			data: `
+snowflake:
+  account: acme-analytics
+  user: LOADER
+  password: "Wq8#zL2m!Tr5"
+`,
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Snowflake,
+					Raw:          []byte("Wq8#zL2m!Tr5"),
+					RawV2:        []byte("acme-analyticsLOADERWq8#zL2m!Tr5"),
+					Redacted:     "LOADER@acme-analytics",
+					ExtraData:    map[string]string{"account": "acme-analytics", "username": "LOADER", "auth_method": "password"},
+				},
+			},
+		},
+		{
+			name: "key pair with account URL",
+			data: fmt.Sprintf("url: https://xy12345.us-east-2.aws.snowflakecomputing.com\nsnowflake_user=LOADER\n%s", keyPEM),
+			want: []detectors.Result{
+				{
+					DetectorType: detectorspb.DetectorType_Snowflake,
+					Raw:          []byte(strings.TrimSpace(keyPEM)),
+					RawV2:        []byte("xy12345.us-east-2.awsLOADER" + strings.TrimSpace(keyPEM)),
+					Redacted:     "LOADER@xy12345.us-east-2.aws",
+					ExtraData:    map[string]string{"account": "xy12345.us-east-2.aws", "username": "LOADER", "auth_method": "key_pair"},
+				},
+			},
+		},
+		{
+			name: "no account",
+			data: "snowflake user: LOADER\npassword: Wq8#zL2m!Tr5",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Scanner{}.FromData(context.Background(), false, []byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := pretty.Compare(got, tt.want); diff != "" {
+				t.Errorf("Snowflake.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestKeyPairJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := keyPairJWT("XY12345", "loader", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Parse(signed, func(*jwt.Token) (interface{}, error) { return &key.PublicKey, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if claims["sub"] != "XY12345.LOADER" {
+		t.Errorf("sub = %v, want XY12345.LOADER", claims["sub"])
+	}
+	if iss, _ := claims["iss"].(string); !strings.HasPrefix(iss, "XY12345.LOADER.SHA256:") {
+		t.Errorf("iss = %v, want the fingerprint of the user's public key", iss)
+	}
+}
+
+func TestScanner_URLs(t *testing.T) {
+	s := New(WithVerifierURLs([]string{"https://acme-analytics.privatelink.snowflakecomputing.com/"}))
+	if diff := pretty.Compare(s.urls("acme-analytics"), []string{"https://acme-analytics.privatelink.snowflakecomputing.com"}); diff != "" {
+		t.Errorf("urls() diff: (-got +want)\n%s", diff)
+	}
+	if diff := pretty.Compare(s.urls("acme-reporting"), []string{"https://acme-reporting.snowflakecomputing.com"}); diff != "" {
+		t.Errorf("urls() diff: (-got +want)\n%s", diff)
+	}
+}
@@ -3,11 +3,15 @@ package sqlserver
 import (
 	"context"
 	"database/sql"
+	"net"
+	"net/url"
 	"regexp"
+	"strconv"
 
 	"github.com/denisenkom/go-mssqldb/msdsn"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 )
 
@@ -45,7 +49,7 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 			Raw:          []byte(params.Password),
 		}
 
-		if verify {
+		if verify && common.CheckVerificationConnection(ctx, endpoint(params)) == nil {
 			verified, err := ping(params)
 			if err != nil {
 			} else {
@@ -59,6 +63,15 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 	return results, nil
 }
 
+// endpoint is the server of the connection.
+func endpoint(config msdsn.Config) *url.URL {
+	host := config.Host
+	if config.Port != 0 {
+		host = net.JoinHostPort(host, strconv.FormatUint(config.Port, 10))
+	}
+	return &url.URL{Scheme: "sqlserver", Host: host}
+}
+
 var ping = func(config msdsn.Config) (bool, error) {
 	url := config.URL()
 	query := url.Query()
@@ -107,7 +107,7 @@ func TestSQLServer_FromChunk(t *testing.T) {
 				}
 				got[i].Raw = nil
 			}
-			ignoreOpts := cmpopts.IgnoreFields(detectors.Result{}, "RawV2")
+			ignoreOpts := cmp.Options{cmpopts.IgnoreFields(detectors.Result{}, "RawV2"), cmpopts.IgnoreUnexported(detectors.Result{})}
 			if diff := cmp.Diff(tt.want, got, ignoreOpts); diff != "" {
 				t.Errorf("SQLServer.FromData() %s diff: (-got +want)\n%s", tt.name, diff)
 			}
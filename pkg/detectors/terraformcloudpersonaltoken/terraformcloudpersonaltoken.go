@@ -2,9 +2,11 @@ package terraformcloudpersonaltoken
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
@@ -12,17 +14,62 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 )
 
-type Scanner struct{}
+const defaultURL = "https://app.terraform.io"
+
+type Scanner struct {
+	verifierURLs []string
+}
+
+// New creates a new Scanner with the given options.
+func New(opts ...func(*Scanner)) *Scanner {
+	scanner := &Scanner{
+		verifierURLs: make([]string, 0),
+	}
+	for _, opt := range opts {
+		opt(scanner)
+	}
+
+	return scanner
+}
+
+// WithVerifierURLs adds the given Terraform Enterprise URLs to the list of
+// URLs to check for verification of secrets.
+func WithVerifierURLs(urls []string, includeDefault bool) func(*Scanner) {
+	return func(s *Scanner) {
+		if includeDefault {
+			urls = append(urls, defaultURL)
+		}
+		s.verifierURLs = append(s.verifierURLs, urls...)
+	}
+}
 
 // Ensure the Scanner satisfies the interface at compile time.
 var _ detectors.Detector = (*Scanner)(nil)
+var _ detectors.Endpointer = (*Scanner)(nil)
 
 var (
 	client = common.SaneHttpClient()
 
-	keyPat = regexp.MustCompile(`\b([A-Za-z0-9]{14}.atlasv1.[A-Za-z0-9]{67})\b`)
+	// User, team, and organization tokens share the same format.
+	keyPat = regexp.MustCompile(`\b([A-Za-z0-9]{14}\.atlasv1\.[A-Za-z0-9_\-]{60,70})\b`)
 )
 
+type accountResponse struct {
+	Data struct {
+		Attributes struct {
+			Username         string `json:"username"`
+			Email            string `json:"email"`
+			IsServiceAccount bool   `json:"is-service-account"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+type organizationsResponse struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
 // Keywords are used for efficiently pre-filtering chunks.
 // Use identifiers in the secret preferably, or the provider name.
 func (s Scanner) Keywords() []string {
@@ -47,30 +94,128 @@ func (s Scanner) FromData(ctx context.Context, verify bool, data []byte) (result
 		}
 
 		if verify {
-			req, err := http.NewRequestWithContext(ctx, "GET", "https://app.terraform.io/api/v2/account/details", nil)
-			if err != nil {
-				continue
-			}
-			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", resMatch))
-			res, err := client.Do(req)
-			if err == nil {
-				defer res.Body.Close()
-				if res.StatusCode >= 200 && res.StatusCode < 300 {
-					s1.Verified = true
-				} else {
-					if detectors.IsKnownFalsePositive(resMatch, detectors.DefaultFalsePositives, true) {
-						continue
-					}
+			for _, baseURL := range s.urls() {
+				extraData, err := verifyToken(ctx, baseURL, resMatch)
+				if err != nil || extraData == nil {
+					continue
+				}
+				s1.Verified = true
+				s1.ExtraData = extraData
+				if baseURL != defaultURL {
+					s1.ExtraData["url"] = baseURL
 				}
+				break
 			}
 		}
 
+		if !s1.Verified && detectors.IsKnownFalsePositive(resMatch, detectors.DefaultFalsePositives, true) {
+			continue
+		}
+
 		results = append(results, s1)
 	}
 
 	return results, nil
 }
 
+// verifyToken returns the token's owner, or nil if the token isn't valid.
+// Team and organization tokens belong to service accounts named after the
+// team or organization, e.g. api-team_12345 or api-org-acme-12345.
+func verifyToken(ctx context.Context, baseURL, token string) (map[string]string, error) {
+	res, err := get(ctx, baseURL+"/api/v2/account/details", token)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	extraData := map[string]string{}
+	switch res.StatusCode {
+	case http.StatusOK:
+		var account accountResponse
+		if err := json.NewDecoder(res.Body).Decode(&account); err != nil {
+			return nil, err
+		}
+		attributes := account.Data.Attributes
+		extraData["username"] = attributes.Username
+		extraData["token_type"] = tokenType(attributes.Username, attributes.IsServiceAccount)
+		if attributes.Email != "" {
+			extraData["email"] = attributes.Email
+		}
+	case http.StatusNotFound:
+		// The token is valid, but doesn't belong to an account that can be
+		// described.
+	case http.StatusUnauthorized:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	if orgs, err := organizations(ctx, baseURL, token); err == nil && len(orgs) > 0 {
+		extraData["organizations"] = strings.Join(orgs, ",")
+	}
+	return extraData, nil
+}
+
+func tokenType(username string, isServiceAccount bool) string {
+	switch {
+	case strings.HasPrefix(username, "api-team_"):
+		return "team"
+	case strings.HasPrefix(username, "api-org-"):
+		return "organization"
+	case isServiceAccount:
+		return "service_account"
+	default:
+		return "user"
+	}
+}
+
+// organizations returns the names of the organizations the token can access.
+func organizations(ctx context.Context, baseURL, token string) ([]string, error) {
+	res, err := get(ctx, baseURL+"/api/v2/organizations", token)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP response status %d", res.StatusCode)
+	}
+
+	var orgs organizationsResponse
+	if err := json.NewDecoder(res.Body).Decode(&orgs); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(orgs.Data))
+	for _, org := range orgs.Data {
+		names = append(names, org.ID)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func get(ctx context.Context, url, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+	return client.Do(req)
+}
+
+// urls returns the Terraform Cloud or Enterprise instances to verify tokens
+// against.
+func (s Scanner) urls() []string {
+	if len(s.verifierURLs) == 0 {
+		return []string{defaultURL}
+	}
+	return s.verifierURLs
+}
+
 func (s Scanner) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_TerraformCloudPersonalToken
 }
+
+// Endpoints returns the instances that tokens are verified against.
+func (s Scanner) Endpoints() []string {
+	return s.urls()
+}
@@ -6,19 +6,32 @@ import (
 	"github.com/go-errors/errors"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/circleci"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanCircleCI scans CircleCI logs.
-func (e *Engine) ScanCircleCI(ctx context.Context, token string) error {
+func (e *Engine) ScanCircleCI(ctx context.Context, c sources.CircleCIConfig) error {
 	connection := &sourcespb.CircleCI{
 		Credential: &sourcespb.CircleCI_Token{
-			Token: token,
+			Token: c.Token,
 		},
+		IncludeArtifacts: c.IncludeArtifacts,
+		AuditEnvVars:     c.AuditEnvVars,
+		Branches:         c.Branches,
+		Workflows:        c.Workflows,
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
+	}
+	if !c.Until.IsZero() {
+		connection.Until = timestamppb.New(c.Until)
 	}
 
 	var conn anypb.Any
@@ -37,11 +50,14 @@ func (e *Engine) ScanCircleCI(ctx context.Context, token string) error {
 	if err != nil {
 		return errors.WrapPrefix(err, "failed to init Circle CI source", 0)
 	}
+	circleSource.WithFilter(c.Filter)
 
 	e.sourcesWg.Add(1)
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, circleSource.Type().String())
+		defer span.End()
 		err := circleSource.Chunks(ctx, e.ChunksChan())
 		if err != nil {
 			ctx.Logger().Error(err, "error scanning Circle CI")
@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"runtime"
+
+	"github.com/go-errors/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/containers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
+)
+
+// ScanContainers scans the containers of a Docker daemon.
+func (e *Engine) ScanContainers(ctx context.Context, c sources.ContainersConfig) error {
+	connection := &sourcespb.Containers{
+		Endpoint:       c.Endpoint,
+		Containers:     c.Containers,
+		IncludeStopped: c.IncludeStopped,
+		SkipVolumes:    c.SkipVolumes,
+		SkipEnv:        c.SkipEnv,
+	}
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal containers connection")
+		return err
+	}
+
+	containersSource := containers.Source{}
+	ctx = context.WithValues(ctx,
+		"source_type", containersSource.Type().String(),
+		"source_name", "containers",
+	)
+	err = containersSource.Init(ctx, "trufflehog - containers", 0, int64(sourcespb.SourceType_SOURCE_TYPE_CONTAINERS), true, &conn, runtime.NumCPU())
+	if err != nil {
+		return errors.WrapPrefix(err, "could not init containers source", 0)
+	}
+	containersSource.WithFilter(c.Filter)
+	e.sourcesWg.Add(1)
+	go func() {
+		defer common.RecoverWithExit(ctx)
+		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, containersSource.Type().String())
+		defer span.End()
+		err := containersSource.Chunks(ctx, e.ChunksChan())
+		if err != nil {
+			ctx.Logger().Error(err, "error scanning containers")
+		}
+	}()
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/amadeus"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/ambee"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/amplitudeapikey"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/anthropic"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/anypoint"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/apacta"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/api2cart"
@@ -55,10 +56,13 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/avazapersonalaccesstoken"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/aviationstack"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/aws"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/awssessionkeys"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/axonaut"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/aylien"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/ayrshare"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/azure"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/azuresastoken"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/azurestorageconnectionstring"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/bannerbear"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/baremetrics"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/baseapiio"
@@ -95,6 +99,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/calendlyapikey"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/calorieninja"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/campayn"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/canarytoken"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/cannyio"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/capsulecrm"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/captaindata"
@@ -138,6 +143,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/codeclimate"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/codemagic"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/codequiry"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/cohere"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/coinapi"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/coinbase"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/coinlayer"
@@ -158,6 +164,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/courier"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/coveralls"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/craftmypdf"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/cratesio"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/crossbrowsertesting"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/crowdin"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/cryptocompare"
@@ -172,6 +179,8 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/dandelion"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/dareboost"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/databox"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/databricks"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/databaseuri"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/datadogtoken"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/datafire"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/datagov"
@@ -192,6 +201,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/disqus"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/ditto"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/dnscheck"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/docker"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/docparser"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/documo"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/doppler"
@@ -278,6 +288,11 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/github_old"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/githubapp"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlab"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabcijobtoken"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabdeploytoken"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabfeedtoken"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabrunnertoken"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabtriggertoken"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabv2"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitter"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/glassnode"
@@ -308,6 +323,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/host"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/html2pdf"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/hubspotapikey"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/huggingface"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/humanity"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/hunter"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/hybiscus"
@@ -341,11 +357,13 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kanbantool"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/karmacrm"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/keenio"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/keystore"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kickbox"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/klipfolio"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/knapsackpro"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kontent"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kraken"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kubernetesserviceaccount"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kucoin"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/kylas"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/languagelayer"
@@ -399,6 +417,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/midise"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/mindmeister"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/miro"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/mistral"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/mite"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/mixmax"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/mockaroo"
@@ -430,6 +449,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/nozbeteams"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/npmtoken"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/npmtokenv2"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/nuget"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/numverify"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/nutritionix"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/nylas"
@@ -471,6 +491,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pdfshift"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/peopledatalabs"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pepipost"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pgpprivatekey"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pinata"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pipedream"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pipedrive"
@@ -498,9 +519,11 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/proxycrawl"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pubnubpublishkey"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pubnubsubscriptionkey"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pulumi"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/purestake"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pushbulletapikey"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pusherchannelkey"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/pypi"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/qase"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/qualaroo"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/qubole"
@@ -517,6 +540,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/refiner"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/rentman"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/repairshopr"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/replicate"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/restpack"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/restpackhtmltopdfapi"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/restpackscreenshotapi"
@@ -590,7 +614,9 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/smartsheets"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/smartystreets"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/smooch"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/smtp"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/snipcart"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/snowflake"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/snykkey"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/sonarcloud"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/speechtextai"
@@ -742,6 +768,24 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/coinmarketcap"
 )
 
+// verifierURLKeys are the names that --verifier sets the verification URLs of
+// detectors by.
+var verifierURLKeys = map[detectorspb.DetectorType]string{
+	detectorspb.DetectorType_Github:                        "github",
+	detectorspb.DetectorType_Gitlab:                        "gitlab",
+	detectorspb.DetectorType_GitlabDeployToken:             "gitlab",
+	detectorspb.DetectorType_GitlabRunnerToken:             "gitlab",
+	detectorspb.DetectorType_GitlabTriggerToken:            "gitlab",
+	detectorspb.DetectorType_GitlabCIJobToken:              "gitlab",
+	detectorspb.DetectorType_GitlabFeedToken:               "gitlab",
+	detectorspb.DetectorType_TerraformCloudPersonalToken:   "terraform",
+	detectorspb.DetectorType_Pulumi:                        "pulumi",
+	detectorspb.DetectorType_DatabricksToken:               "databricks",
+	detectorspb.DetectorType_Snowflake:                     "snowflake",
+	detectorspb.DetectorType_LDAP:                          "ldap",
+	detectorspb.DetectorType_KubernetesServiceAccountToken: "kubernetes",
+}
+
 // CustomDetectors returns a list of detectors that are enabled by default, but
 // can be overridden by the user.
 func CustomDetectors(ctx context.Context, urls map[string][]string) []detectors.Detector {
@@ -751,66 +795,88 @@ func CustomDetectors(ctx context.Context, urls map[string][]string) []detectors.
 	}
 
 	for i, detector := range defaultDetectors {
-
-		switch detector.Type() {
-		case detectorspb.DetectorType_Github:
-			githubUrls, ok := urls["github"]
-			if !ok {
-				ctx.Logger().V(2).Info("no GitHub urls to ignore")
-				continue
-			}
-			ctx.Logger().V(2).Info("ignoring GitHub urls: %v", githubUrls)
-
-			versioner, ok := detector.(detectors.Versioner)
-			if !ok {
-				ctx.Logger().V(2).Info("Failed to get version for GitHub detector")
-			}
-			switch versioner.Version() {
-			case 1:
-				defaultDetectors[i] = github_old.New(github_old.WithVerifierURLs(githubUrls, true))
-			default:
-				defaultDetectors[i] = github.New(github.WithVerifierURLs(githubUrls, true))
-			}
-
-		case detectorspb.DetectorType_Gitlab:
-			gitlabUrls, ok := urls["gitlab"]
-			if !ok {
-				ctx.Logger().V(2).Info("no GitLab urls to ignore")
-				continue
-			}
-			ctx.Logger().V(2).Info("ignoring GitLab urls: %v", gitlabUrls)
-
-			versioner, ok := detector.(detectors.Versioner)
-			if !ok {
-				ctx.Logger().V(2).Info("Failed to get version for Gitlab detector")
-			}
-			switch versioner.Version() {
-			case 1:
-				defaultDetectors[i] = gitlab.New(gitlab.WithVerifierURLs(gitlabUrls, true))
-			default:
-				defaultDetectors[i] = gitlabv2.New(gitlabv2.WithVerifierURLs(gitlabUrls, true))
-			}
-
-		case detectorspb.DetectorType_JiraToken:
-			// TODO(ahrav): Double check that we need to do this.
-		default:
+		key, ok := verifierURLKeys[detector.Type()]
+		if !ok {
 			ctx.Logger().V(5).Info("ignoring custom detector", "type", detector.Type())
 			continue
 		}
+		detectorUrls, ok := urls[key]
+		if !ok {
+			continue
+		}
+		ctx.Logger().V(2).Info("using custom verifier urls", "type", detector.Type(), "urls", detectorUrls)
+		if custom, ok := withVerifierURLs(detector, detectorUrls); ok {
+			defaultDetectors[i] = custom
+		}
 	}
 	return defaultDetectors
 }
 
+// withVerifierURLs rebuilds the detector with its package's WithVerifierURLs
+// option. ok is false if the detector doesn't support custom verifier URLs.
+func withVerifierURLs(detector detectors.Detector, urls []string) (custom detectors.Detector, ok bool) {
+	version := 0
+	if versioner, isVersioner := detector.(detectors.Versioner); isVersioner {
+		version = versioner.Version()
+	}
+
+	switch detector.Type() {
+	case detectorspb.DetectorType_Github:
+		if version == 1 {
+			return github_old.New(github_old.WithVerifierURLs(urls, true)), true
+		}
+		return github.New(github.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_Gitlab:
+		if version == 1 {
+			return gitlab.New(gitlab.WithVerifierURLs(urls, true)), true
+		}
+		return gitlabv2.New(gitlabv2.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_GitlabDeployToken:
+		return gitlabdeploytoken.New(gitlabdeploytoken.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_GitlabRunnerToken:
+		return gitlabrunnertoken.New(gitlabrunnertoken.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_GitlabTriggerToken:
+		return gitlabtriggertoken.New(gitlabtriggertoken.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_GitlabCIJobToken:
+		return gitlabcijobtoken.New(gitlabcijobtoken.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_GitlabFeedToken:
+		return gitlabfeedtoken.New(gitlabfeedtoken.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_TerraformCloudPersonalToken:
+		return terraformcloudpersonaltoken.New(terraformcloudpersonaltoken.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_Pulumi:
+		return pulumi.New(pulumi.WithVerifierURLs(urls, true)), true
+	case detectorspb.DetectorType_DatabricksToken:
+		return databricks.New(databricks.WithVerifierURLs(urls)), true
+	case detectorspb.DetectorType_Snowflake:
+		return snowflake.New(snowflake.WithVerifierURLs(urls)), true
+	case detectorspb.DetectorType_LDAP:
+		return ldap.New(ldap.WithVerifierURLs(urls)), true
+	case detectorspb.DetectorType_KubernetesServiceAccountToken:
+		return kubernetesserviceaccount.New(kubernetesserviceaccount.WithVerifierURLs(urls)), true
+	default:
+		return detector, false
+	}
+}
+
 func DefaultDetectors() []detectors.Detector {
 	return []detectors.Detector{
 		&heroku.Scanner{},
 		&linearapi.Scanner{},
 		&alibaba.Scanner{},
 		aws.New(),
+		&awssessionkeys.Scanner{},
 		&azure.Scanner{},
+		&azuresastoken.Scanner{},
+		&azurestorageconnectionstring.Scanner{},
 		&slack.Scanner{}, // has 4 secret types
 		&gitlabv2.Scanner{},
 		&gitlab.Scanner{},
+		&gitlabdeploytoken.Scanner{},
+		&gitlabrunnertoken.Scanner{},
+		&gitlabtriggertoken.Scanner{},
+		&gitlabcijobtoken.Scanner{},
+		&gitlabfeedtoken.Scanner{},
+		&kubernetesserviceaccount.Scanner{},
 		&sendgrid.Scanner{},
 		&mailchimp.Scanner{},
 		&okta.Scanner{},
@@ -825,9 +891,13 @@ func DefaultDetectors() []detectors.Detector {
 		&gcp.Scanner{},
 		&circleci.Scanner{},
 		&uri.Scanner{},
+		&databaseuri.Scanner{},
+		&smtp.Scanner{},
 		&razorpay.Scanner{},
 		&jdbc.Scanner{},
 		&privatekey.Scanner{},
+		&pgpprivatekey.Scanner{},
+		&keystore.Scanner{},
 		&maxmindlicense.Scanner{},
 		&airtableapikey.Scanner{},
 		&bitfinex.Scanner{},
@@ -837,6 +907,7 @@ func DefaultDetectors() []detectors.Detector {
 		&cloudflarecakey.Scanner{},
 		// &cloudflareglobalapikey.Scanner{},
 		&terraformcloudpersonaltoken.Scanner{},
+		&pulumi.Scanner{},
 		&asanapersonalaccesstoken.Scanner{},
 		&trelloapikey.Scanner{},
 		&mapbox.Scanner{},
@@ -945,6 +1016,12 @@ func DefaultDetectors() []detectors.Detector {
 		&nftport.Scanner{},
 		&coveralls.Scanner{},
 		&rubygems.Scanner{},
+		&pypi.Scanner{},
+		&cratesio.Scanner{},
+		&nuget.Scanner{},
+		&docker.Scanner{},
+		&databricks.Scanner{},
+		&snowflake.Scanner{},
 		&webflow.Scanner{},
 		&graphcms.Scanner{},
 		&anypoint.Scanner{},
@@ -1236,6 +1313,11 @@ func DefaultDetectors() []detectors.Detector {
 		streak.Scanner{},
 		route4me.Scanner{},
 		openai.Scanner{},
+		anthropic.Scanner{},
+		cohere.Scanner{},
+		mistral.Scanner{},
+		replicate.Scanner{},
+		huggingface.Scanner{},
 		opencagedata.Scanner{},
 		positionstack.Scanner{},
 		upcdatabase.Scanner{},
@@ -1565,6 +1647,7 @@ func DefaultDetectors() []detectors.Detector {
 		moralis.Scanner{},
 		bscscan.Scanner{},
 		coinmarketcap.Scanner{},
+		canarytoken.Scanner{},
 	}
 
 }
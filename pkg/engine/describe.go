@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// DetectorInfo describes a detector that the engine runs.
+type DetectorInfo struct {
+	// Name is the name of the detector in --include-detectors, with its
+	// version, or the name of a custom detector.
+	Name     string
+	Type     detectorspb.DetectorType
+	Version  int
+	Keywords []string
+	// Verify is whether the results of the detector are verified.
+	Verify bool
+	// Endpoints are the endpoints that the detector sends verification
+	// requests to, if it verifies its results. It's nil if the detector
+	// doesn't list them.
+	Endpoints []string
+}
+
+// Detectors describes the detectors that an engine started with the options
+// would run, sorted by name, without starting it.
+func Detectors(ctx context.Context, options ...EngineOption) []DetectorInfo {
+	e := &Engine{}
+	for _, option := range options {
+		option(e)
+	}
+	e.configureDetectors(ctx)
+
+	var infos []DetectorInfo
+	for verify, detectorsSet := range e.detectors {
+		for _, detector := range detectorsSet {
+			id := detectorID(detector)
+			info := DetectorInfo{
+				Name:     id.String(),
+				Type:     detector.Type(),
+				Version:  id.Version,
+				Keywords: e.keywords(detector),
+				Verify:   verify,
+			}
+			// Custom detectors have the name of their configuration.
+			if named, ok := detector.(interface{ GetName() string }); ok && named.GetName() != "" {
+				info.Name = named.GetName()
+			}
+			if endpointer, ok := detector.(detectors.Endpointer); ok && verify {
+				info.Endpoints = append([]string{}, endpointer.Endpoints()...)
+			}
+			infos = append(infos, info)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Name != infos[j].Name {
+			return infos[i].Name < infos[j].Name
+		}
+		return infos[i].Version < infos[j].Version
+	})
+	return infos
+}
+
+// DetectorsHash returns a hash of the detectors that an engine started with
+// the options would run, with their versions, keywords and whether they
+// verify their results. It changes when the set of detectors or their
+// settings do.
+func DetectorsHash(ctx context.Context, options ...EngineOption) string {
+	h := sha256.New()
+	for _, info := range Detectors(ctx, options...) {
+		keywords := append([]string{}, info.Keywords...)
+		sort.Strings(keywords)
+		fmt.Fprintf(h, "%s\x00%d\x00%d\x00%t\x00%q\n", info.Name, info.Type, info.Version, info.Verify, keywords)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
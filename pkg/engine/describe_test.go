@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlab"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabv2"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestDetectors(t *testing.T) {
+	verify := false
+	infos := Detectors(logContext.Background(),
+		WithDetectors(true,
+			gitlabv2.New(gitlabv2.WithVerifierURLs([]string{"https://gitlab.internal.corp"}, true)),
+			gitlab.New(gitlab.WithVerifierURLs(nil, true)),
+		),
+		WithDetectorSettings(
+			config.DetectorSettings{
+				ID:       config.DetectorID{ID: detectorspb.DetectorType_Gitlab, Version: 2},
+				Keywords: []string{"glcorp"},
+			},
+			config.DetectorSettings{
+				ID:     config.DetectorID{ID: detectorspb.DetectorType_Gitlab, Version: 1},
+				Verify: &verify,
+			},
+		),
+	)
+
+	want := []DetectorInfo{
+		{
+			Name:     "Gitlab.v1",
+			Type:     detectorspb.DetectorType_Gitlab,
+			Version:  1,
+			Keywords: gitlab.New().Keywords(),
+		},
+		{
+			Name:      "Gitlab.v2",
+			Type:      detectorspb.DetectorType_Gitlab,
+			Version:   2,
+			Keywords:  append(gitlabv2.New().Keywords(), "glcorp"),
+			Verify:    true,
+			Endpoints: []string{"https://gitlab.internal.corp", "https://gitlab.com"},
+		},
+	}
+	if !reflect.DeepEqual(infos, want) {
+		t.Errorf("expected %+v, got %+v", want, infos)
+	}
+}
@@ -0,0 +1,21 @@
+// Package engine finds secrets in the chunks of sources with detectors, and
+// verifies them. It's the API for embedding TruffleHog in other programs:
+//
+//	e := engine.Start(ctx,
+//		engine.WithConcurrency(8),
+//		engine.WithDetectors(true, engine.DefaultDetectors()...),
+//		engine.WithResults(engine.Results{Verified: true}),
+//	)
+//	if err := e.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{"."}}); err != nil {
+//		return err
+//	}
+//	return e.Collect(ctx, func(r detectors.ResultWithMetadata) error {
+//		fmt.Println(r.DetectorType, r.Redacted)
+//		return nil
+//	})
+//
+// Start, the EngineOption functions, the Scan methods, ScanSource, Collect,
+// ResultsChan and Finish follow semantic versioning: they only change in
+// backward incompatible ways in a new major version of the module. The rest of
+// the package may change in any release.
+package engine
@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
+)
+
+// ScanSource scans a source that has no Scan method of its own, like a
+// source of the embedding program. The source must be initialized already.
+// Like the other scans, it runs in the background until Finish, and errors of
+// the source are logged.
+func (e *Engine) ScanSource(ctx context.Context, source sources.Source) {
+	ctx = context.WithValues(ctx, "source_type", source.Type().String())
+	e.sourcesWg.Add(1)
+	go func() {
+		defer common.RecoverWithExit(ctx)
+		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, source.Type().String())
+		defer span.End()
+		if err := source.Chunks(ctx, e.ChunksChan()); err != nil {
+			ctx.Logger().Error(err, "error scanning source")
+		}
+	}()
+}
+
+// Collect finishes the scans of the engine, and calls handle with each result
+// as it's found, until every result was handled. Once handle returns an
+// error, the remaining results are discarded and Collect returns the error
+// after the engine finished. The results must be received either with
+// Collect or from ResultsChan.
+func (e *Engine) Collect(ctx context.Context, handle func(detectors.ResultWithMetadata) error) error {
+	go e.Finish(ctx)
+
+	var err error
+	for r := range e.ResultsChan() {
+		// The results are drained so the workers don't block.
+		if err == nil {
+			err = handle(r)
+		}
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// fakeSource emits a chunk for each of its strings.
+type fakeSource []string
+
+func (s fakeSource) Type() sourcespb.SourceType { return sourcespb.SourceType_SOURCE_TYPE_TEST }
+func (s fakeSource) SourceID() int64            { return 0 }
+func (s fakeSource) JobID() int64               { return 0 }
+func (s fakeSource) Init(logContext.Context, string, int64, int64, bool, *anypb.Any, int) error {
+	return nil
+}
+func (s fakeSource) GetProgress() *sources.Progress { return &sources.Progress{} }
+
+func (s fakeSource) Chunks(_ logContext.Context, chunks chan *sources.Chunk) error {
+	for _, data := range s {
+		chunks <- &sources.Chunk{SourceName: "fake", Data: []byte(data)}
+	}
+	return nil
+}
+
+func TestEngineCollect(t *testing.T) {
+	ctx := logContext.Background()
+	e := Start(ctx, WithConcurrency(1), WithDetectors(false, &fakeDetector{}))
+	e.ScanSource(ctx, fakeSource{"fake secret", "nothing", "fake secret"})
+
+	var sourceNames []string
+	err := e.Collect(ctx, func(r detectors.ResultWithMetadata) error {
+		sourceNames = append(sourceNames, r.SourceName)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fake", "fake"}, sourceNames)
+}
+
+func TestEngineCollectError(t *testing.T) {
+	ctx := logContext.Background()
+	e := Start(ctx, WithConcurrency(1), WithDetectors(false, &fakeDetector{}))
+	e.ScanSource(ctx, fakeSource{"fake secret", "fake secret", "fake secret"})
+
+	handled := 0
+	errStop := errors.New("stop")
+	err := e.Collect(ctx, func(detectors.ResultWithMetadata) error {
+		handled++
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, handled)
+}
+
+func TestEngineResultProcessors(t *testing.T) {
+	ctx := logContext.Background()
+	processed := 0
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(false, &fakeDetector{}),
+		WithResultProcessors(
+			// Drops the first result.
+			func(logContext.Context, *detectors.ResultWithMetadata) bool {
+				processed++
+				return processed > 1
+			},
+			func(_ logContext.Context, r *detectors.ResultWithMetadata) bool {
+				r.ExtraData = map[string]string{"team": "security"}
+				return true
+			},
+		),
+	)
+	e.ScanSource(ctx, fakeSource{"fake secret", "fake secret"})
+
+	var results []detectors.ResultWithMetadata
+	assert.NoError(t, e.Collect(ctx, func(r detectors.ResultWithMetadata) error {
+		results = append(results, r)
+		return nil
+	}))
+	assert.Equal(t, 2, processed)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, map[string]string{"team": "security"}, results[0].ExtraData)
+	}
+}
@@ -4,15 +4,19 @@ import (
 	"bytes"
 	"reflect"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	ahocorasick "github.com/petar-dambovaliev/aho-corasick"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
@@ -20,6 +24,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 type Engine struct {
@@ -33,11 +38,74 @@ type Engine struct {
 	detectorAvgTime sync.Map
 	sourcesWg       sync.WaitGroup
 	workersWg       sync.WaitGroup
+
+	// sourceChunks counts the chunks scanned from each source, by name.
+	sourceChunks sync.Map
+
+	// verificationConcurrency is the number of workers verifying candidate
+	// secrets. Verification happens in its own pool so slow provider APIs
+	// don't hold up chunk processing.
+	verificationConcurrency int
+	verificationChunks      chan detectableChunk
+	verificationWg          sync.WaitGroup
+	// verificationBacklog is the number of chunks waiting for or being
+	// verified.
+	verificationBacklog int64
+
+	// verifiers holds the custom verifiers registered per detector type.
+	verifiers map[detectorspb.DetectorType]customVerifier
+	// unreverifiedFindings counts the findings Reverify couldn't verify
+	// again.
+	unreverifiedFindings uint64
+
+	// offlineVerification scores results with local heuristics, for scans
+	// that can't verify secrets over the network.
+	offlineVerification bool
+
+	// liveVerification lets the detectors that verify by connecting to hosts
+	// found in the scanned data, like database connection URIs, verify.
+	liveVerification bool
+
 	// filterUnverified is used to reduce the number of unverified results.
 	// If there are multiple unverified results for the same chunk for the same detector,
 	// only the first one will be kept.
 	filterUnverified bool
 
+	// resultTypes selects the categories of results that are emitted. Every
+	// result is emitted if it's nil.
+	resultTypes *Results
+	// resultProcessors process the results before they're emitted.
+	resultProcessors []ResultProcessor
+
+	// ignoreRules drop the results they match, and ignoredResults counts
+	// them.
+	ignoreRules    []config.IgnoreRule
+	ignoredResults uint64
+	// inlineIgnores drops the results annotated with trufflehog:ignore
+	// comments, and inlineIgnoredResults counts them.
+	inlineIgnores        bool
+	inlineIgnoredResults uint64
+	// sampleHeuristics reports the candidates that look like sample
+	// credentials without verifying them, and unverifiedSamples counts them.
+	sampleHeuristics  bool
+	unverifiedSamples uint64
+
+	// revokeFilter selects the detectors whose verified secrets are revoked,
+	// and revocations holds the outcome of revoking each secret.
+	revokeFilter func(detectors.Detector) bool
+	revocations  sync.Map
+
+	// gitRepos holds the repos whose findings are enriched, by the
+	// repository of their source metadata, and secretAges the age of each
+	// secret found in them.
+	gitRepos   sync.Map
+	secretAges sync.Map
+
+	// detectorSettings tune the detectors when the engine starts.
+	detectorSettings []config.DetectorSettings
+	// extraKeywords are the keywords added to detectors by their settings.
+	extraKeywords map[config.DetectorID][]string
+
 	// prefilter is a ahocorasick struct used for doing efficient string
 	// matching given a set of words (keywords from the rules in the config)
 	prefilter ahocorasick.AhoCorasick
@@ -51,6 +119,62 @@ func WithConcurrency(concurrency int) EngineOption {
 	}
 }
 
+// WithVerificationConcurrency sets the number of workers verifying candidate
+// secrets. It defaults to the detection concurrency.
+func WithVerificationConcurrency(concurrency int) EngineOption {
+	return func(e *Engine) {
+		e.verificationConcurrency = concurrency
+	}
+}
+
+// WithOfflineVerification enables scoring results with structural checks and
+// other local heuristics. The score is recorded in the result's ExtraData as
+// "offline_confidence".
+func WithOfflineVerification(enabled bool) EngineOption {
+	return func(e *Engine) {
+		e.offlineVerification = enabled
+	}
+}
+
+// WithLiveVerification enables the verification of the detectors that
+// connect to hosts found in the scanned data, e.g. database connection URIs.
+// It is opt-in even when verification is enabled.
+func WithLiveVerification(enabled bool) EngineOption {
+	return func(e *Engine) {
+		e.liveVerification = enabled
+	}
+}
+
+// customVerifier is a verifier registered for a detector type.
+type customVerifier struct {
+	detectors.Verifier
+	// override skips the detector's built-in verification. Otherwise the
+	// verifier only runs for results the detector couldn't verify.
+	override bool
+}
+
+// WithVerifier registers a verifier that replaces the built-in verification
+// of the given detector type. It only runs for detectors configured to
+// verify.
+func WithVerifier(detectorType detectorspb.DetectorType, verifier detectors.Verifier) EngineOption {
+	return withCustomVerifier(detectorType, customVerifier{Verifier: verifier, override: true})
+}
+
+// WithSupplementalVerifier registers a verifier that runs for results the
+// built-in verification of the given detector type didn't verify.
+func WithSupplementalVerifier(detectorType detectorspb.DetectorType, verifier detectors.Verifier) EngineOption {
+	return withCustomVerifier(detectorType, customVerifier{Verifier: verifier})
+}
+
+func withCustomVerifier(detectorType detectorspb.DetectorType, verifier customVerifier) EngineOption {
+	return func(e *Engine) {
+		if e.verifiers == nil {
+			e.verifiers = make(map[detectorspb.DetectorType]customVerifier)
+		}
+		e.verifiers[detectorType] = verifier
+	}
+}
+
 func WithDetectors(verify bool, d ...detectors.Detector) EngineOption {
 	return func(e *Engine) {
 		if e.detectors == nil {
@@ -93,6 +217,26 @@ func WithFilterDetectors(filterFunc func(detectors.Detector) bool) EngineOption
 	}
 }
 
+// configureDetectors sets up the detectors configured by the options, or the
+// default detectors.
+func (e *Engine) configureDetectors(ctx context.Context) {
+	if len(e.detectors) == 0 {
+		e.detectors = map[bool][]detectors.Detector{}
+		e.detectors[true] = DefaultDetectors()
+		e.detectors[false] = []detectors.Detector{}
+	}
+
+	if e.liveVerification {
+		for i, d := range e.detectors[true] {
+			if live, ok := d.(detectors.LiveVerifier); ok {
+				e.detectors[true][i] = live.WithLiveVerification()
+			}
+		}
+	}
+
+	e.applyDetectorSettings(ctx)
+}
+
 func filterDetectors(filterFunc func(detectors.Detector) bool, input []detectors.Detector) []detectors.Detector {
 	var output []detectors.Detector
 	for _, detector := range input {
@@ -105,9 +249,10 @@ func filterDetectors(filterFunc func(detectors.Detector) bool, input []detectors
 
 func Start(ctx context.Context, options ...EngineOption) *Engine {
 	e := &Engine{
-		chunks:          make(chan *sources.Chunk),
-		results:         make(chan detectors.ResultWithMetadata),
-		detectorAvgTime: sync.Map{},
+		chunks:             make(chan *sources.Chunk),
+		results:            make(chan detectors.ResultWithMetadata),
+		verificationChunks: make(chan detectableChunk),
+		detectorAvgTime:    sync.Map{},
 	}
 
 	for _, option := range options {
@@ -120,26 +265,33 @@ func Start(ctx context.Context, options ...EngineOption) *Engine {
 		ctx.Logger().Info("No concurrency specified, defaulting to max", "cpu", numCPU)
 		e.concurrency = numCPU
 	}
-	ctx.Logger().V(2).Info("engine started", "workers", e.concurrency)
+	if e.verificationConcurrency == 0 {
+		e.verificationConcurrency = e.concurrency
+	}
+	ctx.Logger().V(2).Info("engine started",
+		"workers", e.concurrency,
+		"verification_workers", e.verificationConcurrency,
+	)
 
 	if len(e.decoders) == 0 {
 		e.decoders = decoders.DefaultDecoders()
 	}
 
-	if len(e.detectors) == 0 {
-		e.detectors = map[bool][]detectors.Detector{}
-		e.detectors[true] = DefaultDetectors()
-		e.detectors[false] = []detectors.Detector{}
+	e.configureDetectors(ctx)
+
+	// Run the versions of a detector side by side, deduping their results.
+	for verify, detectorsSet := range e.detectors {
+		e.detectors[verify] = groupVersions(detectorsSet, e.keywords)
 	}
 
 	// build ahocorasick prefilter for efficient string matching
 	// on keywords
 	keywords := []string{}
 	for _, d := range e.detectors[false] {
-		keywords = append(keywords, d.Keywords()...)
+		keywords = append(keywords, e.keywords(d)...)
 	}
 	for _, d := range e.detectors[true] {
-		keywords = append(keywords, d.Keywords()...)
+		keywords = append(keywords, e.keywords(d)...)
 	}
 	builder := ahocorasick.NewAhoCorasickBuilder(ahocorasick.Opts{
 		AsciiCaseInsensitive: true,
@@ -165,6 +317,14 @@ func Start(ctx context.Context, options ...EngineOption) *Engine {
 			e.detectorWorker(ctx)
 		}()
 	}
+	for i := 0; i < e.verificationConcurrency; i++ {
+		e.verificationWg.Add(1)
+		go func() {
+			defer common.RecoverWithExit(ctx)
+			defer e.verificationWg.Done()
+			e.verificationWorker(ctx)
+		}()
+	}
 
 	return e
 }
@@ -180,6 +340,10 @@ func (e *Engine) Finish(ctx context.Context) {
 	// wait for the workers to finish processing all of the chunks and putting
 	// results onto the results channel
 	e.workersWg.Wait()
+	// wait for the verification workers to finish verifying the chunks the
+	// workers handed them
+	close(e.verificationChunks)
+	e.verificationWg.Wait()
 
 	// TODO: re-evaluate whether this is needed and investigate why if so
 	//
@@ -198,11 +362,28 @@ func (e *Engine) ResultsChan() chan detectors.ResultWithMetadata {
 }
 
 func (e *Engine) ChunksScanned() uint64 {
-	return e.chunksScanned
+	return atomic.LoadUint64(&e.chunksScanned)
 }
 
 func (e *Engine) BytesScanned() uint64 {
-	return e.bytesScanned
+	return atomic.LoadUint64(&e.bytesScanned)
+}
+
+// VerificationBacklog returns the number of chunks with candidate secrets that
+// are waiting for or being verified.
+func (e *Engine) VerificationBacklog() int64 {
+	return atomic.LoadInt64(&e.verificationBacklog)
+}
+
+// SourceChunks returns the number of chunks scanned from each source, by the
+// name of the source.
+func (e *Engine) SourceChunks() map[string]uint64 {
+	counts := map[string]uint64{}
+	e.sourceChunks.Range(func(k, v interface{}) bool {
+		counts[k.(string)] = atomic.LoadUint64(v.(*uint64))
+		return true
+	})
+	return counts
 }
 
 func (e *Engine) DetectorAvgTime() map[string][]time.Duration {
@@ -228,6 +409,10 @@ func (e *Engine) DetectorAvgTime() map[string][]time.Duration {
 
 func (e *Engine) detectorWorker(ctx context.Context) {
 	for originalChunk := range e.chunks {
+		ctx, span := tracing.Start(ctx, "chunk",
+			attribute.String("source_name", originalChunk.SourceName),
+			attribute.String("source_type", originalChunk.SourceType.String()),
+		)
 		for chunk := range sources.Chunker(originalChunk) {
 			matchedKeywords := make(map[string]struct{})
 			atomic.AddUint64(&e.bytesScanned, uint64(len(chunk.Data)))
@@ -256,8 +441,10 @@ func (e *Engine) detectorWorker(ctx context.Context) {
 
 				for verify, detectorsSet := range e.detectors {
 					for _, detector := range detectorsSet {
-						chunkContainsKeyword := false
-						for _, kw := range detector.Keywords() {
+						detectorKeywords := e.keywords(detector)
+						// Detectors without keywords scan every chunk.
+						chunkContainsKeyword := len(detectorKeywords) == 0
+						for _, kw := range detectorKeywords {
 							if _, ok := matchedKeywords[strings.ToLower(kw)]; ok {
 								chunkContainsKeyword = true
 								break
@@ -268,61 +455,289 @@ func (e *Engine) detectorWorker(ctx context.Context) {
 							continue
 						}
 
-						start := time.Now()
-
-						results, err := func() ([]detectors.Result, error) {
-							ctx, cancel := context.WithTimeout(ctx, time.Second*10)
-							defer cancel()
-							defer common.Recover(ctx)
-							return detector.FromData(ctx, verify, decoded.Data)
-						}()
-						if err != nil {
-							ctx.Logger().Error(err, "could not scan chunk",
-								"source_type", decoded.SourceType.String(),
-								"metadata", decoded.SourceMetadata,
-							)
+						detectable := detectableChunk{
+							chunk:       chunk,
+							decoded:     decoded,
+							decoderType: decoderType,
+							detector:    detector,
+							span:        tracing.SpanContext(ctx),
+						}
+						if !verify {
+							e.detect(ctx, detectable, false)
 							continue
 						}
 
-						if e.filterUnverified {
-							results = detectors.CleanResults(results)
-						}
-						for _, result := range results {
-							resultChunk := chunk
-							if SupportsLineNumbers(chunk.SourceType) {
-								copyChunk := *chunk
-								copyMetaDataClone := proto.Clone(chunk.SourceMetadata)
-								if copyMetaData, ok := copyMetaDataClone.(*source_metadatapb.MetaData); ok {
-									copyChunk.SourceMetadata = copyMetaData
-								}
-								fragStart, mdLine := FragmentFirstLine(&copyChunk)
-								SetResultLineNumber(&copyChunk, &result, fragStart, mdLine)
-								resultChunk = &copyChunk
+						// Detectors that can verify results on their own are
+						// scanned here, so that chunks without candidates
+						// never wait for the verification workers, and sample
+						// credentials aren't verified. The others verify while
+						// they scan, so the chunk is scanned by the
+						// verification workers instead.
+						if _, ok := detector.(detectors.Verifier); ok {
+							candidates, err := e.fromData(ctx, detectable, false)
+							if err != nil {
+								ctx.Logger().Error(err, "could not scan chunk",
+									"source_type", decoded.SourceType.String(),
+									"metadata", decoded.SourceMetadata,
+								)
+								continue
 							}
-							result.DecoderType = decoderType
-							e.results <- detectors.CopyMetadata(resultChunk, result)
-
-						}
-						if len(results) > 0 {
-							elapsed := time.Since(start)
-							detectorName := results[0].DetectorType.String()
-							avgTimeI, ok := e.detectorAvgTime.Load(detectorName)
-							var avgTime []time.Duration
-							if ok {
-								avgTime, ok = avgTimeI.([]time.Duration)
-								if !ok {
-									continue
-								}
+							if len(candidates) == 0 {
+								continue
+							}
+							detectable.candidates = candidates
+							if e.skipVerification(detectable, candidates) {
+								e.detect(ctx, detectable, false)
+								continue
 							}
-							avgTime = append(avgTime, elapsed)
-							e.detectorAvgTime.Store(detectorName, avgTime)
 						}
+						atomic.AddInt64(&e.verificationBacklog, 1)
+						e.verificationChunks <- detectable
 					}
 				}
 			}
 		}
+		span.End()
 		atomic.AddUint64(&e.chunksScanned, 1)
+		count, _ := e.sourceChunks.LoadOrStore(originalChunk.SourceName, new(uint64))
+		atomic.AddUint64(count.(*uint64), 1)
+	}
+}
+
+func (e *Engine) verificationWorker(ctx context.Context) {
+	for chunk := range e.verificationChunks {
+		e.detect(tracing.WithSpanContext(ctx, chunk.span), chunk, true)
+		atomic.AddInt64(&e.verificationBacklog, -1)
+	}
+}
+
+// detectableChunk is decoded chunk data paired with a detector whose keywords
+// matched it.
+type detectableChunk struct {
+	chunk       *sources.Chunk
+	decoded     *sources.Chunk
+	decoderType detectorspb.DecoderType
+	detector    detectors.Detector
+	// span is the span of the chunk, which verification continues in
+	// another goroutine.
+	span trace.SpanContext
+	// candidates are the unverified results the detector found in the chunk,
+	// if it was already scanned.
+	candidates []detectors.Result
+}
+
+// fromData runs the detector against the decoded data with a timeout.
+func (e *Engine) fromData(ctx context.Context, chunk detectableChunk, verify bool) ([]detectors.Result, error) {
+	ctx, span := tracing.Start(ctx, "detect",
+		attribute.String("detector", chunk.detector.Type().String()),
+		attribute.Bool("verify", verify),
+	)
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	defer common.Recover(ctx)
+	detectorCtx := common.WithDetectorName(ctx, chunk.detector.Type().String())
+	return chunk.detector.FromData(detectorCtx, verify, chunk.decoded.Data)
+}
+
+// scan returns the results of the chunk's detector, verified if verify is set.
+// The candidates of a chunk that was already scanned are reused and verified
+// with the detector's Verify method, instead of scanning the chunk again.
+func (e *Engine) scan(ctx context.Context, chunk detectableChunk, verify bool) ([]detectors.Result, error) {
+	if chunk.candidates == nil {
+		return e.fromData(ctx, chunk, verify)
+	}
+	if !verify {
+		return chunk.candidates, nil
+	}
+	verifier, ok := chunk.detector.(detectors.Verifier)
+	if !ok {
+		return e.fromData(ctx, chunk, true)
+	}
+
+	ctx, span := tracing.Start(ctx, "verify",
+		attribute.String("detector", chunk.detector.Type().String()),
+	)
+	defer span.End()
+	ctx = context.WithLogger(common.WithDetectorName(ctx, chunk.detector.Type().String()), ctx.Logger())
+	for i := range chunk.candidates {
+		verifyResult(ctx, verifier, &chunk.candidates[i])
+	}
+	return chunk.candidates, nil
+}
+
+// detect runs the detector against the chunk and sends any results to the
+// results channel.
+func (e *Engine) detect(ctx context.Context, chunk detectableChunk, verify bool) {
+	start := time.Now()
+
+	verifier, hasVerifier := e.verifiers[chunk.detector.Type()]
+	hasVerifier = hasVerifier && verify
+
+	// Record which endpoints would have been contacted to verify the results
+	// when running a verification dry run.
+	var planned *common.PlannedRequests
+	if verify && common.VerificationDryRun() {
+		planned = &common.PlannedRequests{}
+		ctx = context.WithLogger(common.WithPlannedRequests(ctx, planned), ctx.Logger())
+	}
+
+	// Record the verification requests that fail, so that the results they
+	// couldn't verify are reported as unknown instead of unverified.
+	verificationErrs := &common.VerificationErrors{}
+	if verify {
+		ctx = context.WithLogger(common.WithVerificationErrors(ctx, verificationErrs), ctx.Logger())
+	}
+
+	results, err := e.scan(ctx, chunk, verify && !(hasVerifier && verifier.override))
+	if err != nil {
+		ctx.Logger().Error(err, "could not scan chunk",
+			"source_type", chunk.decoded.SourceType.String(),
+			"metadata", chunk.decoded.SourceMetadata,
+		)
+		return
+	}
+	setVerificationErrors(results, verificationErrs.Err())
+	results = e.dropIgnored(chunk, results)
+	results = e.dropInlineIgnored(chunk, results)
+	if hasVerifier {
+		e.customVerify(ctx, verifier, results)
+	}
+	if planned != nil {
+		setPlannedEndpoints(results, planned.Requests())
+	}
+	if e.offlineVerification {
+		for i := range results {
+			if results[i].ExtraData == nil {
+				results[i].ExtraData = map[string]string{}
+			}
+			confidence := detectors.OfflineConfidence(detectorOf(chunk.detector, results[i]), results[i])
+			results[i].ExtraData["offline_confidence"] = strconv.FormatFloat(confidence, 'f', 2, 64)
+		}
+	}
+
+	// Revoking would send requests that a dry run only reports.
+	if e.revokeFilter != nil && verify && !common.VerificationDryRun() {
+		e.revoke(ctx, chunk.detector, results)
+	}
+
+	if gitMetadata := chunk.chunk.SourceMetadata.GetGit(); gitMetadata != nil {
+		if repo, ok := e.gitRepos.Load(gitMetadata.Repository); ok {
+			e.enrichGitResults(ctx, repo.(*gitRepo), gitMetadata.File, results)
+		}
+	}
+
+	if e.filterUnverified {
+		results = detectors.CleanResults(results)
+	}
+	file := metadataFile(chunk.chunk.SourceMetadata)
+	for _, result := range results {
+		if e.resultTypes != nil && !e.resultTypes.keep(result, file) {
+			continue
+		}
+		resultChunk := chunk.chunk
+		if SupportsLineNumbers(resultChunk.SourceType) {
+			copyChunk := *resultChunk
+			copyMetaDataClone := proto.Clone(resultChunk.SourceMetadata)
+			if copyMetaData, ok := copyMetaDataClone.(*source_metadatapb.MetaData); ok {
+				copyChunk.SourceMetadata = copyMetaData
+			}
+			fragStart, mdLine := FragmentFirstLine(&copyChunk)
+			SetResultLineNumber(&copyChunk, &result, fragStart, mdLine)
+			resultChunk = &copyChunk
+		}
+		result.DecoderType = chunk.decoderType
+		resultWithMetadata := detectors.CopyMetadata(resultChunk, result)
+		resultWithMetadata.ChunkData = chunk.decoded.Data
+		if !e.processResult(ctx, &resultWithMetadata) {
+			continue
+		}
+		e.results <- resultWithMetadata
+	}
+
+	if len(results) > 0 {
+		elapsed := time.Since(start)
+		detectorName := results[0].DetectorType.String()
+		avgTimeI, ok := e.detectorAvgTime.Load(detectorName)
+		var avgTime []time.Duration
+		if ok {
+			avgTime, ok = avgTimeI.([]time.Duration)
+			if !ok {
+				return
+			}
+		}
+		avgTime = append(avgTime, elapsed)
+		e.detectorAvgTime.Store(detectorName, avgTime)
+	}
+}
+
+// setVerificationErrors records err as the verification error of the results
+// that weren't verified. The requests of a detector can't be told apart, so a
+// failed request makes every result the detector couldn't verify unknown.
+func setVerificationErrors(results []detectors.Result, err error) {
+	if err == nil {
+		return
+	}
+	for i := range results {
+		if !results[i].Verified && results[i].VerificationError() == nil {
+			results[i].SetVerificationError(err)
+		}
+	}
+}
+
+// setPlannedEndpoints records the endpoints a verification dry run would have
+// contacted in the results' ExtraData.
+func setPlannedEndpoints(results []detectors.Result, requests []common.PlannedRequest) {
+	if len(requests) == 0 {
+		return
+	}
+	var endpoints []string
+	seen := make(map[string]struct{}, len(requests))
+	for _, r := range requests {
+		endpoint := r.Method + " " + r.Endpoint
+		if _, ok := seen[endpoint]; ok {
+			continue
+		}
+		seen[endpoint] = struct{}{}
+		endpoints = append(endpoints, endpoint)
+	}
+	for i := range results {
+		if results[i].ExtraData == nil {
+			results[i].ExtraData = map[string]string{}
+		}
+		results[i].ExtraData["verification_endpoints"] = strings.Join(endpoints, ", ")
+	}
+}
+
+// customVerify runs the custom verifier against results that aren't verified
+// yet.
+func (e *Engine) customVerify(ctx context.Context, verifier customVerifier, results []detectors.Result) {
+	for i := range results {
+		verifyResult(ctx, verifier, &results[i])
+	}
+}
+
+// verifyResult verifies the result with the verifier, unless it's verified
+// already. Canaries are skipped, since verifying them would trip them.
+func verifyResult(ctx context.Context, verifier detectors.Verifier, result *detectors.Result) {
+	if result.Verified || result.Canary {
+		return
+	}
+	verified, err := func() (bool, error) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+		defer cancel()
+		defer common.Recover(ctx)
+		return verifier.Verify(ctx, *result)
+	}()
+	if err != nil {
+		ctx.Logger().Error(err, "verification failed",
+			"detector", result.DetectorType.String(),
+		)
+		result.SetVerificationError(err)
+		return
 	}
+	result.Verified = verified
+	result.SetVerificationError(nil)
 }
 
 // gitSources is a list of sources that utilize the Git source. It is stored this way because slice consts are not
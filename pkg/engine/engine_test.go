@@ -0,0 +1,263 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// fakeDetector finds the string "secret" and counts how often it scans and is
+// asked to verify.
+type fakeDetector struct {
+	scans         int32
+	verifications int32
+	// invalid makes verification fail.
+	invalid bool
+}
+
+func (d *fakeDetector) FromData(_ context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	atomic.AddInt32(&d.scans, 1)
+	if string(data) != "fake secret" {
+		return nil, nil
+	}
+	if verify {
+		atomic.AddInt32(&d.verifications, 1)
+	}
+	return []detectors.Result{{
+		DetectorType: detectorspb.DetectorType_CustomRegex,
+		Raw:          []byte("secret"),
+		Verified:     verify && !d.invalid,
+	}}, nil
+}
+
+func (d *fakeDetector) Keywords() []string { return []string{"fake"} }
+
+func (d *fakeDetector) Type() detectorspb.DetectorType { return detectorspb.DetectorType_CustomRegex }
+
+func TestEngineVerificationWorkers(t *testing.T) {
+	ctx := logContext.Background()
+	detector := &fakeDetector{}
+	e := Start(ctx,
+		WithConcurrency(2),
+		WithVerificationConcurrency(3),
+		WithDetectors(true, detector),
+	)
+
+	results := scanData(ctx, e, "fake secret", "fake but nothing to find", "fake secret")
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, r := range results {
+		if !r.Verified {
+			t.Errorf("expected result to be verified")
+		}
+	}
+	// Each chunk is scanned once, by the verification workers.
+	if got := atomic.LoadInt32(&detector.scans); got != 3 {
+		t.Errorf("expected 3 scans, got %d", got)
+	}
+	if got := atomic.LoadInt32(&detector.verifications); got != 2 {
+		t.Errorf("expected 2 verifications, got %d", got)
+	}
+}
+
+// verifyingDetector is a fakeDetector that verifies its results itself.
+type verifyingDetector struct {
+	fakeDetector
+}
+
+func (d *verifyingDetector) Verify(_ context.Context, result detectors.Result) (bool, error) {
+	return string(result.Raw) == "secret", nil
+}
+
+func TestEngineVerifiesCandidates(t *testing.T) {
+	ctx := logContext.Background()
+	detector := &verifyingDetector{}
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(true, detector),
+	)
+
+	results := scanData(ctx, e, "fake secret")
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if !results[0].Verified {
+		t.Errorf("expected result to be verified")
+	}
+	// The candidates are verified without scanning the chunk again.
+	if got := atomic.LoadInt32(&detector.scans); got != 1 {
+		t.Errorf("expected 1 scan, got %d", got)
+	}
+	if got := atomic.LoadInt32(&detector.verifications); got != 0 {
+		t.Errorf("expected no verifying scans, got %d", got)
+	}
+}
+
+func TestEngineCustomVerifier(t *testing.T) {
+	ctx := logContext.Background()
+
+	tests := []struct {
+		name                  string
+		detector              *fakeDetector
+		option                func(detectorspb.DetectorType, detectors.Verifier) EngineOption
+		customResult          bool
+		wantVerified          bool
+		wantVerifications     int32
+		wantCustomVerifyCalls int32
+	}{
+		{
+			name:                  "override",
+			detector:              &fakeDetector{},
+			option:                WithVerifier,
+			customResult:          false,
+			wantVerified:          false,
+			wantVerifications:     0,
+			wantCustomVerifyCalls: 1,
+		},
+		{
+			name:                  "supplement after built-in verification fails",
+			detector:              &fakeDetector{invalid: true},
+			option:                WithSupplementalVerifier,
+			customResult:          true,
+			wantVerified:          true,
+			wantVerifications:     1,
+			wantCustomVerifyCalls: 1,
+		},
+		{
+			name:                  "supplement skipped after built-in verification succeeds",
+			detector:              &fakeDetector{},
+			option:                WithSupplementalVerifier,
+			customResult:          false,
+			wantVerified:          true,
+			wantVerifications:     1,
+			wantCustomVerifyCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			verifier := detectors.VerifierFunc(func(_ context.Context, result detectors.Result) (bool, error) {
+				atomic.AddInt32(&calls, 1)
+				if string(result.Raw) != "secret" {
+					t.Errorf("unexpected raw secret %q", result.Raw)
+				}
+				return tt.customResult, nil
+			})
+
+			e := Start(ctx,
+				WithConcurrency(1),
+				WithDetectors(true, tt.detector),
+				tt.option(detectorspb.DetectorType_CustomRegex, verifier),
+			)
+			results := scanData(ctx, e, "fake secret")
+
+			if len(results) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(results))
+			}
+			if results[0].Verified != tt.wantVerified {
+				t.Errorf("verified: got %t, want %t", results[0].Verified, tt.wantVerified)
+			}
+			if got := atomic.LoadInt32(&tt.detector.verifications); got != tt.wantVerifications {
+				t.Errorf("built-in verifications: got %d, want %d", got, tt.wantVerifications)
+			}
+			if got := atomic.LoadInt32(&calls); got != tt.wantCustomVerifyCalls {
+				t.Errorf("custom verifications: got %d, want %d", got, tt.wantCustomVerifyCalls)
+			}
+		})
+	}
+}
+
+// liveDetector is a fakeDetector that only verifies once live verification
+// is enabled.
+type liveDetector struct {
+	fakeDetector
+	live bool
+}
+
+func (d *liveDetector) FromData(ctx context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	return d.fakeDetector.FromData(ctx, verify && d.live, data)
+}
+
+func (d *liveDetector) WithLiveVerification() detectors.Detector {
+	return &liveDetector{live: true}
+}
+
+func TestEngineLiveVerification(t *testing.T) {
+	ctx := logContext.Background()
+
+	for _, enabled := range []bool{false, true} {
+		e := Start(ctx,
+			WithConcurrency(1),
+			WithDetectors(true, &liveDetector{}),
+			WithLiveVerification(enabled),
+		)
+		results := scanData(ctx, e, "fake secret")
+
+		if len(results) != 1 {
+			t.Fatalf("expected 1 result, got %d", len(results))
+		}
+		if results[0].Verified != enabled {
+			t.Errorf("live verification %t: got verified %t", enabled, results[0].Verified)
+		}
+	}
+}
+
+// keywordlessDetector finds the string "secret" without any keywords.
+type keywordlessDetector struct{}
+
+func (keywordlessDetector) FromData(_ context.Context, _ bool, data []byte) ([]detectors.Result, error) {
+	if !strings.Contains(string(data), "secret") {
+		return nil, nil
+	}
+	return []detectors.Result{{DetectorType: detectorspb.DetectorType_Entropy, Raw: []byte("secret")}}, nil
+}
+
+func (keywordlessDetector) Keywords() []string { return nil }
+
+func (keywordlessDetector) Type() detectorspb.DetectorType { return detectorspb.DetectorType_Entropy }
+
+func TestEngineKeywordlessDetector(t *testing.T) {
+	ctx := logContext.Background()
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(false, keywordlessDetector{}, &fakeDetector{}),
+	)
+
+	results := scanData(ctx, e, "a secret", "nothing", "another secret")
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, r := range results {
+		if r.DetectorType != detectorspb.DetectorType_Entropy {
+			t.Errorf("expected results of the keywordless detector, got %s", r.DetectorType)
+		}
+	}
+}
+
+// scanData sends each string to the engine as a chunk and collects the
+// results.
+func scanData(ctx logContext.Context, e *Engine, data ...string) []detectors.ResultWithMetadata {
+	go func() {
+		for _, d := range data {
+			e.ChunksChan() <- &sources.Chunk{Data: []byte(d)}
+		}
+		e.Finish(ctx)
+	}()
+
+	var results []detectors.ResultWithMetadata
+	for r := range e.ResultsChan() {
+		results = append(results, r)
+	}
+	return results
+}
@@ -12,12 +12,20 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/filesystem"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanFileSystem scans a given file system.
 func (e *Engine) ScanFileSystem(ctx context.Context, c sources.FilesystemConfig) error {
 	connection := &sourcespb.Filesystem{
-		Paths: c.Paths,
+		Paths:            c.Paths,
+		RespectGitignore: c.RespectGitignore,
+		Watch:            c.Watch,
+
+		FollowSymlinks:           c.FollowSymlinks,
+		ScanDevices:              c.ScanDevices,
+		ScanAlternateDataStreams: c.ScanAlternateDataStreams,
+		MaxFileSize:              c.MaxFileSize,
 	}
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -41,6 +49,8 @@ func (e *Engine) ScanFileSystem(ctx context.Context, c sources.FilesystemConfig)
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, fileSystemSource.Type().String())
+		defer span.End()
 		err := fileSystemSource.Chunks(ctx, e.ChunksChan())
 		if err != nil {
 			ctx.Logger().Error(err, "error scanning filesystem")
@@ -11,6 +11,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gcs"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanGCS with the provided options.
@@ -32,6 +33,11 @@ func (e *Engine) ScanGCS(ctx context.Context, c sources.GCSConfig) error {
 		ExcludeBuckets: c.ExcludeBuckets,
 		IncludeObjects: c.IncludeObjects,
 		ExcludeObjects: c.ExcludeObjects,
+
+		IncludePrefixes:    c.IncludePrefixes,
+		ExcludePrefixes:    c.ExcludePrefixes,
+		UserProject:        c.UserProject,
+		IncludeGenerations: c.IncludeGenerations,
 	}
 
 	// Make sure only one auth method is selected.
@@ -53,11 +59,14 @@ func (e *Engine) ScanGCS(ctx context.Context, c sources.GCSConfig) error {
 	if err = source.Init(ctx, "trufflehog - GCS", 0, 0, true, &conn, int(c.Concurrency)); err != nil {
 		return fmt.Errorf("failed to initialize GCS source: %w", err)
 	}
+	source.WithFilter(c.Filter)
 
 	e.sourcesWg.Add(1)
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, source.Type().String())
+		defer span.End()
 		if err := source.Chunks(ctx, e.ChunksChan()); err != nil {
 			ctx.Logger().Error(err, "could not scan GCS")
 		}
@@ -3,15 +3,21 @@ package engine
 import (
 	"fmt"
 	"runtime"
+	"strings"
+	"sync"
+	"time"
 
 	gogit "github.com/go-git/go-git/v5"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/codeowners"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanGit scans any git source.
@@ -39,10 +45,22 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.GitConfig) error {
 	if c.ExcludeGlobs != nil {
 		opts = append(opts, git.ScanOptionExcludeGlobs(c.ExcludeGlobs))
 	}
+	opts = append(opts,
+		git.ScanOptionNotes(c.IncludeNotes),
+		git.ScanOptionStash(c.IncludeStash),
+		git.ScanOptionReflog(c.IncludeReflog),
+		git.ScanOptionLFS(c.IncludeLFS, c.MaxLFSObjectSize),
+	)
+	if c.SubmoduleDepth > 0 {
+		opts = append(opts, git.ScanOptionSubmodules(c.SubmoduleDepth, c.SubmoduleAllowlist))
+	}
 	scanOptions := git.NewScanOptions(opts...)
 
 	gitSource := git.NewGit(sourcespb.SourceType_SOURCE_TYPE_GIT, 0, 0, "trufflehog - git", true, runtime.NumCPU(),
 		func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			if c.SecretAge || c.Owners {
+				e.gitRepos.LoadOrStore(repository, &gitRepo{path: c.RepoPath, secretAge: c.SecretAge, owners: c.Owners})
+			}
 			return &source_metadatapb.MetaData{
 				Data: &source_metadatapb.MetaData_Git{
 					Git: &source_metadatapb.Git{
@@ -65,10 +83,102 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.GitConfig) error {
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
-		err := gitSource.ScanRepo(ctx, repo, c.RepoPath, scanOptions, e.ChunksChan())
+		ctx, span := tracing.StartSource(ctx, sourcespb.SourceType_SOURCE_TYPE_GIT.String())
+		defer span.End()
+		var err error
+		switch {
+		case c.StagedOnly:
+			err = gitSource.ScanStaged(ctx, repo, c.RepoPath, scanOptions, e.ChunksChan())
+		case len(c.PushedRevisions) > 0:
+			err = gitSource.ScanPush(ctx, repo, c.RepoPath, c.PushedRevisions, scanOptions, e.ChunksChan())
+		default:
+			err = gitSource.ScanRepo(ctx, repo, c.RepoPath, scanOptions, e.ChunksChan())
+		}
 		if err != nil {
 			ctx.Logger().Error(err, "could not scan repo")
 		}
 	}()
 	return nil
 }
+
+// gitRepo is a repo whose findings are enriched with the ages of their
+// secrets or the owners of their files.
+type gitRepo struct {
+	path      string
+	secretAge bool
+	owners    bool
+
+	// codeowners is the CODEOWNERS file of the repo, read once.
+	codeownersOnce sync.Once
+	codeowners     *codeowners.File
+}
+
+// enrichGitResults adds what the repo was scanned for to the results found in
+// one of its files.
+func (e *Engine) enrichGitResults(ctx context.Context, repo *gitRepo, file string, results []detectors.Result) {
+	if repo.secretAge {
+		e.addSecretAge(ctx, repo.path, results)
+	}
+	if repo.owners {
+		addOwners(ctx, repo, file, results)
+	}
+}
+
+// addOwners adds the owners of the file from the CODEOWNERS file of the repo
+// at HEAD to the results found in it.
+func addOwners(ctx context.Context, repo *gitRepo, file string, results []detectors.Result) {
+	repo.codeownersOnce.Do(func() {
+		f, err := codeowners.Find(func(name string) ([]byte, error) {
+			return git.ReadFileAtHead(ctx, repo.path, name)
+		})
+		if err != nil {
+			ctx.Logger().V(2).Info("could not read the owners of the repo", "path", repo.path, "error", err)
+			return
+		}
+		repo.codeowners = f
+	})
+	if repo.codeowners == nil {
+		return
+	}
+	owners := repo.codeowners.Owners(file)
+	if len(owners) == 0 {
+		return
+	}
+	for i := range results {
+		if results[i].ExtraData == nil {
+			results[i].ExtraData = map[string]string{}
+		}
+		results[i].ExtraData["owners"] = strings.Join(owners, ",")
+	}
+}
+
+// secretAge is the age of a secret in a repo, which is only looked up once
+// however often the secret is found.
+type secretAge struct {
+	once sync.Once
+	age  git.SecretAge
+	err  error
+}
+
+// addSecretAge adds to the results found in a repo scanned with SecretAge
+// when their secrets were first added, and whether HEAD still has them.
+func (e *Engine) addSecretAge(ctx context.Context, repoPath string, results []detectors.Result) {
+	for i := range results {
+		secret := string(results[i].Raw)
+		v, _ := e.secretAges.LoadOrStore(repoPath+"\x00"+secret, &secretAge{})
+		a := v.(*secretAge)
+		a.once.Do(func() {
+			a.age, a.err = git.FindSecretAge(ctx, repoPath, secret)
+		})
+		if a.err != nil {
+			ctx.Logger().V(2).Info("could not find the age of the secret", "detector", results[i].DetectorType.String(), "error", a.err)
+			continue
+		}
+		if results[i].ExtraData == nil {
+			results[i].ExtraData = map[string]string{}
+		}
+		for k, v := range a.age.ExtraData(time.Now()) {
+			results[i].ExtraData[k] = v
+		}
+	}
+}
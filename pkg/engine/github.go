@@ -1,16 +1,21 @@
 package engine
 
 import (
+	"time"
+
 	gogit "github.com/go-git/go-git/v5"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/github"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanGitHub scans Github with the provided options.
@@ -18,14 +23,31 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.GithubConfig) error {
 	source := github.Source{}
 
 	connection := sourcespb.GitHub{
-		Endpoint:      c.Endpoint,
-		Organizations: c.Orgs,
-		Repositories:  c.Repos,
-		ScanUsers:     c.IncludeMembers,
-		IgnoreRepos:   c.ExcludeRepos,
-		IncludeRepos:  c.IncludeRepos,
+		Endpoint:           c.Endpoint,
+		Organizations:      c.Orgs,
+		Repositories:       c.Repos,
+		ScanUsers:          c.IncludeMembers,
+		IgnoreRepos:        c.ExcludeRepos,
+		IncludeRepos:       c.IncludeRepos,
+		IncludeGists:       c.IncludeGists,
+		GistUsers:          c.GistUsers,
+		IncludeComments:    c.IncludeComments,
+		IncludeWikis:       c.IncludeWikis,
+		IncludeReleases:    c.IncludeReleases,
+		IncludeActionsLogs: c.IncludeActionsLogs,
+	}
+	if c.ActionsLogsWindow > 0 {
+		connection.ActionsLogsSince = timestamppb.New(time.Now().Add(-c.ActionsLogsWindow))
 	}
-	if len(c.Token) > 0 {
+	if len(c.AppID) > 0 {
+		connection.Credential = &sourcespb.GitHub_GithubApp{
+			GithubApp: &credentialspb.GitHubApp{
+				AppId:          c.AppID,
+				PrivateKey:     c.AppPrivateKey,
+				InstallationId: c.AppInstallationID,
+			},
+		}
+	} else if len(c.Token) > 0 {
 		connection.Credential = &sourcespb.GitHub_Token{
 			Token: c.Token,
 		}
@@ -55,6 +77,9 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.GithubConfig) error {
 		git.ScanOptionFilter(c.Filter),
 		git.ScanOptionLogOptions(logOptions),
 	}
+	if c.SubmoduleDepth > 0 {
+		opts = append(opts, git.ScanOptionSubmodules(c.SubmoduleDepth, c.SubmoduleAllowlist))
+	}
 	scanOptions := git.NewScanOptions(opts...)
 	source.WithScanOptions(scanOptions)
 
@@ -62,6 +87,8 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.GithubConfig) error {
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, source.Type().String())
+		defer span.End()
 		err := source.Chunks(ctx, e.ChunksChan())
 		if err != nil {
 			ctx.Logger().Error(err, "could not scan github")
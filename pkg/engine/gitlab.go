@@ -3,11 +3,13 @@ package engine
 import (
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/go-errors/errors"
 	gogit "github.com/go-git/go-git/v5"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -15,6 +17,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gitlab"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanGitLab scans GitLab with the provided configuration.
@@ -24,6 +27,9 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.GitlabConfig) error {
 		git.ScanOptionFilter(c.Filter),
 		git.ScanOptionLogOptions(logOptions),
 	}
+	if c.SubmoduleDepth > 0 {
+		opts = append(opts, git.ScanOptionSubmodules(c.SubmoduleDepth, c.SubmoduleAllowlist))
+	}
 	scanOptions := git.NewScanOptions(opts...)
 
 	connection := &sourcespb.GitLab{}
@@ -44,6 +50,21 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.GitlabConfig) error {
 	if len(c.Repos) > 0 {
 		connection.Repositories = c.Repos
 	}
+	connection.IncludeSnippets = c.IncludeSnippets
+	connection.IncludeComments = c.IncludeComments
+	connection.CommentProjects = c.CommentProjects
+	connection.IncludeCiVariables = c.IncludeCIVariables
+	connection.IncludeJobLogs = c.IncludeJobLogs
+	connection.IncludeJobArtifacts = c.IncludeJobArtifacts
+	if c.JobLogsWindow > 0 {
+		connection.JobLogsSince = timestamppb.New(time.Now().Add(-c.JobLogsWindow))
+	}
+	connection.IncludeGroups = c.IncludeGroups
+	connection.IgnoreGroups = c.IgnoreGroups
+	connection.IncludeRepos = c.IncludeRepos
+	connection.IgnoreRepos = c.IgnoreRepos
+	connection.SkipArchived = c.SkipArchived
+	connection.SkipForks = c.SkipForks
 
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -67,6 +88,8 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.GitlabConfig) error {
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, gitlabSource.Type().String())
+		defer span.End()
 		err := gitlabSource.Chunks(ctx, e.ChunksChan())
 		if err != nil {
 			ctx.Logger().Error(err, "error scanning GitLab")
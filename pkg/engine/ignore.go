@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"sync/atomic"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+// WithIgnoreRules drops the results that match any of the rules, before
+// they're verified by custom verifiers, revoked or enriched.
+func WithIgnoreRules(rules ...config.IgnoreRule) EngineOption {
+	return func(e *Engine) {
+		e.ignoreRules = append(e.ignoreRules, rules...)
+	}
+}
+
+// IgnoredResults returns the number of results that the ignore rules
+// dropped.
+func (e *Engine) IgnoredResults() uint64 {
+	return atomic.LoadUint64(&e.ignoredResults)
+}
+
+// dropIgnored returns the results of the chunk that no ignore rule matches.
+func (e *Engine) dropIgnored(chunk detectableChunk, results []detectors.Result) []detectors.Result {
+	if len(e.ignoreRules) == 0 || len(results) == 0 {
+		return results
+	}
+	path := metadataFile(chunk.chunk.SourceMetadata)
+	kept := results[:0]
+	for _, result := range results {
+		detector := detectorOf(chunk.detector, result)
+		if e.ignored(detector, result, path) {
+			atomic.AddUint64(&e.ignoredResults, 1)
+			continue
+		}
+		kept = append(kept, result)
+	}
+	return kept
+}
+
+func (e *Engine) ignored(detector detectors.Detector, result detectors.Result, path string) bool {
+	for _, rule := range e.ignoreRules {
+		if rule.Matches(detector, result, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataFileFields are the fields of source metadata messages that hold
+// the path of the file a chunk comes from.
+var metadataFileFields = []protoreflect.Name{"file", "filename"}
+
+// metadataFile returns the path of the file of a chunk, or an empty string
+// if its source doesn't have files.
+func metadataFile(md *source_metadatapb.MetaData) string {
+	if md == nil {
+		return ""
+	}
+	m := md.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("data"))
+	if field == nil {
+		return ""
+	}
+	data := m.Get(field).Message()
+	for _, name := range metadataFileFields {
+		f := data.Descriptor().Fields().ByName(name)
+		if f == nil || f.Kind() != protoreflect.StringKind {
+			continue
+		}
+		if path := data.Get(f).String(); path != "" {
+			return path
+		}
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+func TestEngineIgnoreRules(t *testing.T) {
+	ctx := logContext.Background()
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(false, &fakeDetector{}),
+		WithIgnoreRules(config.IgnoreRule{Secrets: []*regexp.Regexp{regexp.MustCompile("^secret$")}}),
+	)
+	e.ScanSource(ctx, fakeSource{"fake secret", "fake secret"})
+
+	found := 0
+	err := e.Collect(ctx, func(detectors.ResultWithMetadata) error {
+		found++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, found)
+	assert.Equal(t, uint64(2), e.IgnoredResults())
+}
+
+func TestMetadataFile(t *testing.T) {
+	tests := map[string]struct {
+		metadata *source_metadatapb.MetaData
+		want     string
+	}{
+		"git": {
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Git{Git: &source_metadatapb.Git{File: "testdata/keys"}}},
+			want:     "testdata/keys",
+		},
+		"filesystem": {
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Filesystem{Filesystem: &source_metadatapb.Filesystem{File: "/src/.env"}}},
+			want:     "/src/.env",
+		},
+		"syslog": {
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Syslog{Syslog: &source_metadatapb.Syslog{Hostname: "host"}}},
+		},
+		"no metadata": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, metadataFile(tt.metadata))
+		})
+	}
+}
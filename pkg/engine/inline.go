@@ -0,0 +1,149 @@
+package engine
+
+import (
+	"bytes"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+)
+
+// inlineIgnoreMarker marks the comments that ignore the secrets on their line
+// or the next one, e.g.:
+//
+//	# trufflehog:ignore detector=aws expires=2025-12-31
+const inlineIgnoreMarker = "trufflehog:ignore"
+
+// WithInlineIgnores drops the results of git and filesystem sources that are
+// annotated with trufflehog:ignore comments.
+func WithInlineIgnores(enabled bool) EngineOption {
+	return func(e *Engine) {
+		e.inlineIgnores = enabled
+	}
+}
+
+// InlineIgnoredResults returns the number of results that trufflehog:ignore
+// comments dropped.
+func (e *Engine) InlineIgnoredResults() uint64 {
+	return atomic.LoadUint64(&e.inlineIgnoredResults)
+}
+
+// supportsInlineIgnores reports whether the chunks of a source keep the lines
+// of the files they come from, so that comments can be found next to
+// secrets.
+func supportsInlineIgnores(sourceType sourcespb.SourceType) bool {
+	return sourceType == sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM || SupportsLineNumbers(sourceType)
+}
+
+// dropInlineIgnored returns the results of the chunk that aren't annotated
+// with a trufflehog:ignore comment.
+func (e *Engine) dropInlineIgnored(chunk detectableChunk, results []detectors.Result) []detectors.Result {
+	if !e.inlineIgnores || len(results) == 0 || !supportsInlineIgnores(chunk.chunk.SourceType) {
+		return results
+	}
+	data := chunk.decoded.Data
+	if !bytes.Contains(data, []byte(inlineIgnoreMarker)) {
+		return results
+	}
+	lines := bytes.Split(data, []byte("\n"))
+	now := time.Now()
+	kept := results[:0]
+	for _, result := range results {
+		if inlineIgnored(lines, detectorOf(chunk.detector, result), result, now) {
+			atomic.AddUint64(&e.inlineIgnoredResults, 1)
+			continue
+		}
+		kept = append(kept, result)
+	}
+	return kept
+}
+
+// inlineIgnored reports whether every line with the result's secret has a
+// trufflehog:ignore comment that applies to it, on the line itself or the one
+// before.
+func inlineIgnored(lines [][]byte, detector detectors.Detector, result detectors.Result, now time.Time) bool {
+	if len(result.Raw) == 0 {
+		return false
+	}
+	found := false
+	for i, line := range lines {
+		if !bytes.Contains(line, result.Raw) {
+			continue
+		}
+		found = true
+		ignored := false
+		for j := i; j >= 0 && j >= i-1; j-- {
+			if c, ok := parseInlineIgnore(lines[j]); ok && c.applies(detector, result, now) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			return false
+		}
+	}
+	return found
+}
+
+// inlineIgnore is a trufflehog:ignore comment. Its detectors option limits it
+// to some detectors, and its expires option to the secrets found until the
+// end of a day.
+type inlineIgnore struct {
+	detectors []string
+	expires   time.Time
+}
+
+// parseInlineIgnore parses the trufflehog:ignore comment of a line, if it has
+// one. Comments with invalid options are ignored, so the secrets they
+// annotate are still reported.
+func parseInlineIgnore(line []byte) (inlineIgnore, bool) {
+	i := bytes.Index(line, []byte(inlineIgnoreMarker))
+	if i < 0 {
+		return inlineIgnore{}, false
+	}
+	var c inlineIgnore
+	for _, field := range strings.Fields(string(line[i+len(inlineIgnoreMarker):])) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(key) {
+		case "detector", "detectors":
+			c.detectors = append(c.detectors, strings.Split(value, ",")...)
+		case "expires":
+			expires, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return inlineIgnore{}, false
+			}
+			c.expires = expires.AddDate(0, 0, 1)
+		}
+	}
+	return c, true
+}
+
+func (c inlineIgnore) applies(detector detectors.Detector, result detectors.Result, now time.Time) bool {
+	if !c.expires.IsZero() && !now.Before(c.expires) {
+		return false
+	}
+	if len(c.detectors) == 0 {
+		return true
+	}
+	for _, name := range c.detectors {
+		if result.DetectorName != "" && strings.EqualFold(name, result.DetectorName) {
+			return true
+		}
+		ids, err := config.ParseDetectors(name)
+		if err != nil {
+			continue
+		}
+		for _, id := range ids {
+			if id.Matches(detector) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// ResultProcessor processes a result before the engine emits it, e.g. to
+// add to its extra data or redact it. It returns false to drop the result.
+// Processors are called concurrently for different results.
+type ResultProcessor func(ctx context.Context, result *detectors.ResultWithMetadata) bool
+
+// WithResultProcessors adds processors that run in order on each result the
+// engine emits, after the results of unselected categories are left out. A
+// result that a processor drops isn't passed to the next ones.
+func WithResultProcessors(processors ...ResultProcessor) EngineOption {
+	return func(e *Engine) {
+		e.resultProcessors = append(e.resultProcessors, processors...)
+	}
+}
+
+// processResult runs the result processors on a result, and reports whether
+// it's kept.
+func (e *Engine) processResult(ctx context.Context, result *detectors.ResultWithMetadata) bool {
+	for _, process := range e.resultProcessors {
+		if !process(ctx, result) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// Results selects the categories of results the engine emits.
+type Results struct {
+	Verified   bool
+	Unverified bool
+	// Unknown are the results whose verification failed with an error, so
+	// whether their secrets are valid is unknown.
+	Unknown bool
+	// FilteredUnverified are the results that weren't verified and look like
+	// known false positives, e.g. secrets containing "example" or the sample
+	// keys of providers' documentation.
+	FilteredUnverified bool
+	// Canary are the results whose secrets are known canary tokens, decoys
+	// that alert whoever planted them once they're used.
+	Canary bool
+}
+
+// DefaultResults are the categories of results the CLI outputs unless others
+// are selected, which is all of them.
+const DefaultResults = "verified,unverified,unknown,filtered_unverified,canary"
+
+// resultTypes are the names of the categories of results.
+var resultTypes = map[string]func(*Results) *bool{
+	"verified":            func(r *Results) *bool { return &r.Verified },
+	"unverified":          func(r *Results) *bool { return &r.Unverified },
+	"unknown":             func(r *Results) *bool { return &r.Unknown },
+	"filtered_unverified": func(r *Results) *bool { return &r.FilteredUnverified },
+	"canary":              func(r *Results) *bool { return &r.Canary },
+}
+
+// ParseResults parses a comma separated list of categories of results:
+// verified, unverified, unknown, filtered_unverified and canary.
+func ParseResults(input string) (Results, error) {
+	var r Results
+	for _, name := range strings.Split(input, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		field, ok := resultTypes[name]
+		if !ok {
+			return Results{}, fmt.Errorf("invalid result type %q, expected verified, unverified, unknown, filtered_unverified or canary", name)
+		}
+		*field(&r) = true
+	}
+	if r == (Results{}) {
+		return Results{}, fmt.Errorf("no result types selected")
+	}
+	return r, nil
+}
+
+// WithResults selects the categories of results the engine emits. Every
+// result is emitted by default.
+func WithResults(results Results) EngineOption {
+	return func(e *Engine) {
+		e.resultTypes = &results
+	}
+}
+
+// keep reports whether the category of a result, found in a file or an empty
+// file if it wasn't found in one, is selected.
+func (r Results) keep(result detectors.Result, file string) bool {
+	switch {
+	case result.Canary:
+		return r.Canary
+	case result.Verified:
+		return r.Verified
+	case detectors.IsSampleResult(result, file):
+		return r.FilteredUnverified
+	case result.VerificationError() != nil:
+		return r.Unknown
+	default:
+		return r.Unverified
+	}
+}
@@ -0,0 +1,136 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestParseResults(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Results
+		wantErr bool
+	}{
+		{input: "verified", want: Results{Verified: true}},
+		{input: "verified, Unknown", want: Results{Verified: true, Unknown: true}},
+		{input: "unverified,filtered_unverified", want: Results{Unverified: true, FilteredUnverified: true}},
+		{input: "verified,canary", want: Results{Verified: true, Canary: true}},
+		{input: DefaultResults, want: Results{Verified: true, Unverified: true, Unknown: true, FilteredUnverified: true, Canary: true}},
+		{input: "", wantErr: true},
+		{input: "verified,false_positive", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseResults(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResultsKeep(t *testing.T) {
+	unknown := detectors.Result{Raw: []byte("AKIA1234")}
+	unknown.SetVerificationError(errors.New("timeout"))
+	tests := []struct {
+		name   string
+		result detectors.Result
+		file   string
+		keep   Results
+	}{
+		{name: "verified", result: detectors.Result{Raw: []byte("AKIA1234"), Verified: true}, keep: Results{Verified: true}},
+		{name: "verified example", result: detectors.Result{Raw: []byte("AKIAEXAMPLE"), Verified: true}, keep: Results{Verified: true}},
+		{name: "unverified", result: detectors.Result{Raw: []byte("AKIA1234")}, keep: Results{Unverified: true}},
+		{name: "unknown", result: unknown, keep: Results{Unknown: true}},
+		{name: "false positive", result: detectors.Result{Raw: []byte("AKIAEXAMPLE")}, keep: Results{FilteredUnverified: true}},
+		{name: "documented sample", result: detectors.Result{Raw: []byte("sk_test_4eC39HqLyjWDarjtT1zdp7dc")}, keep: Results{FilteredUnverified: true}},
+		{name: "test secret", result: detectors.Result{Raw: []byte("sk_test_Q1w2E3r4T5y6U7i8O9p0")}, keep: Results{Unverified: true}},
+		{name: "test secret in fixtures", result: detectors.Result{Raw: []byte("sk_test_Q1w2E3r4T5y6U7i8O9p0")}, file: "spec/fixtures/stripe.yml", keep: Results{FilteredUnverified: true}},
+		{name: "canary", result: detectors.Result{Raw: []byte("AKIA1234"), Canary: true}, keep: Results{Canary: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.keep.keep(tt.result, tt.file))
+			all := Results{Verified: true, Unverified: true, Unknown: true, FilteredUnverified: true, Canary: true}
+			assert.False(t, Results{
+				Verified:           all.Verified != tt.keep.Verified,
+				Unverified:         all.Unverified != tt.keep.Unverified,
+				Unknown:            all.Unknown != tt.keep.Unknown,
+				FilteredUnverified: all.FilteredUnverified != tt.keep.FilteredUnverified,
+				Canary:             all.Canary != tt.keep.Canary,
+			}.keep(tt.result, tt.file))
+		})
+	}
+}
+
+// requestDetector finds the string "secret" and verifies it with a request to
+// url.
+type requestDetector struct {
+	url string
+}
+
+func (d requestDetector) FromData(ctx context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	result := detectors.Result{DetectorType: detectorspb.DetectorType_CustomRegex, Raw: []byte("secret")}
+	if verify {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res, err := common.SaneHttpClient().Do(req); err == nil {
+			res.Body.Close()
+			result.Verified = res.StatusCode == http.StatusOK
+		}
+	}
+	return []detectors.Result{result}, nil
+}
+
+func (requestDetector) Keywords() []string { return []string{"secret"} }
+
+func (requestDetector) Type() detectorspb.DetectorType { return detectorspb.DetectorType_CustomRegex }
+
+func TestEngineUnknownResults(t *testing.T) {
+	ctx := logContext.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		url         string
+		wantUnknown bool
+	}{
+		{name: "invalid secret", url: server.URL + "/user"},
+		{name: "provider error", url: server.URL + "/down", wantUnknown: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Start(ctx,
+				WithConcurrency(1),
+				WithDetectors(true, requestDetector{url: tt.url}),
+			)
+			results := scanData(ctx, e, "secret")
+
+			assert.Len(t, results, 1)
+			assert.False(t, results[0].Verified)
+			assert.Equal(t, tt.wantUnknown, results[0].VerificationError() != nil)
+		})
+	}
+}
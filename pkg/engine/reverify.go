@@ -0,0 +1,119 @@
+package engine
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// Reverify re-runs verification for findings from a previous scan, e.g. to
+// confirm that secrets were rotated, and sends the updated findings to the
+// results channel. No source is scanned again, the detectors run against the
+// chunk data recorded with each finding. Findings are matched to the engine's
+// verifying detectors by type and reported with their original source
+// metadata. The findings that can't be verified again are counted by
+// UnreverifiedFindings instead.
+func (e *Engine) Reverify(ctx context.Context, findings []detectors.ResultWithMetadata) {
+	queue := make(chan detectors.ResultWithMetadata)
+	var wg sync.WaitGroup
+	for i := 0; i < e.verificationConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer common.RecoverWithExit(ctx)
+			defer wg.Done()
+			for finding := range queue {
+				e.reverify(ctx, finding)
+			}
+		}()
+	}
+
+	e.sourcesWg.Add(1)
+	go func() {
+		defer common.RecoverWithExit(ctx)
+		defer e.sourcesWg.Done()
+		for _, finding := range findings {
+			queue <- finding
+		}
+		close(queue)
+		wg.Wait()
+	}()
+}
+
+// reverify verifies a single finding with each matching detector until one
+// of them reproduces it from the data of the chunk it was found in.
+func (e *Engine) reverify(ctx context.Context, finding detectors.ResultWithMetadata) {
+	if len(finding.ChunkData) == 0 {
+		e.skipReverification(ctx, finding, "finding has no chunk data")
+		return
+	}
+
+	found := false
+	for _, detector := range e.detectors[true] {
+		if detector.Type() != finding.DetectorType {
+			continue
+		}
+		found = true
+
+		detectable := detectableChunk{
+			chunk:       &sources.Chunk{},
+			decoded:     &sources.Chunk{Data: finding.ChunkData},
+			decoderType: finding.DecoderType,
+			detector:    detector,
+		}
+		verifier, hasVerifier := e.verifiers[detector.Type()]
+		results, err := e.fromData(ctx, detectable, !(hasVerifier && verifier.override))
+		if err != nil {
+			ctx.Logger().Error(err, "could not reverify finding", "detector", finding.DetectorType.String())
+			continue
+		}
+		if hasVerifier {
+			e.customVerify(ctx, verifier, results)
+		}
+
+		for _, result := range results {
+			if !bytes.Equal(result.Raw, finding.Raw) {
+				continue
+			}
+			if len(finding.RawV2) > 0 && !bytes.Equal(result.RawV2, finding.RawV2) {
+				continue
+			}
+			finding.Verified = result.Verified
+			finding.Canary = result.Canary
+			finding.ExtraData = result.ExtraData
+			finding.TypedExtraData = result.TypedExtraData
+			finding.StructuredData = result.StructuredData
+			if e.processResult(ctx, &finding) {
+				e.results <- finding
+			}
+			return
+		}
+	}
+
+	if !found {
+		e.skipReverification(ctx, finding, "no verifying detector")
+		return
+	}
+	e.skipReverification(ctx, finding, "could not reproduce finding")
+}
+
+// skipReverification counts a finding that couldn't be verified again, so its
+// previous verification result stands.
+func (e *Engine) skipReverification(ctx context.Context, finding detectors.ResultWithMetadata, reason string) {
+	atomic.AddUint64(&e.unreverifiedFindings, 1)
+	ctx.Logger().Info("could not reverify finding",
+		"reason", reason,
+		"detector", finding.DetectorType.String(),
+		"redacted", finding.Redacted,
+	)
+}
+
+// UnreverifiedFindings returns the number of findings Reverify couldn't
+// verify again, because no detector reproduced them from their chunk data.
+func (e *Engine) UnreverifiedFindings() uint64 {
+	return atomic.LoadUint64(&e.unreverifiedFindings)
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"sync/atomic"
+	"testing"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+func TestEngineReverify(t *testing.T) {
+	ctx := logContext.Background()
+	detector := &fakeDetector{}
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(true, detector),
+	)
+
+	metadata := &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Filesystem{
+			Filesystem: &source_metadatapb.Filesystem{File: "config.yml"},
+		},
+	}
+	e.Reverify(ctx, []detectors.ResultWithMetadata{
+		{
+			SourceMetadata: metadata,
+			ChunkData:      []byte("fake secret"),
+			Result:         detectors.Result{DetectorType: detectorspb.DetectorType_CustomRegex, Raw: []byte("secret")},
+		},
+		{
+			// Not reproduced by the detector.
+			ChunkData: []byte("fake but nothing to find"),
+			Result:    detectors.Result{DetectorType: detectorspb.DetectorType_CustomRegex, Raw: []byte("other")},
+		},
+		{
+			// No detector of this type.
+			ChunkData: []byte("fake secret"),
+			Result:    detectors.Result{DetectorType: detectorspb.DetectorType_AWS, Raw: []byte("secret")},
+		},
+		{
+			// No chunk data to scan.
+			Result: detectors.Result{DetectorType: detectorspb.DetectorType_CustomRegex, Raw: []byte("secret")},
+		},
+	})
+	go e.Finish(ctx)
+
+	var results []detectors.ResultWithMetadata
+	for r := range e.ResultsChan() {
+		results = append(results, r)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if !results[0].Verified {
+		t.Errorf("expected result to be verified")
+	}
+	if results[0].SourceMetadata != metadata {
+		t.Errorf("expected the original source metadata")
+	}
+	if got := atomic.LoadInt32(&detector.verifications); got != 1 {
+		t.Errorf("expected 1 verification, got %d", got)
+	}
+	if got := e.UnreverifiedFindings(); got != 3 {
+		t.Errorf("expected 3 unreverified findings, got %d", got)
+	}
+}
@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// errNoRevoker is recorded for the results of detectors that can't revoke
+// their secrets.
+var errNoRevoker = errors.New("the detector can't revoke secrets")
+
+// revocation is the outcome of revoking a secret, which is only tried once
+// however often the secret is found.
+type revocation struct {
+	once sync.Once
+	err  error
+}
+
+// WithRevocation revokes the verified secrets found by the detectors that
+// the filter returns true for, with the provider's API. The outcome is
+// recorded in the results. Revoking can't be undone, so callers should have
+// the user confirm it.
+func WithRevocation(filterFunc func(detectors.Detector) bool) EngineOption {
+	return func(e *Engine) {
+		e.revokeFilter = filterFunc
+	}
+}
+
+// revoke revokes the secrets of the verified results of a detector selected
+// for revocation. A secret revoked earlier in the scan no longer verifies, so
+// its results are reported verified with the earlier outcome.
+func (e *Engine) revoke(ctx context.Context, detector detectors.Detector, results []detectors.Result) {
+	for i := range results {
+		d := detectorOf(detector, results[i])
+		if !e.revokeFilter(d) {
+			continue
+		}
+
+		key := results[i].DetectorType.String() + "/" + string(results[i].Raw) + string(results[i].RawV2)
+		if !results[i].Verified {
+			if v, ok := e.revocations.Load(key); ok && v.(*revocation).err == nil {
+				results[i].Verified = true
+				results[i].SetRevocation(nil)
+			}
+			continue
+		}
+
+		v, _ := e.revocations.LoadOrStore(key, &revocation{})
+		r := v.(*revocation)
+		r.once.Do(func() {
+			revoker, ok := d.(detectors.Revoker)
+			if !ok {
+				r.err = errNoRevoker
+				return
+			}
+			r.err = func() error {
+				ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+				defer cancel()
+				defer common.Recover(ctx)
+				return revoker.Revoke(ctx, results[i])
+			}()
+			if r.err != nil {
+				ctx.Logger().Error(r.err, "could not revoke secret", "detector", results[i].DetectorType.String())
+				return
+			}
+			ctx.Logger().Info("revoked secret", "detector", results[i].DetectorType.String())
+		})
+		results[i].SetRevocation(r.err)
+	}
+}
@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// revokingDetector is a fakeDetector that counts how often it's asked to
+// revoke a secret.
+type revokingDetector struct {
+	fakeDetector
+	revocations int32
+	err         error
+}
+
+func (d *revokingDetector) Revoke(_ context.Context, result detectors.Result) error {
+	atomic.AddInt32(&d.revocations, 1)
+	return d.err
+}
+
+func TestEngineRevocation(t *testing.T) {
+	ctx := logContext.Background()
+
+	tests := []struct {
+		name            string
+		detector        detectors.Detector
+		filter          func(detectors.Detector) bool
+		wantRevocations int32
+		wantTried       bool
+		wantErr         error
+	}{
+		{
+			name:            "revoked once",
+			detector:        &revokingDetector{},
+			filter:          func(detectors.Detector) bool { return true },
+			wantRevocations: 1,
+			wantTried:       true,
+		},
+		{
+			name:            "revocation failed",
+			detector:        &revokingDetector{err: errors.New("forbidden")},
+			filter:          func(detectors.Detector) bool { return true },
+			wantRevocations: 1,
+			wantTried:       true,
+			wantErr:         errors.New("forbidden"),
+		},
+		{
+			name:     "detector not selected",
+			detector: &revokingDetector{},
+			filter:   func(detectors.Detector) bool { return false },
+		},
+		{
+			name:      "detector can't revoke",
+			detector:  &fakeDetector{},
+			filter:    func(detectors.Detector) bool { return true },
+			wantTried: true,
+			wantErr:   errNoRevoker,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Start(ctx,
+				WithConcurrency(1),
+				WithDetectors(true, tt.detector),
+				WithRevocation(tt.filter),
+			)
+			results := scanData(ctx, e, "fake secret", "fake secret")
+
+			if len(results) != 2 {
+				t.Fatalf("expected 2 results, got %d", len(results))
+			}
+			for _, r := range results {
+				tried, err := r.Revocation()
+				if tried != tt.wantTried {
+					t.Errorf("revocation tried: got %t, want %t", tried, tt.wantTried)
+				}
+				if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+					t.Errorf("revocation error: got %v, want %v", err, tt.wantErr)
+				}
+			}
+			if d, ok := tt.detector.(*revokingDetector); ok {
+				if got := atomic.LoadInt32(&d.revocations); got != tt.wantRevocations {
+					t.Errorf("revocations: got %d, want %d", got, tt.wantRevocations)
+				}
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/s3"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanS3 scans S3 buckets.
@@ -38,6 +39,15 @@ func (e *Engine) ScanS3(ctx context.Context, c sources.S3Config) error {
 	if len(c.Buckets) > 0 {
 		connection.Buckets = c.Buckets
 	}
+	connection.Roles = c.Roles
+	connection.ExternalId = c.ExternalID
+	connection.BucketRoles = c.BucketRoles
+	connection.IncludePrefixes = c.IncludePrefixes
+	connection.ExcludePrefixes = c.ExcludePrefixes
+	connection.IncludeSuffixes = c.IncludeSuffixes
+	connection.ExcludeSuffixes = c.ExcludeSuffixes
+	connection.MaxObjectSize = c.MaxObjectSize
+	connection.IncludeVersions = c.IncludeVersions
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
 	if err != nil {
@@ -54,11 +64,14 @@ func (e *Engine) ScanS3(ctx context.Context, c sources.S3Config) error {
 	if err != nil {
 		return errors.WrapPrefix(err, "failed to init S3 source", 0)
 	}
+	s3Source.WithFilter(c.Filter)
 
 	e.sourcesWg.Add(1)
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, s3Source.Type().String())
+		defer span.End()
 		err := s3Source.Chunks(ctx, e.ChunksChan())
 		if err != nil {
 			ctx.Logger().Error(err, "error scanning S3")
@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"sync/atomic"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// WithSampleHeuristics skips verifying the chunks whose candidates all look
+// like sample credentials, e.g. the example keys of providers' documentation
+// or placeholders in test fixtures. It applies to the detectors that verify
+// their candidates separately (detectors.Verifier), since the others verify
+// while they scan. Unverified samples are reported as filtered_unverified.
+func WithSampleHeuristics(enabled bool) EngineOption {
+	return func(e *Engine) {
+		e.sampleHeuristics = enabled
+	}
+}
+
+// UnverifiedSamples returns the number of candidates that weren't verified
+// because they look like sample credentials.
+func (e *Engine) UnverifiedSamples() uint64 {
+	return atomic.LoadUint64(&e.unverifiedSamples)
+}
+
+// skipVerification reports whether the candidates of a chunk all look like
+// sample credentials, so that verifying them would only waste requests.
+func (e *Engine) skipVerification(chunk detectableChunk, candidates []detectors.Result) bool {
+	if !e.sampleHeuristics {
+		return false
+	}
+	file := metadataFile(chunk.chunk.SourceMetadata)
+	for _, candidate := range candidates {
+		if !detectors.IsSampleResult(candidate, file) {
+			return false
+		}
+	}
+	atomic.AddUint64(&e.unverifiedSamples, uint64(len(candidates)))
+	return true
+}
@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/entropy"
+)
+
+// WithDetectorSettings tunes the detectors matching the settings. Settings are
+// applied in order when the engine starts, after the detectors are filtered,
+// by rebuilding each detector with its package's options.
+func WithDetectorSettings(settings ...config.DetectorSettings) EngineOption {
+	return func(e *Engine) {
+		e.detectorSettings = append(e.detectorSettings, settings...)
+	}
+}
+
+func (e *Engine) applyDetectorSettings(ctx context.Context) {
+	if len(e.detectorSettings) == 0 {
+		return
+	}
+
+	configured := map[bool][]detectors.Detector{true: {}, false: {}}
+	for verify, detectorsSet := range e.detectors {
+		for _, detector := range detectorsSet {
+			verifyDetector := verify
+			for _, settings := range e.detectorSettings {
+				if !settings.ID.Matches(detector) {
+					continue
+				}
+				tuned, err := applySettings(detector, settings)
+				if err != nil {
+					ctx.Logger().Error(err, "could not apply detector settings", "detector", settings.ID)
+					continue
+				}
+				detector = tuned
+				if len(settings.Keywords) > 0 {
+					if e.extraKeywords == nil {
+						e.extraKeywords = make(map[config.DetectorID][]string)
+					}
+					id := detectorID(detector)
+					e.extraKeywords[id] = append(e.extraKeywords[id], settings.Keywords...)
+				}
+				if settings.Verify != nil {
+					verifyDetector = *settings.Verify
+				}
+			}
+			configured[verifyDetector] = append(configured[verifyDetector], detector)
+		}
+	}
+	e.detectors = configured
+}
+
+// applySettings rebuilds the detector with the verifier URLs and entropy
+// thresholds of the settings.
+func applySettings(detector detectors.Detector, settings config.DetectorSettings) (detectors.Detector, error) {
+	if len(settings.VerifierURLs) > 0 {
+		custom, ok := withVerifierURLs(detector, settings.VerifierURLs)
+		if !ok {
+			return nil, fmt.Errorf("detector %s doesn't support custom verifier URLs", settings.ID)
+		}
+		detector = custom
+	}
+
+	if len(settings.EntropyThresholds) > 0 {
+		scanner, ok := detector.(*entropy.Scanner)
+		if !ok {
+			return nil, fmt.Errorf("detector %s doesn't have entropy thresholds", settings.ID)
+		}
+		charsets, err := entropy.CharsetsWithThresholds(settings.EntropyThresholds)
+		if err != nil {
+			return nil, err
+		}
+		detector = scanner.With(entropy.WithCharsets(charsets...))
+	}
+
+	return detector, nil
+}
+
+// keywords returns the detector's keywords, and the keywords its settings
+// added.
+func (e *Engine) keywords(detector detectors.Detector) []string {
+	if len(e.extraKeywords) == 0 {
+		return detector.Keywords()
+	}
+	extra, ok := e.extraKeywords[detectorID(detector)]
+	if !ok {
+		return detector.Keywords()
+	}
+	keywords := detector.Keywords()
+	return append(keywords[:len(keywords):len(keywords)], extra...)
+}
+
+func detectorID(detector detectors.Detector) config.DetectorID {
+	id := config.DetectorID{ID: detector.Type()}
+	if versioner, ok := detector.(detectors.Versioner); ok {
+		id.Version = versioner.Version()
+	}
+	return id
+}
@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/entropy"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors/gitlabv2"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestEngineDetectorSettingsKeywords(t *testing.T) {
+	ctx := logContext.Background()
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(false, keywordlessDetector{}),
+		WithDetectorSettings(config.DetectorSettings{
+			ID:       config.DetectorID{ID: detectorspb.DetectorType_Entropy},
+			Keywords: []string{"needle"},
+		}),
+	)
+
+	results := scanData(ctx, e, "a secret", "needle secret")
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+}
+
+func TestEngineDetectorSettingsVerify(t *testing.T) {
+	ctx := logContext.Background()
+	detector := &fakeDetector{}
+	verify := false
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(true, detector),
+		WithDetectorSettings(config.DetectorSettings{
+			ID:     config.DetectorID{ID: detectorspb.DetectorType_CustomRegex},
+			Verify: &verify,
+		}),
+	)
+
+	results := scanData(ctx, e, "fake secret")
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Verified {
+		t.Errorf("expected result not to be verified")
+	}
+	if got := atomic.LoadInt32(&detector.verifications); got != 0 {
+		t.Errorf("expected no verifications, got %d", got)
+	}
+}
+
+func TestApplySettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		detector detectors.Detector
+		settings config.DetectorSettings
+		wantErr  bool
+		check    func(t *testing.T, d detectors.Detector)
+	}{
+		{
+			name:     "verifier URLs",
+			detector: &gitlabv2.Scanner{},
+			settings: config.DetectorSettings{
+				ID:           config.DetectorID{ID: detectorspb.DetectorType_Gitlab, Version: 2},
+				VerifierURLs: []string{"https://gitlab.internal.corp"},
+			},
+			check: func(t *testing.T, d detectors.Detector) {
+				if d.Type() != detectorspb.DetectorType_Gitlab || d.(detectors.Versioner).Version() != 2 {
+					t.Errorf("expected a GitLab v2 detector, got %T", d)
+				}
+			},
+		},
+		{
+			name:     "verifier URLs not supported",
+			detector: &fakeDetector{},
+			settings: config.DetectorSettings{
+				ID:           config.DetectorID{ID: detectorspb.DetectorType_CustomRegex},
+				VerifierURLs: []string{"https://verifier.internal.corp"},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "entropy thresholds",
+			detector: entropy.New(entropy.WithLength(30, 0)),
+			settings: config.DetectorSettings{
+				ID:                config.DetectorID{ID: detectorspb.DetectorType_Entropy},
+				EntropyThresholds: map[string]float64{"hex": 3.9},
+			},
+			check: func(t *testing.T, d detectors.Detector) {
+				// A 40 character hex string below the new threshold.
+				results, err := d.FromData(logContext.Background(), false, []byte("da39a3ee5e6b4b0d3255bfef95601890afd80709"))
+				if err != nil || len(results) != 0 {
+					t.Errorf("expected no results, got %v, %v", results, err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applySettings(tt.detector, tt.settings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applySettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, got)
+			}
+		})
+	}
+}
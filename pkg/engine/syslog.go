@@ -12,6 +12,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/syslog"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/tracing"
 )
 
 // ScanSyslog is a source that scans syslog files.
@@ -20,6 +21,9 @@ func (e *Engine) ScanSyslog(ctx context.Context, c sources.SyslogConfig) error {
 		Protocol:      c.Protocol,
 		ListenAddress: c.Address,
 		Format:        c.Format,
+
+		SenderRateLimit: c.SenderRateLimit,
+		SenderBurst:     int32(c.SenderBurst),
 	}
 
 	if c.CertPath != "" && c.KeyPath != "" {
@@ -36,6 +40,14 @@ func (e *Engine) ScanSyslog(ctx context.Context, c sources.SyslogConfig) error {
 		connection.TlsKey = string(key)
 	}
 
+	if c.ClientCAPath != "" {
+		clientCA, err := os.ReadFile(c.ClientCAPath)
+		if err != nil {
+			return errors.WrapPrefix(err, "could not open TLS client CA file", 0)
+		}
+		connection.TlsClientCA = string(clientCA)
+	}
+
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
 	if err != nil {
@@ -57,6 +69,8 @@ func (e *Engine) ScanSyslog(ctx context.Context, c sources.SyslogConfig) error {
 	go func() {
 		defer common.RecoverWithExit(ctx)
 		defer e.sourcesWg.Done()
+		ctx, span := tracing.StartSource(ctx, source.Type().String())
+		defer span.End()
 		err := source.Chunks(ctx, e.ChunksChan())
 		if err != nil {
 			ctx.Logger().Error(err, "could not scan syslog")
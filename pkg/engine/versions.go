@@ -0,0 +1,223 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// versionGroup runs the versions of a detector side by side, e.g. the
+// detectors for the old and new GitLab token formats. Versions often find the
+// same secret, so the group dedupes their results by secret and by where they
+// are in the data, keeping the result of the higher version.
+type versionGroup struct {
+	detectorType detectorspb.DetectorType
+	// versions are sorted from the highest version to the lowest.
+	versions []groupedVersion
+}
+
+type groupedVersion struct {
+	detector detectors.Detector
+	version  int
+	// keywords are the lowercase keywords of the version, including the
+	// keywords added by its settings.
+	keywords [][]byte
+}
+
+// Ensure the versionGroup satisfies the interface at compile time.
+var _ detectors.Detector = (*versionGroup)(nil)
+
+// groupVersions replaces the versions of each detector with a versionGroup.
+// Detectors with a single version, without a version, or with several
+// instances of the same version are left alone.
+func groupVersions(input []detectors.Detector, keywords func(detectors.Detector) []string) []detectors.Detector {
+	byType := make(map[detectorspb.DetectorType][]detectors.Detector)
+	for _, detector := range input {
+		if versioner, ok := detector.(detectors.Versioner); ok && versioner.Version() > 0 {
+			byType[detector.Type()] = append(byType[detector.Type()], detector)
+		}
+	}
+
+	groups := make(map[detectorspb.DetectorType]*versionGroup)
+	for detectorType, versions := range byType {
+		if len(versions) < 2 || !distinctVersions(versions) {
+			continue
+		}
+		group := &versionGroup{detectorType: detectorType}
+		for _, detector := range versions {
+			version := groupedVersion{
+				detector: detector,
+				version:  detector.(detectors.Versioner).Version(),
+			}
+			for _, kw := range keywords(detector) {
+				version.keywords = append(version.keywords, bytes.ToLower([]byte(kw)))
+			}
+			group.versions = append(group.versions, version)
+		}
+		sort.Slice(group.versions, func(i, j int) bool {
+			return group.versions[i].version > group.versions[j].version
+		})
+		groups[detectorType] = group
+	}
+	if len(groups) == 0 {
+		return input
+	}
+
+	output := make([]detectors.Detector, 0, len(input))
+	for _, detector := range input {
+		group, ok := groups[detector.Type()]
+		if !ok {
+			output = append(output, detector)
+			continue
+		}
+		// The group takes the place of its first version.
+		if group != nil {
+			output = append(output, group)
+			groups[detector.Type()] = nil
+		}
+	}
+	return output
+}
+
+func distinctVersions(versions []detectors.Detector) bool {
+	seen := make(map[int]struct{}, len(versions))
+	for _, detector := range versions {
+		version := detector.(detectors.Versioner).Version()
+		if _, ok := seen[version]; ok {
+			return false
+		}
+		seen[version] = struct{}{}
+	}
+	return true
+}
+
+// FromData runs the versions whose keywords are in the data concurrently, and
+// dedupes their results.
+func (g *versionGroup) FromData(ctx context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	dataLower := bytes.ToLower(data)
+	results := make([][]detectors.Result, len(g.versions))
+	var wg errgroup.Group
+	for i, version := range g.versions {
+		if !version.matches(dataLower) {
+			continue
+		}
+		i, version := i, version
+		wg.Go(func() error {
+			var err error
+			results[i], err = version.detector.FromData(ctx, verify, data)
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	var kept []detectors.Result
+	var spans []span
+	for i, version := range g.versions {
+		for _, result := range results[i] {
+			s := findSpan(data, result)
+			if duplicate(kept, spans, result, s) {
+				continue
+			}
+			if result.ExtraData == nil {
+				result.ExtraData = map[string]string{}
+			}
+			result.ExtraData["version"] = strconv.Itoa(version.version)
+			kept = append(kept, result)
+			spans = append(spans, s)
+		}
+	}
+	return kept, nil
+}
+
+func (g *versionGroup) Keywords() []string {
+	var keywords []string
+	for _, version := range g.versions {
+		for _, kw := range version.keywords {
+			keywords = append(keywords, string(kw))
+		}
+	}
+	return keywords
+}
+
+func (g *versionGroup) Type() detectorspb.DetectorType {
+	return g.detectorType
+}
+
+// detectorOf returns the version of the group that found the result.
+func (g *versionGroup) detectorOf(result detectors.Result) detectors.Detector {
+	version, _ := strconv.Atoi(result.ExtraData["version"])
+	for _, v := range g.versions {
+		if v.version == version {
+			return v.detector
+		}
+	}
+	return g
+}
+
+// matches reports whether one of the version's keywords is in the data.
+// Versions without keywords match all data.
+func (v groupedVersion) matches(dataLower []byte) bool {
+	if len(v.keywords) == 0 {
+		return true
+	}
+	for _, kw := range v.keywords {
+		if bytes.Contains(dataLower, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// span is where a secret is in the data. The span is empty if the secret
+// isn't in the data verbatim.
+type span struct {
+	start, end int
+}
+
+func findSpan(data []byte, result detectors.Result) span {
+	if len(result.Raw) == 0 {
+		return span{}
+	}
+	start := bytes.Index(data, result.Raw)
+	if start < 0 {
+		return span{}
+	}
+	return span{start: start, end: start + len(result.Raw)}
+}
+
+func (s span) overlaps(other span) bool {
+	if s.start == s.end || other.start == other.end {
+		return false
+	}
+	return s.start < other.end && other.start < s.end
+}
+
+// duplicate reports whether a result of a higher version already covers the
+// result.
+func duplicate(kept []detectors.Result, spans []span, result detectors.Result, s span) bool {
+	for i, k := range kept {
+		if bytes.Equal(k.Raw, result.Raw) && bytes.Equal(k.RawV2, result.RawV2) {
+			return true
+		}
+		if spans[i].overlaps(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectorOf returns the detector that found the result.
+func detectorOf(detector detectors.Detector, result detectors.Result) detectors.Detector {
+	if group, ok := detector.(*versionGroup); ok {
+		return group.detectorOf(result)
+	}
+	return detector
+}
@@ -0,0 +1,165 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// versionedDetector finds the given secrets in the data.
+type versionedDetector struct {
+	version  int
+	keywords []string
+	secrets  []string
+}
+
+func (d versionedDetector) FromData(_ context.Context, _ bool, data []byte) ([]detectors.Result, error) {
+	var results []detectors.Result
+	for _, secret := range d.secrets {
+		if strings.Contains(string(data), secret) {
+			results = append(results, detectors.Result{
+				DetectorType: detectorspb.DetectorType_Gitlab,
+				Raw:          []byte(secret),
+			})
+		}
+	}
+	return results, nil
+}
+
+func (d versionedDetector) Keywords() []string { return d.keywords }
+
+func (d versionedDetector) Type() detectorspb.DetectorType { return detectorspb.DetectorType_Gitlab }
+
+func (d versionedDetector) Version() int { return d.version }
+
+func noExtraKeywords(d detectors.Detector) []string { return d.Keywords() }
+
+func TestVersionGroupFromData(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []detectors.Detector
+		data     string
+		want     []detectors.Result
+	}{
+		{
+			name: "same secret",
+			versions: []detectors.Detector{
+				versionedDetector{version: 1, keywords: []string{"gitlab"}, secrets: []string{"abcd1234"}},
+				versionedDetector{version: 2, keywords: []string{"gitlab"}, secrets: []string{"abcd1234"}},
+			},
+			data: "gitlab abcd1234",
+			want: []detectors.Result{
+				{DetectorType: detectorspb.DetectorType_Gitlab, Raw: []byte("abcd1234"), ExtraData: map[string]string{"version": "2"}},
+			},
+		},
+		{
+			name: "overlapping secrets",
+			versions: []detectors.Detector{
+				versionedDetector{version: 1, keywords: []string{"gitlab"}, secrets: []string{"abcd1234"}},
+				versionedDetector{version: 2, keywords: []string{"glpat-"}, secrets: []string{"glpat-abcd1234"}},
+			},
+			data: "gitlab glpat-abcd1234",
+			want: []detectors.Result{
+				{DetectorType: detectorspb.DetectorType_Gitlab, Raw: []byte("glpat-abcd1234"), ExtraData: map[string]string{"version": "2"}},
+			},
+		},
+		{
+			name: "separate secrets",
+			versions: []detectors.Detector{
+				versionedDetector{version: 1, keywords: []string{"gitlab"}, secrets: []string{"abcd1234"}},
+				versionedDetector{version: 2, keywords: []string{"glpat-"}, secrets: []string{"glpat-efgh5678"}},
+			},
+			data: "gitlab abcd1234 glpat-efgh5678",
+			want: []detectors.Result{
+				{DetectorType: detectorspb.DetectorType_Gitlab, Raw: []byte("glpat-efgh5678"), ExtraData: map[string]string{"version": "2"}},
+				{DetectorType: detectorspb.DetectorType_Gitlab, Raw: []byte("abcd1234"), ExtraData: map[string]string{"version": "1"}},
+			},
+		},
+		{
+			name: "keywords of one version",
+			versions: []detectors.Detector{
+				versionedDetector{version: 1, keywords: []string{"gitlab"}, secrets: []string{"abcd1234"}},
+				versionedDetector{version: 2, keywords: []string{"glpat-"}, secrets: []string{"abcd1234"}},
+			},
+			data: "gitlab abcd1234",
+			want: []detectors.Result{
+				{DetectorType: detectorspb.DetectorType_Gitlab, Raw: []byte("abcd1234"), ExtraData: map[string]string{"version": "1"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grouped := groupVersions(tt.versions, noExtraKeywords)
+			if len(grouped) != 1 {
+				t.Fatalf("expected 1 detector, got %d", len(grouped))
+			}
+			results, err := grouped[0].FromData(context.Background(), false, []byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, results, cmpopts.IgnoreUnexported(detectors.Result{})); diff != "" {
+				t.Errorf("results diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupVersions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []detectors.Detector
+		want  int
+	}{
+		{
+			name:  "single version",
+			input: []detectors.Detector{versionedDetector{version: 1}, &fakeDetector{}},
+			want:  2,
+		},
+		{
+			name:  "two versions",
+			input: []detectors.Detector{versionedDetector{version: 1}, &fakeDetector{}, versionedDetector{version: 2}},
+			want:  2,
+		},
+		{
+			name:  "same version twice",
+			input: []detectors.Detector{versionedDetector{version: 1}, versionedDetector{version: 1}},
+			want:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(groupVersions(tt.input, noExtraKeywords)); got != tt.want {
+				t.Errorf("expected %d detectors, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEngineDetectorVersions(t *testing.T) {
+	ctx := logContext.Background()
+	e := Start(ctx,
+		WithConcurrency(1),
+		WithDetectors(false,
+			versionedDetector{version: 1, keywords: []string{"gitlab"}, secrets: []string{"abcd1234"}},
+			versionedDetector{version: 2, keywords: []string{"glpat-"}, secrets: []string{"glpat-abcd1234"}},
+		),
+	)
+
+	results := scanData(ctx, e, "gitlab glpat-abcd1234")
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if got := results[0].ExtraData["version"]; got != "2" {
+		t.Errorf("expected a result of version 2, got version %q", got)
+	}
+}
@@ -24,6 +24,9 @@ const (
 
 	// defaultMaxCommitSize is the maximum size for a commit. Larger commits will be cut off.
 	defaultMaxCommitSize = 1 * 1024 * 1024 * 1024 // 1GB
+
+	// emptyTreeHash is the hash of the tree without any files.
+	emptyTreeHash = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
 )
 
 // Commit contains commit header info and diffs.
@@ -139,10 +142,48 @@ func (c *Parser) RepoPath(ctx context.Context, source string, head string, abbre
 	return c.executeCommand(ctx, cmd)
 }
 
+// Revisions parses the output of the `git log` command for the `source` path,
+// limited to the given revision arguments. Merge commits are diffed against
+// their first parent, since stashes are merge commits.
+func (c *Parser) Revisions(ctx context.Context, source string, revisions ...string) (chan Commit, error) {
+	args := []string{"-C", source, "log", "-p", "-U5", "--full-history", "-m", "--first-parent", "--date=format:%a %b %d %H:%M:%S %Y %z"}
+	args = append(args, revisions...)
+
+	cmd := exec.Command("git", args...)
+	absPath, err := filepath.Abs(source)
+	if err == nil {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_DIR=%s", filepath.Join(absPath, ".git")))
+	}
+
+	return c.executeCommand(ctx, cmd)
+}
+
+// Pushed parses the output of the `git log` command for the commits that a push
+// to the `source` repository adds: those reachable from the pushed revisions
+// but not from any ref. It's meant to run in a pre-receive hook, before the refs
+// are updated. The environment isn't replaced, since git keeps the objects of
+// a push in a quarantine directory that only the hook's environment points
+// to, and the repository is usually bare.
+func (c *Parser) Pushed(ctx context.Context, source string, revisions ...string) (chan Commit, error) {
+	args := []string{"-C", source, "log", "-p", "-U5", "--full-history", "--date=format:%a %b %d %H:%M:%S %Y %z"}
+	args = append(args, revisions...)
+	args = append(args, "--not", "--all")
+
+	cmd := exec.Command("git", args...)
+	return c.executeCommand(ctx, cmd)
+}
+
 // Unstaged parses the output of the `git diff` command for the `source` path.
 func (c *Parser) Unstaged(ctx context.Context, source string) (chan Commit, error) {
+	// A repository without commits has no HEAD to diff against, so the staged
+	// files are diffed against the empty tree.
+	base := "HEAD"
+	if err := exec.Command("git", "-C", source, "rev-parse", "--verify", "--quiet", "HEAD").Run(); err != nil {
+		base = emptyTreeHash
+	}
+
 	// Provide the --cached flag to diff to get the diff of the staged changes.
-	args := []string{"-C", source, "diff", "-p", "-U5", "--cached", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z", "HEAD"}
+	args := []string{"-C", source, "diff", "-p", "-U5", "--cached", "--full-history", "--diff-filter=AM", "--date=format:%a %b %d %H:%M:%S %Y %z", base}
 
 	cmd := exec.Command("git", args...)
 
@@ -207,7 +248,7 @@ func (c *Parser) FromReader(ctx context.Context, stdOut io.Reader, commitChan ch
 		switch {
 		case isCommitLine(line):
 			// If there is a currentDiff, add it to currentCommit.
-			if currentDiff != nil && currentDiff.Content.Len() > 0 {
+			if currentDiff != nil && (currentDiff.Content.Len() > 0 || currentDiff.IsBinary) {
 				currentCommit.Diffs = append(currentCommit.Diffs, *currentDiff)
 			}
 			// If there is a currentCommit, send it to the channel.
@@ -236,7 +277,7 @@ func (c *Parser) FromReader(ctx context.Context, stdOut io.Reader, commitChan ch
 			if currentCommit == nil {
 				currentCommit = &Commit{}
 			}
-			if currentDiff != nil && currentDiff.Content.Len() > 0 {
+			if currentDiff != nil && (currentDiff.Content.Len() > 0 || currentDiff.IsBinary) {
 				currentCommit.Diffs = append(currentCommit.Diffs, *currentDiff)
 				// If the currentDiff is over 1GB, drop it into the channel so it isn't held in memory waiting for more commits.
 				totalSize := 0
@@ -278,7 +319,7 @@ func (c *Parser) FromReader(ctx context.Context, stdOut io.Reader, commitChan ch
 			currentDiff.IsBinary = true
 			currentDiff.PathB = pathFromBinaryLine(line)
 		case isLineNumberDiffLine(line):
-			if currentDiff != nil && currentDiff.Content.Len() > 0 {
+			if currentDiff != nil && (currentDiff.Content.Len() > 0 || currentDiff.IsBinary) {
 				currentCommit.Diffs = append(currentCommit.Diffs, *currentDiff)
 			}
 			newDiff := &Diff{
@@ -307,7 +348,7 @@ func (c *Parser) FromReader(ctx context.Context, stdOut io.Reader, commitChan ch
 }
 
 func cleanupParse(currentCommit *Commit, currentDiff *Diff, commitChan chan Commit) {
-	if currentDiff != nil && currentDiff.Content.Len() > 0 {
+	if currentDiff != nil && (currentDiff.Content.Len() > 0 || currentDiff.IsBinary) {
 		currentCommit.Diffs = append(currentCommit.Diffs, *currentDiff)
 	}
 	if currentCommit != nil {
@@ -435,6 +476,6 @@ func pathFromBinaryLine(line []byte) string {
 		logger.V(2).Info("Expected binary line to be in 'Binary files a/fileA and b/fileB differ' format.", "got", line)
 		return ""
 	}
-	bRaw := sbytes[1]
-	return string(bRaw[:len(bRaw)-7]) // drop the "b/" and " differ"
+	bRaw := bytes.TrimRight(sbytes[1], "\n")
+	return string(bytes.TrimSuffix(bRaw, []byte(" differ")))
 }
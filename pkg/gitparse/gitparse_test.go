@@ -76,12 +76,17 @@ func TestIsIndexLine(t *testing.T) {
 }
 
 func TestBinaryPathParse(t *testing.T) {
-	filename := pathFromBinaryLine([]byte("Binary files /dev/null and b/plugin.sig differ"))
 	expected := "plugin.sig"
-	if filename != expected {
-		t.Errorf("Expected: %s, Got: %s", expected, filename)
+	// Lines read from git keep their newline.
+	for _, line := range []string{
+		"Binary files /dev/null and b/plugin.sig differ",
+		"Binary files /dev/null and b/plugin.sig differ\n",
+	} {
+		filename := pathFromBinaryLine([]byte(line))
+		if filename != expected {
+			t.Errorf("Expected: %s, Got: %s", expected, filename)
+		}
 	}
-
 }
 
 func TestSingleCommitSingleDiff(t *testing.T) {
@@ -10,6 +10,8 @@ import (
 func DefaultHandlers() []Handler {
 	return []Handler{
 		&Archive{},
+		&PGPKeyring{},
+		&Keystore{},
 	}
 }
 
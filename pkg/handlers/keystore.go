@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	jksMagic   = 0xFEEDFEED
+	jceksMagic = 0xCECECECE
+)
+
+// pkcs7DataOID is the DER encoded pkcs7-data content type, which PKCS#12
+// files wrap their contents in.
+var pkcs7DataOID = []byte{0x06, 0x09, 0x2a, 0x86, 0x48, 0x86, 0xf7, 0x0d, 0x01, 0x07, 0x01}
+
+// Keystore is a handler for PKCS#12 (.p12, .pfx) files and Java keystores
+// (.jks, .jceks). It PEM encodes them, so detectors can find them.
+type Keystore struct {
+	blockType string
+}
+
+// New resets the detected keystore format.
+func (h *Keystore) New() {
+	h.blockType = ""
+}
+
+// IsFiletype returns true if the reader starts with a keystore header.
+func (h *Keystore) IsFiletype(ctx context.Context, reader io.Reader) (io.Reader, bool) {
+	header := make([]byte, 32)
+	n, _ := io.ReadFull(reader, header)
+	header = header[:n]
+	h.blockType = keystoreBlockType(header)
+	return io.MultiReader(bytes.NewReader(header), reader), h.blockType != ""
+}
+
+// keystoreBlockType returns the PEM block type for the keystore format the
+// header belongs to, or an empty string if it isn't a keystore.
+func keystoreBlockType(header []byte) string {
+	if len(header) >= 8 {
+		magic := binary.BigEndian.Uint32(header)
+		version := binary.BigEndian.Uint32(header[4:])
+		if (magic == jksMagic || magic == jceksMagic) && (version == 1 || version == 2) {
+			return "JAVA KEYSTORE"
+		}
+	}
+	if isPKCS12(header) {
+		return "PKCS12"
+	}
+	return ""
+}
+
+// isPKCS12 reports whether the header is the start of a PFX structure: a
+// SEQUENCE holding version 3 and pkcs7-data content.
+// https://www.rfc-editor.org/rfc/rfc7292#section-4
+func isPKCS12(header []byte) bool {
+	if len(header) < 2 || header[0] != 0x30 {
+		return false
+	}
+	// Skip the SEQUENCE's length, in short or long form.
+	i := 2
+	if header[1]&0x80 != 0 {
+		i += int(header[1] & 0x7f)
+	}
+	version := []byte{0x02, 0x01, 0x03}
+	if !bytes.HasPrefix(header[i:], version) {
+		return false
+	}
+	i += len(version)
+	// The content info is another SEQUENCE, starting with the content type.
+	if len(header) <= i+1 || header[i] != 0x30 {
+		return false
+	}
+	i += 2
+	if header[i-1]&0x80 != 0 {
+		i += int(header[i-1] & 0x7f)
+	}
+	return len(header) > i && bytes.HasPrefix(header[i:], pkcs7DataOID)
+}
+
+// FromFile PEM encodes the keystore.
+func (h *Keystore) FromFile(ctx context.Context, data io.Reader) chan ([]byte) {
+	keystoreChan := make(chan ([]byte), 1)
+	go func() {
+		defer close(keystoreChan)
+		logger := logContext.AddLogger(ctx).Logger()
+
+		blockType := h.blockType
+		if blockType == "" {
+			return
+		}
+		keystore, err := io.ReadAll(io.LimitReader(data, int64(maxSize)))
+		if err != nil {
+			logger.V(2).Info("Error reading keystore.", "error", err)
+			return
+		}
+
+		encoded := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: keystore})
+		select {
+		case keystoreChan <- encoded:
+		case <-ctx.Done():
+		}
+	}()
+	return keystoreChan
+}
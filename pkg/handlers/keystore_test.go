@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func TestKeystoreHandler(t *testing.T) {
+	var jks bytes.Buffer
+	for _, v := range []uint32{jksMagic, 2, 0} {
+		assert.NoError(t, binary.Write(&jks, binary.BigEndian, v))
+	}
+	jks.Write(make([]byte, 20))
+
+	handler := &Keystore{}
+	_, isType := handler.IsFiletype(context.Background(), strings.NewReader("not a keystore"))
+	assert.False(t, isType)
+
+	ch := make(chan *sources.Chunk, 1)
+	assert.True(t, HandleFile(context.Background(), bytes.NewReader(jks.Bytes()), &sources.Chunk{}, ch))
+	assert.Equal(t, 1, len(ch))
+	chunk := <-ch
+	block, _ := pem.Decode(chunk.Data)
+	if assert.NotNil(t, block) {
+		assert.Equal(t, "JAVA KEYSTORE", block.Type)
+		assert.Equal(t, jks.Bytes(), block.Bytes)
+	}
+}
+
+func TestKeystoreBlockType(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []byte
+		want   string
+	}{
+		{
+			name:   "jks",
+			header: []byte{0xfe, 0xed, 0xfe, 0xed, 0x00, 0x00, 0x00, 0x02},
+			want:   "JAVA KEYSTORE",
+		},
+		{
+			name:   "jceks",
+			header: []byte{0xce, 0xce, 0xce, 0xce, 0x00, 0x00, 0x00, 0x02},
+			want:   "JAVA KEYSTORE",
+		},
+		{
+			// Created by openssl pkcs12 -export.
+			name: "pkcs12",
+			header: []byte{
+				0x30, 0x82, 0x03, 0xaa, 0x02, 0x01, 0x03, 0x30, 0x82, 0x03, 0x70, 0x06, 0x09, 0x2a, 0x86, 0x48,
+				0x86, 0xf7, 0x0d, 0x01, 0x07, 0x01, 0xa0, 0x82, 0x03, 0x61, 0x04, 0x82, 0x03, 0x5d, 0x30, 0x82,
+			},
+			want: "PKCS12",
+		},
+		{
+			// A certificate is a SEQUENCE too.
+			name:   "certificate",
+			header: []byte{0x30, 0x82, 0x01, 0x8a, 0x30, 0x82, 0x01, 0x30, 0xa0, 0x03, 0x02, 0x01, 0x02},
+			want:   "",
+		},
+		{
+			name:   "short",
+			header: []byte{0x30},
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, keystoreBlockType(tt.header))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	pgperrors "golang.org/x/crypto/openpgp/errors"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// pgpSecretKeyTag is the OpenPGP packet tag of a secret key.
+// https://www.rfc-editor.org/rfc/rfc4880#section-5.5.1.3
+const pgpSecretKeyTag = 5
+
+// PGPKeyring is a handler for binary OpenPGP keyrings containing secret
+// keys, like GnuPG's secring.gpg or the output of gpg --export-secret-keys.
+// It ASCII armors them, so detectors can find the keys.
+type PGPKeyring struct{}
+
+// New is a no-op, the handler has no state.
+func (h *PGPKeyring) New() {}
+
+// IsFiletype returns true if the reader starts with a secret key packet.
+func (h *PGPKeyring) IsFiletype(ctx context.Context, reader io.Reader) (io.Reader, bool) {
+	header := make([]byte, 6)
+	n, _ := io.ReadFull(reader, header)
+	header = header[:n]
+	return io.MultiReader(bytes.NewReader(header), reader), isSecretKeyPacket(header)
+}
+
+// isSecretKeyPacket reports whether the header is the start of a version 3,
+// 4, or 5 secret key packet.
+func isSecretKeyPacket(header []byte) bool {
+	if len(header) < 2 || header[0]&0x80 == 0 {
+		return false
+	}
+
+	var bodyStart int
+	if header[0]&0x40 != 0 {
+		// New format: the tag is in the low six bits, followed by a one,
+		// two, or five byte length.
+		if header[0]&0x3f != pgpSecretKeyTag {
+			return false
+		}
+		switch {
+		case header[1] < 192:
+			bodyStart = 2
+		case header[1] < 224:
+			bodyStart = 3
+		case header[1] == 255:
+			bodyStart = 6
+		default:
+			// Partial body lengths aren't allowed for key packets.
+			return false
+		}
+	} else {
+		// Old format: the tag is in bits 2 to 5, followed by a one, two, or
+		// four byte length.
+		if (header[0]>>2)&0x0f != pgpSecretKeyTag {
+			return false
+		}
+		switch header[0] & 0x03 {
+		case 0:
+			bodyStart = 2
+		case 1:
+			bodyStart = 3
+		case 2:
+			bodyStart = 5
+		default:
+			return false
+		}
+	}
+
+	if len(header) <= bodyStart {
+		return false
+	}
+	version := header[bodyStart]
+	return version >= 3 && version <= 5
+}
+
+// FromFile armors the keyring if it contains a secret key.
+func (h *PGPKeyring) FromFile(ctx context.Context, data io.Reader) chan ([]byte) {
+	keyringChan := make(chan ([]byte), 1)
+	go func() {
+		defer close(keyringChan)
+		logger := logContext.AddLogger(ctx).Logger()
+
+		keyring, err := io.ReadAll(io.LimitReader(data, int64(maxSize)))
+		if err != nil {
+			logger.V(2).Info("Error reading keyring.", "error", err)
+			return
+		}
+		// Make sure the file really is a keyring before armoring it. Keys
+		// using algorithms the parser doesn't support are armored anyway.
+		if _, err := openpgp.ReadKeyRing(bytes.NewReader(keyring)); err != nil {
+			var unsupported pgperrors.UnsupportedError
+			if !errors.As(err, &unsupported) {
+				logger.V(3).Info("Not a PGP keyring.", "error", err)
+				return
+			}
+		}
+
+		var armored bytes.Buffer
+		w, err := armor.Encode(&armored, openpgp.PrivateKeyType, nil)
+		if err != nil {
+			return
+		}
+		if _, err := w.Write(keyring); err != nil {
+			return
+		}
+		if err := w.Close(); err != nil {
+			return
+		}
+
+		select {
+		case keyringChan <- armored.Bytes():
+		case <-ctx.Done():
+		}
+	}()
+	return keyringChan
+}
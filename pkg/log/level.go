@@ -1,7 +1,10 @@
 package log
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
@@ -37,6 +40,26 @@ func SetLevel(level int8) {
 	SetLevelForControl(globalLogLevel, level)
 }
 
+// namedLevels are the verbosity levels that have a name.
+var namedLevels = map[string]int8{
+	"info":  0,
+	"debug": 2,
+	"trace": 5,
+}
+
+// ParseLevel parses a verbosity level for SetLevel: a number from 0 to 127, or
+// one of info (0), debug (2) and trace (5).
+func ParseLevel(s string) (int8, error) {
+	if level, ok := namedLevels[strings.ToLower(s)]; ok {
+		return level, nil
+	}
+	level, err := strconv.ParseInt(s, 10, 8)
+	if err != nil || level < 0 {
+		return 0, fmt.Errorf("invalid log level %q, expected info, debug, trace or a number from 0 to 127", s)
+	}
+	return int8(level), nil
+}
+
 // SetLevelForControl sets the log level for a given control.
 func SetLevelForControl(control levelSetter, level int8) {
 	// Zap's levels get more verbose as the number gets smaller, as explained
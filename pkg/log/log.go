@@ -21,8 +21,9 @@ type logConfig struct {
 }
 
 // New creates a new log object with the provided configurations. If no sinks
-// are provided, a no-op sink will be used. Returns the logger and a cleanup
-// function that should be executed before the program exits.
+// are provided, a no-op sink will be used. Secrets are masked in all sinks
+// (see NewRedactCore). Returns the logger and a cleanup function that should
+// be executed before the program exits.
 func New(service string, configs ...logConfig) (logr.Logger, func() error) {
 	var cores []zapcore.Core
 	var cleanupFuncs []func() error
@@ -38,7 +39,7 @@ func New(service string, configs ...logConfig) (logr.Logger, func() error) {
 		}
 	}
 	// create logger
-	zapLogger := zap.New(zapcore.NewTee(cores...))
+	zapLogger := zap.New(NewRedactCore(zapcore.NewTee(cores...)))
 	cleanupFuncs = append(cleanupFuncs, zapLogger.Sync)
 	logger := zapr.NewLogger(zapLogger).WithName(service)
 
@@ -133,8 +134,9 @@ func AddSentry(l logr.Logger, opts sentry.ClientOptions, tags map[string]string)
 	return AddSink(l, WithSentry(opts, tags))
 }
 
-// AddSink extends an existing logr.Logger with a new sink. It returns the new
-// logr.Logger, a cleanup function, and an error.
+// AddSink extends an existing logr.Logger with a new sink, in which secrets
+// are masked. It returns the new logr.Logger, a cleanup function, and an
+// error.
 func AddSink(l logr.Logger, sink logConfig) (logr.Logger, func() error, error) {
 	if sink.err != nil {
 		return l, nil, sink.err
@@ -144,7 +146,7 @@ func AddSink(l logr.Logger, sink logConfig) (logr.Logger, func() error, error) {
 		return l, nil, errors.New("unsupported logr implementation")
 	}
 	zapLogger = zapLogger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
-		return zapcore.NewTee(core, sink.core)
+		return zapcore.NewTee(core, NewRedactCore(sink.core))
 	}))
 	return zapr.NewLogger(zapLogger), firstErrorFunc(zapLogger.Sync, sink.cleanup), nil
 }
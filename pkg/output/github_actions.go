@@ -39,7 +39,9 @@ func PrintGitHubActionsOutput(r *detectors.ResultWithMetadata) error {
 	}
 
 	verifiedStatus := "unverified"
-	if out.Verified {
+	if r.Result.Canary {
+		verifiedStatus = "canary"
+	} else if out.Verified {
 		verifiedStatus = "verified"
 	}
 
@@ -2,15 +2,32 @@ package output
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/remediation"
 )
 
 func PrintJSON(r *detectors.ResultWithMetadata) error {
+	out, err := MarshalJSON(r)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// MarshalJSON encodes a result in the format of PrintJSON.
+func MarshalJSON(r *detectors.ResultWithMetadata) ([]byte, error) {
 	v := &struct {
 		// SourceMetadata contains source-specific contextual information.
 		SourceMetadata *source_metadatapb.MetaData
@@ -27,13 +44,31 @@ func PrintJSON(r *detectors.ResultWithMetadata) error {
 		// DecoderName is the string name of the DecoderType.
 		DecoderName string
 		Verified    bool
+		// Canary is set if the secret is a known canary token.
+		Canary bool `json:",omitempty"`
 		// Raw contains the raw secret data.
 		Raw string
+		// RawV2 contains the raw secret identifier that is a combination of both the ID and the secret.
+		RawV2 string `json:",omitempty"`
 		// Redacted contains the redacted version of the raw secret identification data for display purposes.
 		// A secret ID should be used if available.
 		Redacted       string
 		ExtraData      map[string]string
+		TypedExtraData *detectorspb.ExtraData
 		StructuredData *detectorspb.StructuredData
+		// VerificationError is the error that kept the result from being
+		// verified, if any.
+		VerificationError string `json:",omitempty"`
+		// Revoked is set if the secret was revoked during the scan, and
+		// RevocationError is the error that kept it from being revoked.
+		Revoked         bool   `json:",omitempty"`
+		RevocationError string `json:",omitempty"`
+		// Remediation tells how to rotate the secret, for the detectors the
+		// knowledge base covers.
+		Remediation *remediation.Guidance `json:",omitempty"`
+		// ChunkData contains the decoded data the secret was found in, which
+		// the reverify command scans again.
+		ChunkData []byte `json:",omitempty"`
 	}{
 		SourceMetadata: r.SourceMetadata,
 		SourceID:       r.SourceID,
@@ -43,15 +78,130 @@ func PrintJSON(r *detectors.ResultWithMetadata) error {
 		DetectorName:   r.DetectorType.String(),
 		DecoderName:    r.DecoderType.String(),
 		Verified:       r.Verified,
+		Canary:         r.Canary,
 		Raw:            string(r.Raw),
+		RawV2:          string(r.RawV2),
 		Redacted:       r.Redacted,
 		ExtraData:      r.ExtraData,
+		TypedExtraData: r.TypedExtraData,
 		StructuredData: r.StructuredData,
+		Remediation:    remediation.For(r.DetectorType),
+		ChunkData:      r.ChunkData,
+	}
+	if err := r.VerificationError(); err != nil {
+		v.VerificationError = err.Error()
+	}
+	if tried, err := r.Revocation(); err != nil {
+		v.RevocationError = err.Error()
+	} else {
+		v.Revoked = tried
 	}
 	out, err := json.Marshal(v)
 	if err != nil {
-		return fmt.Errorf("could not marshal result: %w", err)
+		return nil, fmt.Errorf("could not marshal result: %w", err)
 	}
-	fmt.Println(string(out))
-	return nil
+	return out, nil
+}
+
+// ReadJSON reads results in the format written by PrintJSON, e.g. to
+// reverify the findings of a previous scan.
+func ReadJSON(r io.Reader) ([]detectors.ResultWithMetadata, error) {
+	var results []detectors.ResultWithMetadata
+	decoder := json.NewDecoder(r)
+	for {
+		var v struct {
+			SourceMetadata json.RawMessage
+			SourceID       int64
+			SourceType     sourcespb.SourceType
+			SourceName     string
+			DetectorType   detectorspb.DetectorType
+			DecoderName    string
+			Verified       bool
+			Raw            string
+			RawV2          string
+			Redacted       string
+			ExtraData      map[string]string
+			TypedExtraData *detectorspb.ExtraData
+			StructuredData *detectorspb.StructuredData
+			ChunkData      []byte
+			Manifest       json.RawMessage
+		}
+		err := decoder.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal result: %w", err)
+		}
+		// The manifest of the scan isn't a result.
+		if v.Manifest != nil {
+			continue
+		}
+		metadata, err := unmarshalSourceMetadata(v.SourceMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		result := detectors.ResultWithMetadata{
+			SourceMetadata: metadata,
+			SourceID:       v.SourceID,
+			SourceType:     v.SourceType,
+			SourceName:     v.SourceName,
+			ChunkData:      v.ChunkData,
+			Result: detectors.Result{
+				DetectorType:   v.DetectorType,
+				DecoderType:    detectorspb.DecoderType(detectorspb.DecoderType_value[v.DecoderName]),
+				Verified:       v.Verified,
+				Raw:            []byte(v.Raw),
+				Redacted:       v.Redacted,
+				ExtraData:      v.ExtraData,
+				TypedExtraData: v.TypedExtraData,
+				StructuredData: v.StructuredData,
+			},
+		}
+		if v.RawV2 != "" {
+			result.RawV2 = []byte(v.RawV2)
+		}
+		results = append(results, result)
+	}
+}
+
+// unmarshalSourceMetadata parses source metadata marshaled with
+// encoding/json, which wraps the source specific metadata in an object keyed
+// by the Go name of the oneof field.
+func unmarshalSourceMetadata(data json.RawMessage) (*source_metadatapb.MetaData, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var v struct {
+		Data map[string]json.RawMessage
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("could not unmarshal source metadata: %w", err)
+	}
+	if len(v.Data) == 0 {
+		return nil, nil
+	}
+
+	metadata := &source_metadatapb.MetaData{}
+	m := metadata.ProtoReflect()
+	fields := m.Descriptor().Oneofs().ByName("data").Fields()
+	for name, raw := range v.Data {
+		var field protoreflect.FieldDescriptor
+		for i := 0; i < fields.Len(); i++ {
+			if strings.EqualFold(strings.ReplaceAll(string(fields.Get(i).Name()), "_", ""), name) {
+				field = fields.Get(i)
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("unknown source metadata %q", name)
+		}
+		value := m.NewField(field)
+		if err := protojson.Unmarshal(raw, value.Message().Interface()); err != nil {
+			return nil, fmt.Errorf("could not unmarshal %s source metadata: %w", name, err)
+		}
+		m.Set(field, value)
+	}
+	return metadata, nil
 }
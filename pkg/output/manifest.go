@@ -0,0 +1,153 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Manifest describes a scan, so that its results can be tied back to what was
+// scanned and how.
+type Manifest struct {
+	// RunID identifies the scan.
+	RunID     string
+	StartTime time.Time
+	EndTime   time.Time
+	// Version is the version of trufflehog that ran the scan.
+	Version string
+	// DetectorsHash identifies the detectors that ran and their settings.
+	DetectorsHash string
+	// Sources are the commands that selected the scanned sources, with
+	// their flags and arguments. Secrets in them are redacted.
+	Sources []ManifestSource
+	// Suppressed is the number of findings that were suppressed, by what
+	// suppressed them, e.g. the allowlist.
+	Suppressed map[string]int `json:",omitempty"`
+}
+
+// ManifestSource is a command that selected sources to scan.
+type ManifestSource struct {
+	Command string
+	// Flags are the values of the flags that were set, by name. Boolean
+	// flags have the value "true" or "false".
+	Flags map[string][]string `json:",omitempty"`
+	Args  []string            `json:",omitempty"`
+}
+
+// redacted replaces secrets in manifests.
+const redacted = "REDACTED"
+
+// secretFlags are the flags whose values are secrets.
+var secretFlags = map[string]struct{}{
+	"token":    {},
+	"key":      {},
+	"secret":   {},
+	"api-key":  {},
+	"password": {},
+}
+
+// AddFlag records the value of a flag, redacting it if the flag holds a
+// secret.
+func (s *ManifestSource) AddFlag(name, value string) {
+	if s.Flags == nil {
+		s.Flags = map[string][]string{}
+	}
+	if _, ok := secretFlags[name]; ok || strings.HasSuffix(name, "-token") || strings.HasSuffix(name, "-secret") || strings.HasSuffix(name, "-password") {
+		value = redacted
+	}
+	s.Flags[name] = append(s.Flags[name], redactURL(value))
+}
+
+// AddArg records an argument of the command.
+func (s *ManifestSource) AddArg(value string) {
+	s.Args = append(s.Args, redactURL(value))
+}
+
+// redactURL redacts the password of a URL, e.g. the token of a git remote.
+// Values that aren't URLs are returned as is.
+func redactURL(value string) string {
+	if !strings.Contains(value, "://") {
+		return value
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.User == nil {
+		return value
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	return u.String()
+}
+
+// PrintJSONManifest writes the manifest as a line of JSON, in an object with
+// a single Manifest key so that it can be told apart from the results.
+func PrintJSONManifest(w io.Writer, m Manifest) error {
+	out, err := json.Marshal(struct{ Manifest Manifest }{m})
+	if err != nil {
+		return fmt.Errorf("could not marshal manifest: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// PrintPlainManifest writes the manifest for humans.
+func PrintPlainManifest(w io.Writer, m Manifest) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Run ID: %s\n", m.RunID)
+	fmt.Fprintf(&b, "Version: %s\n", m.Version)
+	fmt.Fprintf(&b, "Started: %s\n", m.StartTime.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Finished: %s\n", m.EndTime.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Detectors Hash: %s\n", m.DetectorsHash)
+	for _, source := range m.Sources {
+		fmt.Fprintf(&b, "Source: %s\n", source.commandLine())
+	}
+	suppressors := make([]string, 0, len(m.Suppressed))
+	for by := range m.Suppressed {
+		suppressors = append(suppressors, by)
+	}
+	sort.Strings(suppressors)
+	for _, by := range suppressors {
+		fmt.Fprintf(&b, "Suppressed (%s): %d\n", by, m.Suppressed[by])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// PrintGitHubActionsManifest writes the manifest as a GitHub Actions notice.
+func PrintGitHubActionsManifest(w io.Writer, m Manifest) error {
+	sources := make([]string, 0, len(m.Sources))
+	for _, source := range m.Sources {
+		sources = append(sources, source.commandLine())
+	}
+	message := fmt.Sprintf("Run ID %s, version %s, from %s to %s, detectors hash %s, sources: %s",
+		m.RunID, m.Version, m.StartTime.Format(time.RFC3339), m.EndTime.Format(time.RFC3339), m.DetectorsHash, strings.Join(sources, "; "))
+	_, err := fmt.Fprintf(w, "::notice title=TruffleHog scan::%s\n", escapeGitHubActionsData(message))
+	return err
+}
+
+// escapeGitHubActionsData escapes the message of a workflow command.
+func escapeGitHubActionsData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// commandLine formats the source like a command line, with its flags sorted
+// by name.
+func (s ManifestSource) commandLine() string {
+	names := make([]string, 0, len(s.Flags))
+	for name := range s.Flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := []string{s.Command}
+	for _, name := range names {
+		for _, value := range s.Flags[name] {
+			parts = append(parts, "--"+name+"="+value)
+		}
+	}
+	parts = append(parts, s.Args...)
+	return strings.Join(parts, " ")
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/remediation"
 )
 
 var (
@@ -36,7 +37,10 @@ func PrintPlainOutput(r *detectors.ResultWithMetadata) error {
 
 	printer := greenPrinter
 
-	if out.Verified {
+	if r.Canary {
+		printer = whitePrinter
+		yellowPrinter.Print("Found canary token 🐤\n")
+	} else if out.Verified {
 		yellowPrinter.Print("Found verified result 🐷🔑\n")
 	} else {
 		printer = whitePrinter
@@ -45,6 +49,22 @@ func PrintPlainOutput(r *detectors.ResultWithMetadata) error {
 	printer.Printf("Detector Type: %s\n", out.DetectorType)
 	printer.Printf("Decoder Type: %s\n", out.DecoderType)
 	printer.Printf("Raw result: %s\n", whitePrinter.Sprint(out.Raw))
+	if err := r.VerificationError(); err != nil {
+		printer.Printf("Verification error: %v\n", err)
+	}
+	if tried, err := r.Revocation(); err != nil {
+		printer.Printf("Revocation error: %v\n", err)
+	} else if tried {
+		printer.Printf("Revoked: true\n")
+	}
+	if g := remediation.For(r.DetectorType); g != nil {
+		if g.Docs != "" {
+			printer.Printf("Remediation: %s\n", g.Docs)
+		}
+		if g.Rotate != "" {
+			printer.Printf("Rotate: %s\n", g.Rotate)
+		}
+	}
 
 	var aggregateData = make(map[string]interface{})
 	var aggregateDataKeys []string
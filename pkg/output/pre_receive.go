@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// PrintPushRejection prints the message that rejects a push in a pre-receive
+// hook, listing the results that were found in it. The secrets themselves
+// aren't printed, since the hook's output may be logged by the server.
+func PrintPushRejection(w io.Writer, results []detectors.ResultWithMetadata) {
+	fmt.Fprintf(w, "TruffleHog rejected the push: %d secrets were found in it.\n\n", len(results))
+	for _, r := range results {
+		verifiedStatus := "unverified"
+		if r.Verified {
+			verifiedStatus = "verified"
+		}
+		fmt.Fprintf(w, "  %s %s result", verifiedStatus, r.DetectorType.String())
+		if g := r.SourceMetadata.GetGit(); g != nil {
+			fmt.Fprintf(w, " in %s:%d of commit %s", g.File, g.Line, g.Commit)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, "\nRevoke the secrets, remove them from the commits, and push again.")
+}
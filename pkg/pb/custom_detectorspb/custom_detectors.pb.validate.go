@@ -91,6 +91,74 @@ func (m *CustomDetectors) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetDetectorSettings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CustomDetectorsValidationError{
+						field:  fmt.Sprintf("DetectorSettings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CustomDetectorsValidationError{
+						field:  fmt.Sprintf("DetectorSettings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CustomDetectorsValidationError{
+					field:  fmt.Sprintf("DetectorSettings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetIgnore() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CustomDetectorsValidationError{
+						field:  fmt.Sprintf("Ignore[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CustomDetectorsValidationError{
+						field:  fmt.Sprintf("Ignore[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CustomDetectorsValidationError{
+					field:  fmt.Sprintf("Ignore[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CustomDetectorsMultiError(errors)
 	}
@@ -229,6 +297,8 @@ func (m *CustomRegex) validate(all bool) error {
 
 	}
 
+	// no validation rules for Validation
+
 	if len(errors) > 0 {
 		return CustomRegexMultiError(errors)
 	}
@@ -342,6 +412,12 @@ func (m *VerifierConfig) validate(all bool) error {
 
 	// no validation rules for Unsafe
 
+	// no validation rules for Method
+
+	// no validation rules for Body
+
+	// no validation rules for SuccessBodyRegex
+
 	if len(errors) > 0 {
 		return VerifierConfigMultiError(errors)
 	}
@@ -419,3 +495,212 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = VerifierConfigValidationError{}
+
+// Validate checks the field values on DetectorSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DetectorSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetectorSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DetectorSettingsMultiError, or nil if none found.
+func (m *DetectorSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetectorSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for EntropyThresholds
+
+	if m.Verify != nil {
+		// no validation rules for Verify
+	}
+
+	if len(errors) > 0 {
+		return DetectorSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DetectorSettingsMultiError is an error wrapping multiple validation errors
+// returned by DetectorSettings.ValidateAll() if the designated constraints
+// aren't met.
+type DetectorSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetectorSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetectorSettingsMultiError) AllErrors() []error { return m }
+
+// DetectorSettingsValidationError is the validation error returned by
+// DetectorSettings.Validate if the designated constraints aren't met.
+type DetectorSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetectorSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetectorSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetectorSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetectorSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetectorSettingsValidationError) ErrorName() string { return "DetectorSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DetectorSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetectorSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetectorSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetectorSettingsValidationError{}
+
+// Validate checks the field values on IgnoreRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IgnoreRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IgnoreRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IgnoreRuleMultiError, or
+// nil if none found.
+func (m *IgnoreRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IgnoreRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return IgnoreRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// IgnoreRuleMultiError is an error wrapping multiple validation errors
+// returned by IgnoreRule.ValidateAll() if the designated constraints aren't met.
+type IgnoreRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IgnoreRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IgnoreRuleMultiError) AllErrors() []error { return m }
+
+// IgnoreRuleValidationError is the validation error returned by
+// IgnoreRule.Validate if the designated constraints aren't met.
+type IgnoreRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IgnoreRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IgnoreRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IgnoreRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IgnoreRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IgnoreRuleValidationError) ErrorName() string { return "IgnoreRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IgnoreRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIgnoreRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IgnoreRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IgnoreRuleValidationError{}
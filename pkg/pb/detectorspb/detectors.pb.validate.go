@@ -99,6 +99,35 @@ func (m *Result) validate(all bool) error {
 
 	// no validation rules for DecoderType
 
+	if all {
+		switch v := interface{}(m.GetTypedExtraData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "TypedExtraData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "TypedExtraData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTypedExtraData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "TypedExtraData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ResultMultiError(errors)
 	}
@@ -552,3 +581,798 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GitHubSSHKeyValidationError{}
+
+// Validate checks the field values on ExtraData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExtraData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtraData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExtraDataMultiError, or nil
+// if none found.
+func (m *ExtraData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtraData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Account
+
+	// no validation rules for Username
+
+	// no validation rules for ExpirationTimestamp
+
+	// no validation rules for Endpoint
+
+	// no validation rules for TokenType
+
+	switch m.Family.(type) {
+	case *ExtraData_Aws:
+
+		if all {
+			switch v := interface{}(m.GetAws()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Aws",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Aws",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAws()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExtraDataValidationError{
+					field:  "Aws",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExtraData_Github:
+
+		if all {
+			switch v := interface{}(m.GetGithub()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Github",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Github",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGithub()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExtraDataValidationError{
+					field:  "Github",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExtraData_Gitlab:
+
+		if all {
+			switch v := interface{}(m.GetGitlab()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Gitlab",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Gitlab",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGitlab()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExtraDataValidationError{
+					field:  "Gitlab",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExtraData_Gcp:
+
+		if all {
+			switch v := interface{}(m.GetGcp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Gcp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Gcp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExtraDataValidationError{
+					field:  "Gcp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExtraData_Database:
+
+		if all {
+			switch v := interface{}(m.GetDatabase()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Database",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExtraDataValidationError{
+						field:  "Database",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDatabase()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExtraDataValidationError{
+					field:  "Database",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExtraDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtraDataMultiError is an error wrapping multiple validation errors returned
+// by ExtraData.ValidateAll() if the designated constraints aren't met.
+type ExtraDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtraDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtraDataMultiError) AllErrors() []error { return m }
+
+// ExtraDataValidationError is the validation error returned by
+// ExtraData.Validate if the designated constraints aren't met.
+type ExtraDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtraDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtraDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtraDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtraDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtraDataValidationError) ErrorName() string { return "ExtraDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExtraDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtraData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtraDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtraDataValidationError{}
+
+// Validate checks the field values on AWSExtraData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AWSExtraData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AWSExtraData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AWSExtraDataMultiError, or
+// nil if none found.
+func (m *AWSExtraData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AWSExtraData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Arn
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return AWSExtraDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// AWSExtraDataMultiError is an error wrapping multiple validation errors
+// returned by AWSExtraData.ValidateAll() if the designated constraints aren't met.
+type AWSExtraDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AWSExtraDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AWSExtraDataMultiError) AllErrors() []error { return m }
+
+// AWSExtraDataValidationError is the validation error returned by
+// AWSExtraData.Validate if the designated constraints aren't met.
+type AWSExtraDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AWSExtraDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AWSExtraDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AWSExtraDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AWSExtraDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AWSExtraDataValidationError) ErrorName() string { return "AWSExtraDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AWSExtraDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAWSExtraData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AWSExtraDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AWSExtraDataValidationError{}
+
+// Validate checks the field values on GitHubExtraData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GitHubExtraData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitHubExtraData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GitHubExtraDataMultiError, or nil if none found.
+func (m *GitHubExtraData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitHubExtraData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountType
+
+	// no validation rules for SiteAdmin
+
+	// no validation rules for Name
+
+	// no validation rules for Company
+
+	// no validation rules for RepositoryCount
+
+	if len(errors) > 0 {
+		return GitHubExtraDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitHubExtraDataMultiError is an error wrapping multiple validation errors
+// returned by GitHubExtraData.ValidateAll() if the designated constraints
+// aren't met.
+type GitHubExtraDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitHubExtraDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitHubExtraDataMultiError) AllErrors() []error { return m }
+
+// GitHubExtraDataValidationError is the validation error returned by
+// GitHubExtraData.Validate if the designated constraints aren't met.
+type GitHubExtraDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitHubExtraDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitHubExtraDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitHubExtraDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitHubExtraDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitHubExtraDataValidationError) ErrorName() string { return "GitHubExtraDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GitHubExtraDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitHubExtraData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitHubExtraDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitHubExtraDataValidationError{}
+
+// Validate checks the field values on GitLabExtraData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GitLabExtraData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitLabExtraData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GitLabExtraDataMultiError, or nil if none found.
+func (m *GitLabExtraData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitLabExtraData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for TokenName
+
+	if len(errors) > 0 {
+		return GitLabExtraDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitLabExtraDataMultiError is an error wrapping multiple validation errors
+// returned by GitLabExtraData.ValidateAll() if the designated constraints
+// aren't met.
+type GitLabExtraDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitLabExtraDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitLabExtraDataMultiError) AllErrors() []error { return m }
+
+// GitLabExtraDataValidationError is the validation error returned by
+// GitLabExtraData.Validate if the designated constraints aren't met.
+type GitLabExtraDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitLabExtraDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitLabExtraDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitLabExtraDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitLabExtraDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitLabExtraDataValidationError) ErrorName() string { return "GitLabExtraDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GitLabExtraDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitLabExtraData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitLabExtraDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitLabExtraDataValidationError{}
+
+// Validate checks the field values on GCPExtraData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GCPExtraData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GCPExtraData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GCPExtraDataMultiError, or
+// nil if none found.
+func (m *GCPExtraData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GCPExtraData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyCreationTimestamp
+
+	if len(errors) > 0 {
+		return GCPExtraDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// GCPExtraDataMultiError is an error wrapping multiple validation errors
+// returned by GCPExtraData.ValidateAll() if the designated constraints aren't met.
+type GCPExtraDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GCPExtraDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GCPExtraDataMultiError) AllErrors() []error { return m }
+
+// GCPExtraDataValidationError is the validation error returned by
+// GCPExtraData.Validate if the designated constraints aren't met.
+type GCPExtraDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GCPExtraDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GCPExtraDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GCPExtraDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GCPExtraDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GCPExtraDataValidationError) ErrorName() string { return "GCPExtraDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GCPExtraDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGCPExtraData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GCPExtraDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GCPExtraDataValidationError{}
+
+// Validate checks the field values on DatabaseExtraData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DatabaseExtraData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatabaseExtraData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DatabaseExtraDataMultiError, or nil if none found.
+func (m *DatabaseExtraData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatabaseExtraData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Scheme
+
+	// no validation rules for Database
+
+	// no validation rules for Vhost
+
+	if len(errors) > 0 {
+		return DatabaseExtraDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatabaseExtraDataMultiError is an error wrapping multiple validation errors
+// returned by DatabaseExtraData.ValidateAll() if the designated constraints
+// aren't met.
+type DatabaseExtraDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatabaseExtraDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatabaseExtraDataMultiError) AllErrors() []error { return m }
+
+// DatabaseExtraDataValidationError is the validation error returned by
+// DatabaseExtraData.Validate if the designated constraints aren't met.
+type DatabaseExtraDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseExtraDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseExtraDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseExtraDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseExtraDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseExtraDataValidationError) ErrorName() string {
+	return "DatabaseExtraDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DatabaseExtraDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabaseExtraData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatabaseExtraDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseExtraDataValidationError{}
@@ -0,0 +1,1325 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: plugins.proto
+
+package pluginspb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	detectorspb "github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = detectorspb.DetectorType(0)
+)
+
+// Validate checks the field values on DescribeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DescribeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeRequestMultiError, or nil if none found.
+func (m *DescribeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DescribeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeRequestMultiError is an error wrapping multiple validation errors
+// returned by DescribeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DescribeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeRequestMultiError) AllErrors() []error { return m }
+
+// DescribeRequestValidationError is the validation error returned by
+// DescribeRequest.Validate if the designated constraints aren't met.
+type DescribeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeRequestValidationError) ErrorName() string { return "DescribeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DescribeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeRequestValidationError{}
+
+// Validate checks the field values on DescribeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DescribeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeResponseMultiError, or nil if none found.
+func (m *DescribeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return DescribeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeResponseMultiError is an error wrapping multiple validation errors
+// returned by DescribeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DescribeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeResponseMultiError) AllErrors() []error { return m }
+
+// DescribeResponseValidationError is the validation error returned by
+// DescribeResponse.Validate if the designated constraints aren't met.
+type DescribeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeResponseValidationError) ErrorName() string { return "DescribeResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DescribeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeResponseValidationError{}
+
+// Validate checks the field values on FromDataRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FromDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FromDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FromDataRequestMultiError, or nil if none found.
+func (m *FromDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FromDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Verify
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return FromDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FromDataRequestMultiError is an error wrapping multiple validation errors
+// returned by FromDataRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FromDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FromDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FromDataRequestMultiError) AllErrors() []error { return m }
+
+// FromDataRequestValidationError is the validation error returned by
+// FromDataRequest.Validate if the designated constraints aren't met.
+type FromDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FromDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FromDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FromDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FromDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FromDataRequestValidationError) ErrorName() string { return "FromDataRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FromDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFromDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FromDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FromDataRequestValidationError{}
+
+// Validate checks the field values on FromDataResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FromDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FromDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FromDataResponseMultiError, or nil if none found.
+func (m *FromDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FromDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FromDataResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FromDataResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FromDataResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FromDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FromDataResponseMultiError is an error wrapping multiple validation errors
+// returned by FromDataResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FromDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FromDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FromDataResponseMultiError) AllErrors() []error { return m }
+
+// FromDataResponseValidationError is the validation error returned by
+// FromDataResponse.Validate if the designated constraints aren't met.
+type FromDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FromDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FromDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FromDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FromDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FromDataResponseValidationError) ErrorName() string { return "FromDataResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FromDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFromDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FromDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FromDataResponseValidationError{}
+
+// Validate checks the field values on Result with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Result) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Result with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ResultMultiError, or nil if none found.
+func (m *Result) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Result) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DetectorType
+
+	// no validation rules for DetectorName
+
+	// no validation rules for Verified
+
+	// no validation rules for Raw
+
+	// no validation rules for RawV2
+
+	// no validation rules for Redacted
+
+	// no validation rules for ExtraData
+
+	if all {
+		switch v := interface{}(m.GetStructuredData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "StructuredData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "StructuredData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStructuredData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "StructuredData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTypedExtraData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "TypedExtraData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "TypedExtraData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTypedExtraData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "TypedExtraData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResultMultiError is an error wrapping multiple validation errors returned by
+// Result.ValidateAll() if the designated constraints aren't met.
+type ResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResultMultiError) AllErrors() []error { return m }
+
+// ResultValidationError is the validation error returned by Result.Validate if
+// the designated constraints aren't met.
+type ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResultValidationError) ErrorName() string { return "ResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResultValidationError{}
+
+// Validate checks the field values on SourceInitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SourceInitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceInitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SourceInitRequestMultiError, or nil if none found.
+func (m *SourceInitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceInitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Options
+
+	// no validation rules for Concurrency
+
+	if len(errors) > 0 {
+		return SourceInitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceInitRequestMultiError is an error wrapping multiple validation errors
+// returned by SourceInitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SourceInitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceInitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceInitRequestMultiError) AllErrors() []error { return m }
+
+// SourceInitRequestValidationError is the validation error returned by
+// SourceInitRequest.Validate if the designated constraints aren't met.
+type SourceInitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceInitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceInitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceInitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceInitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceInitRequestValidationError) ErrorName() string {
+	return "SourceInitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SourceInitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceInitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceInitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceInitRequestValidationError{}
+
+// Validate checks the field values on SourceInitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SourceInitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceInitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SourceInitResponseMultiError, or nil if none found.
+func (m *SourceInitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceInitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return SourceInitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceInitResponseMultiError is an error wrapping multiple validation errors
+// returned by SourceInitResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SourceInitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceInitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceInitResponseMultiError) AllErrors() []error { return m }
+
+// SourceInitResponseValidationError is the validation error returned by
+// SourceInitResponse.Validate if the designated constraints aren't met.
+type SourceInitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceInitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceInitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceInitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceInitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceInitResponseValidationError) ErrorName() string {
+	return "SourceInitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SourceInitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceInitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceInitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceInitResponseValidationError{}
+
+// Validate checks the field values on EnumerateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnumerateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnumerateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnumerateRequestMultiError, or nil if none found.
+func (m *EnumerateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnumerateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnumerateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnumerateRequestMultiError is an error wrapping multiple validation errors
+// returned by EnumerateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnumerateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnumerateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnumerateRequestMultiError) AllErrors() []error { return m }
+
+// EnumerateRequestValidationError is the validation error returned by
+// EnumerateRequest.Validate if the designated constraints aren't met.
+type EnumerateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnumerateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnumerateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnumerateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnumerateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnumerateRequestValidationError) ErrorName() string { return "EnumerateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnumerateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnumerateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnumerateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnumerateRequestValidationError{}
+
+// Validate checks the field values on SourceUnit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SourceUnit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceUnit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SourceUnitMultiError, or
+// nil if none found.
+func (m *SourceUnit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceUnit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return SourceUnitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceUnitMultiError is an error wrapping multiple validation errors
+// returned by SourceUnit.ValidateAll() if the designated constraints aren't met.
+type SourceUnitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceUnitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceUnitMultiError) AllErrors() []error { return m }
+
+// SourceUnitValidationError is the validation error returned by
+// SourceUnit.Validate if the designated constraints aren't met.
+type SourceUnitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceUnitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceUnitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceUnitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceUnitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceUnitValidationError) ErrorName() string { return "SourceUnitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SourceUnitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceUnit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceUnitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceUnitValidationError{}
+
+// Validate checks the field values on ChunksRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChunksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChunksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChunksRequestMultiError, or
+// nil if none found.
+func (m *ChunksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChunksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUnit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChunksRequestValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChunksRequestValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChunksRequestValidationError{
+				field:  "Unit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChunksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChunksRequestMultiError is an error wrapping multiple validation errors
+// returned by ChunksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ChunksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChunksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChunksRequestMultiError) AllErrors() []error { return m }
+
+// ChunksRequestValidationError is the validation error returned by
+// ChunksRequest.Validate if the designated constraints aren't met.
+type ChunksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChunksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChunksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChunksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChunksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChunksRequestValidationError) ErrorName() string { return "ChunksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChunksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChunksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChunksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChunksRequestValidationError{}
+
+// Validate checks the field values on Chunk with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Chunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Chunk with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChunkMultiError, or nil if none found.
+func (m *Chunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Chunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if all {
+		switch v := interface{}(m.GetSourceMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChunkValidationError{
+					field:  "SourceMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChunkValidationError{
+					field:  "SourceMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSourceMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChunkValidationError{
+				field:  "SourceMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChunkMultiError is an error wrapping multiple validation errors returned by
+// Chunk.ValidateAll() if the designated constraints aren't met.
+type ChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChunkMultiError) AllErrors() []error { return m }
+
+// ChunkValidationError is the validation error returned by Chunk.Validate if
+// the designated constraints aren't met.
+type ChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChunkValidationError) ErrorName() string { return "ChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChunkValidationError{}
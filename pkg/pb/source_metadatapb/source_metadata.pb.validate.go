@@ -409,6 +409,8 @@ func (m *CircleCI) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for Artifact
+
 	if len(errors) > 0 {
 		return CircleCIMultiError(errors)
 	}
@@ -2614,6 +2616,8 @@ func (m *Syslog) validate(all bool) error {
 
 	// no validation rules for Facility
 
+	// no validation rules for StructuredDataId
+
 	if len(errors) > 0 {
 		return SyslogMultiError(errors)
 	}
@@ -2691,6 +2695,225 @@ var _ interface {
 	ErrorName() string
 } = SyslogValidationError{}
 
+// Validate checks the field values on Container with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Container) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Container with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContainerMultiError, or nil
+// if none found.
+func (m *Container) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Container) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContainerId
+
+	// no validation rules for ContainerName
+
+	// no validation rules for Image
+
+	// no validation rules for Location
+
+	// no validation rules for Volume
+
+	if len(errors) > 0 {
+		return ContainerMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContainerMultiError is an error wrapping multiple validation errors returned
+// by Container.ValidateAll() if the designated constraints aren't met.
+type ContainerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContainerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContainerMultiError) AllErrors() []error { return m }
+
+// ContainerValidationError is the validation error returned by
+// Container.Validate if the designated constraints aren't met.
+type ContainerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContainerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContainerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContainerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContainerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContainerValidationError) ErrorName() string { return "ContainerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContainerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContainer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContainerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContainerValidationError{}
+
+// Validate checks the field values on Plugin with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Plugin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Plugin with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PluginMultiError, or nil if none found.
+func (m *Plugin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Plugin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Plugin
+
+	// no validation rules for Unit
+
+	// no validation rules for File
+
+	// no validation rules for Link
+
+	// no validation rules for Line
+
+	// no validation rules for Fields
+
+	if len(errors) > 0 {
+		return PluginMultiError(errors)
+	}
+
+	return nil
+}
+
+// PluginMultiError is an error wrapping multiple validation errors returned by
+// Plugin.ValidateAll() if the designated constraints aren't met.
+type PluginMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PluginMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PluginMultiError) AllErrors() []error { return m }
+
+// PluginValidationError is the validation error returned by Plugin.Validate if
+// the designated constraints aren't met.
+type PluginValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PluginValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PluginValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PluginValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PluginValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PluginValidationError) ErrorName() string { return "PluginValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PluginValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlugin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PluginValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PluginValidationError{}
+
 // Validate checks the field values on PublicEventMonitoring with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3596,6 +3819,68 @@ func (m *MetaData) validate(all bool) error {
 			}
 		}
 
+	case *MetaData_Container:
+
+		if all {
+			switch v := interface{}(m.GetContainer()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Container",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Container",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetContainer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Container",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetaData_Plugin:
+
+		if all {
+			switch v := interface{}(m.GetPlugin()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Plugin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Plugin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPlugin()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Plugin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
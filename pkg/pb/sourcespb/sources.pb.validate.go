@@ -583,6 +583,68 @@ func (m *CircleCI) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IncludeArtifacts
+
+	// no validation rules for AuditEnvVars
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CircleCIValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CircleCIValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CircleCIValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CircleCIValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CircleCIValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CircleCIValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch m.Credential.(type) {
 
 	case *CircleCI_Token:
@@ -1144,6 +1206,18 @@ func (m *Filesystem) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for RespectGitignore
+
+	// no validation rules for Watch
+
+	// no validation rules for FollowSymlinks
+
+	// no validation rules for ScanDevices
+
+	// no validation rules for ScanAlternateDataStreams
+
+	// no validation rules for MaxFileSize
+
 	if len(errors) > 0 {
 		return FilesystemMultiError(errors)
 	}
@@ -1246,6 +1320,10 @@ func (m *GCS) validate(all bool) error {
 
 	// no validation rules for MaxObjectSize
 
+	// no validation rules for UserProject
+
+	// no validation rules for IncludeGenerations
+
 	switch m.Credential.(type) {
 
 	case *GCS_JsonServiceAccount:
@@ -1645,6 +1723,49 @@ func (m *GitLab) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for SkipArchived
+
+	// no validation rules for SkipForks
+
+	// no validation rules for IncludeSnippets
+
+	// no validation rules for IncludeComments
+
+	// no validation rules for IncludeCiVariables
+
+	// no validation rules for IncludeJobLogs
+
+	// no validation rules for IncludeJobArtifacts
+
+	if all {
+		switch v := interface{}(m.GetJobLogsSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitLabValidationError{
+					field:  "JobLogsSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitLabValidationError{
+					field:  "JobLogsSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobLogsSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitLabValidationError{
+				field:  "JobLogsSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if _, err := url.Parse(m.GetEndpoint()); err != nil {
 		err = GitLabValidationError{
 			field:  "Endpoint",
@@ -1844,6 +1965,45 @@ func (m *GitHub) validate(all bool) error {
 
 	// no validation rules for Base
 
+	// no validation rules for IncludeGists
+
+	// no validation rules for IncludeComments
+
+	// no validation rules for IncludeWikis
+
+	// no validation rules for IncludeReleases
+
+	// no validation rules for IncludeActionsLogs
+
+	if all {
+		switch v := interface{}(m.GetActionsLogsSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitHubValidationError{
+					field:  "ActionsLogsSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitHubValidationError{
+					field:  "ActionsLogsSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActionsLogsSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitHubValidationError{
+				field:  "ActionsLogsSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch m.Credential.(type) {
 
 	case *GitHub_GithubApp:
@@ -2601,6 +2761,14 @@ func (m *S3) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ExternalId
+
+	// no validation rules for BucketRoles
+
+	// no validation rules for MaxObjectSize
+
+	// no validation rules for IncludeVersions
+
 	switch m.Credential.(type) {
 
 	case *S3_AccessKey:
@@ -3840,6 +4008,12 @@ func (m *Syslog) validate(all bool) error {
 
 	// no validation rules for Format
 
+	// no validation rules for TlsClientCA
+
+	// no validation rules for SenderRateLimit
+
+	// no validation rules for SenderBurst
+
 	if len(errors) > 0 {
 		return SyslogMultiError(errors)
 	}
@@ -4221,3 +4395,110 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SlackRealtimeValidationError{}
+
+// Validate checks the field values on Containers with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Containers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Containers with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContainersMultiError, or
+// nil if none found.
+func (m *Containers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Containers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Endpoint
+
+	// no validation rules for IncludeStopped
+
+	// no validation rules for SkipVolumes
+
+	// no validation rules for SkipEnv
+
+	if len(errors) > 0 {
+		return ContainersMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContainersMultiError is an error wrapping multiple validation errors
+// returned by Containers.ValidateAll() if the designated constraints aren't met.
+type ContainersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContainersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContainersMultiError) AllErrors() []error { return m }
+
+// ContainersValidationError is the validation error returned by
+// Containers.Validate if the designated constraints aren't met.
+type ContainersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContainersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContainersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContainersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContainersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContainersValidationError) ErrorName() string { return "ContainersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContainersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContainers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContainersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContainersValidationError{}
@@ -0,0 +1,248 @@
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/pluginspb"
+)
+
+// Handshake is shared by trufflehog and its detector and source plugins. A
+// binary in the plugins directory that wasn't built with Serve fails the
+// handshake and isn't loaded. Bump ProtocolVersion when pluginspb changes
+// incompatibly.
+var Handshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "TRUFFLEHOG_PLUGIN",
+	MagicCookieValue: "detector",
+}
+
+// pluginName is the name detectors are dispensed under.
+const pluginName = "detector"
+
+// Serve runs a detector as a plugin. It is called from the main function of
+// the plugin's binary and doesn't return. The name is reported in the
+// results of the detector.
+func Serve(name string, detector detectors.Detector) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: plugin.PluginSet{
+			pluginName: &detectorPlugin{name: name, detector: detector},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+// Load starts every executable in dir as a detector plugin, loads every .wasm
+// file in it as a WASM detector, and returns their detectors. Plugins run until
+// Shutdown is called.
+func Load(ctx logContext.Context, dir string) ([]detectors.Detector, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read plugins directory: %w", err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var loaded []detectors.Detector
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if filepath.Ext(path) == ".wasm" {
+			detector, err := loadWASM(ctx, path)
+			if err != nil {
+				Shutdown()
+				return nil, fmt.Errorf("could not load WASM detector %s: %w", path, err)
+			}
+			ctx.Logger().V(2).Info("loaded WASM detector", "path", path, "name", detector.name)
+			loaded = append(loaded, detector)
+			continue
+		}
+		if info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		detector, err := start(ctx, path)
+		if err != nil {
+			Shutdown()
+			return nil, fmt.Errorf("could not load plugin %s: %w", path, err)
+		}
+		ctx.Logger().V(2).Info("loaded detector plugin", "path", path, "name", detector.name)
+		loaded = append(loaded, detector)
+	}
+	return loaded, nil
+}
+
+// Shutdown stops the plugins started by Load.
+func Shutdown() {
+	plugin.CleanupClients()
+	closeWASM()
+}
+
+func start(ctx context.Context, path string) (*detector, error) {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          plugin.PluginSet{pluginName: &detectorPlugin{}},
+		Cmd:              exec.Command(path),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Managed:          true,
+		Logger:           hclog.NewNullLogger(),
+	})
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rpcClient.Dispense(pluginName)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := raw.(*detector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected plugin type %T", raw)
+	}
+	if err := d.describe(ctx); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// detectorPlugin serves and dispenses detectors over gRPC.
+type detectorPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	name     string
+	detector detectors.Detector
+}
+
+func (p *detectorPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	pluginspb.RegisterDetectorServer(s, &server{name: p.name, detector: p.detector})
+	return nil
+}
+
+func (p *detectorPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &detector{client: pluginspb.NewDetectorClient(c)}, nil
+}
+
+// server exposes a detector to trufflehog.
+type server struct {
+	pluginspb.UnimplementedDetectorServer
+
+	name     string
+	detector detectors.Detector
+}
+
+func (s *server) Describe(_ context.Context, _ *pluginspb.DescribeRequest) (*pluginspb.DescribeResponse, error) {
+	resp := &pluginspb.DescribeResponse{
+		Name:     s.name,
+		Keywords: s.detector.Keywords(),
+		Type:     s.detector.Type(),
+	}
+	if versioner, ok := s.detector.(detectors.Versioner); ok {
+		resp.Version = int64(versioner.Version())
+	}
+	return resp, nil
+}
+
+func (s *server) FromData(ctx context.Context, req *pluginspb.FromDataRequest) (*pluginspb.FromDataResponse, error) {
+	results, err := s.detector.FromData(ctx, req.GetVerify(), req.GetData())
+	if err != nil {
+		return nil, err
+	}
+	resp := &pluginspb.FromDataResponse{Results: make([]*pluginspb.Result, 0, len(results))}
+	for _, result := range results {
+		if result.DetectorName == "" {
+			result.DetectorName = s.name
+		}
+		resp.Results = append(resp.Results, &pluginspb.Result{
+			DetectorType:   result.DetectorType,
+			DetectorName:   result.DetectorName,
+			Verified:       result.Verified,
+			Raw:            result.Raw,
+			RawV2:          result.RawV2,
+			Redacted:       result.Redacted,
+			ExtraData:      result.ExtraData,
+			TypedExtraData: result.TypedExtraData,
+			StructuredData: result.StructuredData,
+		})
+	}
+	return resp, nil
+}
+
+// detector is a detector running in a plugin.
+type detector struct {
+	client pluginspb.DetectorClient
+
+	name         string
+	keywords     []string
+	detectorType detectorspb.DetectorType
+	version      int
+}
+
+// Ensure the detector satisfies the interfaces at compile time.
+var (
+	_ detectors.Detector  = (*detector)(nil)
+	_ detectors.Versioner = (*detector)(nil)
+)
+
+// describe caches the description of the detector, which doesn't change
+// while the plugin runs.
+func (d *detector) describe(ctx context.Context) error {
+	resp, err := d.client.Describe(ctx, &pluginspb.DescribeRequest{})
+	if err != nil {
+		return err
+	}
+	d.name = resp.GetName()
+	d.keywords = resp.GetKeywords()
+	d.detectorType = resp.GetType()
+	d.version = int(resp.GetVersion())
+	return nil
+}
+
+func (d *detector) FromData(ctx context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	resp, err := d.client.FromData(ctx, &pluginspb.FromDataRequest{Verify: verify, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", d.name, err)
+	}
+	results := make([]detectors.Result, 0, len(resp.GetResults()))
+	for _, result := range resp.GetResults() {
+		results = append(results, detectors.Result{
+			DetectorType:   result.GetDetectorType(),
+			DetectorName:   result.GetDetectorName(),
+			Verified:       result.GetVerified(),
+			Raw:            result.GetRaw(),
+			RawV2:          result.GetRawV2(),
+			Redacted:       result.GetRedacted(),
+			ExtraData:      result.GetExtraData(),
+			TypedExtraData: result.GetTypedExtraData(),
+			StructuredData: result.GetStructuredData(),
+		})
+	}
+	return results, nil
+}
+
+func (d *detector) Keywords() []string {
+	return d.keywords
+}
+
+func (d *detector) Type() detectorspb.DetectorType {
+	return d.detectorType
+}
+
+func (d *detector) Version() int {
+	return d.version
+}
@@ -0,0 +1,141 @@
+package plugins
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/go-plugin"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+type fakeDetector struct{}
+
+func (fakeDetector) FromData(_ context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	var results []detectors.Result
+	for _, word := range strings.Fields(string(data)) {
+		if !strings.HasPrefix(word, "corp_") {
+			continue
+		}
+		results = append(results, detectors.Result{
+			DetectorType: detectorspb.DetectorType_CustomRegex,
+			Verified:     verify,
+			Raw:          []byte(word),
+			Redacted:     word[:len("corp_")],
+			ExtraData:    map[string]string{"team": "security"},
+		})
+	}
+	return results, nil
+}
+
+func (fakeDetector) Keywords() []string {
+	return []string{"corp_"}
+}
+
+func (fakeDetector) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_CustomRegex
+}
+
+func (fakeDetector) Version() int {
+	return 2
+}
+
+func dispense(t *testing.T) *detector {
+	t.Helper()
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		pluginName: &detectorPlugin{name: "corp", detector: fakeDetector{}},
+	})
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(pluginName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := raw.(*detector)
+	if err := d.describe(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestPluginDescribe(t *testing.T) {
+	d := dispense(t)
+
+	if d.name != "corp" {
+		t.Errorf("expected name corp, got %q", d.name)
+	}
+	if diff := cmp.Diff([]string{"corp_"}, d.Keywords()); diff != "" {
+		t.Errorf("keywords diff: (-want +got)\n%s", diff)
+	}
+	if d.Type() != detectorspb.DetectorType_CustomRegex {
+		t.Errorf("expected type CustomRegex, got %s", d.Type())
+	}
+	if d.Version() != 2 {
+		t.Errorf("expected version 2, got %d", d.Version())
+	}
+}
+
+func TestPluginFromData(t *testing.T) {
+	d := dispense(t)
+
+	results, err := d.FromData(context.Background(), true, []byte("token corp_1234 and corp_5678"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []detectors.Result{
+		{
+			DetectorType: detectorspb.DetectorType_CustomRegex,
+			DetectorName: "corp",
+			Verified:     true,
+			Raw:          []byte("corp_1234"),
+			Redacted:     "corp_",
+			ExtraData:    map[string]string{"team": "security"},
+		},
+		{
+			DetectorType: detectorspb.DetectorType_CustomRegex,
+			DetectorName: "corp",
+			Verified:     true,
+			Raw:          []byte("corp_5678"),
+			Redacted:     "corp_",
+			ExtraData:    map[string]string{"team": "security"},
+		},
+	}
+	if diff := cmp.Diff(want, results, cmpopts.IgnoreUnexported(detectors.Result{})); diff != "" {
+		t.Errorf("results diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestLoadSkipsNonExecutables(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("not a plugin"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(logContext.Background(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 0 {
+		t.Errorf("expected no plugins, got %d", len(loaded))
+	}
+}
+
+func TestLoadRejectsOtherExecutables(t *testing.T) {
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho hello\n"
+	if err := os.WriteFile(filepath.Join(dir, "hello"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(logContext.Background(), dir); err == nil {
+		t.Error("expected an error loading a binary that isn't a plugin")
+	}
+}
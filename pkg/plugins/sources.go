@@ -0,0 +1,245 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/pluginspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// sourcePluginName is the name sources are dispensed under.
+const sourcePluginName = "source"
+
+// Unit is a part of a source that's scanned on its own, like a repository.
+type Unit struct {
+	ID   string
+	Kind string
+}
+
+// Source is implemented by external sources, which are served with
+// ServeSource. Chunks only need their Data and SourceMetadata set; the other
+// fields are set by trufflehog.
+type Source interface {
+	// Init configures the source with the options given on the command line,
+	// and returns the name reported in the results of the source.
+	Init(ctx logContext.Context, options map[string]string, concurrency int) (string, error)
+	// Enumerate reports each unit of the source.
+	Enumerate(ctx logContext.Context, report func(Unit) error) error
+	// Chunks sends the chunks of a unit.
+	Chunks(ctx logContext.Context, unit Unit, send func(*sources.Chunk) error) error
+}
+
+// ServeSource runs a source as a plugin. It is called from the main function
+// of the plugin's binary and doesn't return.
+func ServeSource(source Source) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: plugin.PluginSet{
+			sourcePluginName: &sourcePlugin{source: source},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+// SourceConnection is the connection of plugin sources, for their Init
+// method.
+func SourceConnection(options map[string]string) (*anypb.Any, error) {
+	return anypb.New(&pluginspb.SourceInitRequest{Options: options})
+}
+
+// StartSource starts the executable at path as a source plugin. The source
+// must be initialized with a connection from SourceConnection before it's
+// scanned. The plugin runs until Shutdown is called.
+func StartSource(path string) (sources.Source, error) {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          plugin.PluginSet{sourcePluginName: &sourcePlugin{}},
+		Cmd:              exec.Command(path),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Managed:          true,
+		Logger:           hclog.NewNullLogger(),
+	})
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, fmt.Errorf("could not start source plugin %s: %w", path, err)
+	}
+	raw, err := rpcClient.Dispense(sourcePluginName)
+	if err != nil {
+		return nil, fmt.Errorf("could not start source plugin %s: %w", path, err)
+	}
+	s, ok := raw.(*source)
+	if !ok {
+		return nil, fmt.Errorf("unexpected plugin type %T", raw)
+	}
+	return s, nil
+}
+
+// sourcePlugin serves and dispenses sources over gRPC.
+type sourcePlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	source Source
+}
+
+func (p *sourcePlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	pluginspb.RegisterSourceServer(s, &sourceServer{source: p.source})
+	return nil
+}
+
+func (p *sourcePlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &source{client: pluginspb.NewSourceClient(c)}, nil
+}
+
+// sourceServer exposes a source to trufflehog.
+type sourceServer struct {
+	pluginspb.UnimplementedSourceServer
+
+	source Source
+}
+
+func (s *sourceServer) Init(ctx context.Context, req *pluginspb.SourceInitRequest) (*pluginspb.SourceInitResponse, error) {
+	name, err := s.source.Init(logContext.AddLogger(ctx), req.GetOptions(), int(req.GetConcurrency()))
+	if err != nil {
+		return nil, err
+	}
+	return &pluginspb.SourceInitResponse{Name: name}, nil
+}
+
+func (s *sourceServer) Enumerate(_ *pluginspb.EnumerateRequest, stream pluginspb.Source_EnumerateServer) error {
+	return s.source.Enumerate(logContext.AddLogger(stream.Context()), func(unit Unit) error {
+		return stream.Send(&pluginspb.SourceUnit{Id: unit.ID, Kind: unit.Kind})
+	})
+}
+
+func (s *sourceServer) Chunks(req *pluginspb.ChunksRequest, stream pluginspb.Source_ChunksServer) error {
+	unit := Unit{ID: req.GetUnit().GetId(), Kind: req.GetUnit().GetKind()}
+	return s.source.Chunks(logContext.AddLogger(stream.Context()), unit, func(chunk *sources.Chunk) error {
+		return stream.Send(&pluginspb.Chunk{Data: chunk.Data, SourceMetadata: chunk.SourceMetadata})
+	})
+}
+
+// source is a source running in a plugin.
+type source struct {
+	client pluginspb.SourceClient
+
+	name     string
+	jobID    int64
+	sourceID int64
+	verify   bool
+	sources.Progress
+}
+
+// Ensure the source satisfies the interface at compile time.
+var _ sources.Source = (*source)(nil)
+
+func (s *source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_PLUGIN
+}
+
+func (s *source) SourceID() int64 {
+	return s.sourceID
+}
+
+func (s *source) JobID() int64 {
+	return s.jobID
+}
+
+// Init initializes the plugin with the options of the connection, which is
+// made with SourceConnection. The name of the source is the one the plugin
+// reports, if any.
+func (s *source) Init(ctx logContext.Context, name string, jobID, sourceID int64, verify bool, connection *anypb.Any, concurrency int) error {
+	conn := &pluginspb.SourceInitRequest{}
+	if connection != nil {
+		if err := connection.UnmarshalTo(conn); err != nil {
+			return fmt.Errorf("error unmarshalling connection: %w", err)
+		}
+	}
+	conn.Concurrency = int64(concurrency)
+	resp, err := s.client.Init(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("could not initialize source plugin: %w", err)
+	}
+
+	s.name = name
+	if resp.GetName() != "" {
+		s.name = resp.GetName()
+	}
+	s.jobID = jobID
+	s.sourceID = sourceID
+	s.verify = verify
+	return nil
+}
+
+// Chunks enumerates the units of the plugin, then scans them in order. Errors
+// scanning a unit are logged, and the scan continues with the next one.
+func (s *source) Chunks(ctx logContext.Context, chunksChan chan *sources.Chunk) error {
+	units, err := s.enumerate(ctx)
+	if err != nil {
+		return err
+	}
+	for i, unit := range units {
+		s.SetProgressComplete(i, len(units), fmt.Sprintf("Unit: %s", unit.GetId()), "")
+		if err := s.chunks(ctx, unit, chunksChan); err != nil {
+			ctx.Logger().Error(err, "could not scan unit of source plugin", "unit", unit.GetId(), "kind", unit.GetKind())
+		}
+	}
+	s.SetProgressComplete(len(units), len(units), "Completed source plugin scan", "")
+	return nil
+}
+
+func (s *source) enumerate(ctx context.Context) ([]*pluginspb.SourceUnit, error) {
+	stream, err := s.client.Enumerate(ctx, &pluginspb.EnumerateRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not enumerate source plugin %s: %w", s.name, err)
+	}
+	var units []*pluginspb.SourceUnit
+	for {
+		unit, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return units, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not enumerate source plugin %s: %w", s.name, err)
+		}
+		units = append(units, unit)
+	}
+}
+
+func (s *source) chunks(ctx context.Context, unit *pluginspb.SourceUnit, chunksChan chan *sources.Chunk) error {
+	stream, err := s.client.Chunks(ctx, &pluginspb.ChunksRequest{Unit: unit})
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case chunksChan <- &sources.Chunk{
+			SourceName:     s.name,
+			SourceID:       s.sourceID,
+			SourceType:     s.Type(),
+			SourceMetadata: chunk.GetSourceMetadata(),
+			Data:           chunk.GetData(),
+			Verify:         s.verify,
+		}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
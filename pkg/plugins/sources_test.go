@@ -0,0 +1,97 @@
+package plugins
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// fakeSource has a page per unit, and fails to scan the unit "locked".
+type fakeSource struct {
+	options map[string]string
+}
+
+func (f *fakeSource) Init(_ logContext.Context, options map[string]string, _ int) (string, error) {
+	f.options = options
+	return "wiki", nil
+}
+
+func (f *fakeSource) Enumerate(_ logContext.Context, report func(Unit) error) error {
+	for _, id := range []string{"engineering", "locked", "security"} {
+		if err := report(Unit{ID: id, Kind: "space"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeSource) Chunks(_ logContext.Context, unit Unit, send func(*sources.Chunk) error) error {
+	if unit.ID == "locked" {
+		return errors.New("access denied")
+	}
+	return send(&sources.Chunk{
+		Data: []byte("page of " + unit.ID + " on " + f.options["url"]),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Plugin{Plugin: &source_metadatapb.Plugin{Unit: unit.ID, Line: 1}},
+		},
+	})
+}
+
+func TestSourcePlugin(t *testing.T) {
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		sourcePluginName: &sourcePlugin{source: &fakeSource{}},
+	})
+	t.Cleanup(func() { client.Close() })
+	raw, err := client.Dispense(sourcePluginName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := raw.(*source)
+
+	ctx := logContext.Background()
+	conn, err := SourceConnection(map[string]string{"url": "https://wiki.corp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(ctx, "trufflehog - plugin", 0, 7, true, conn, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	chunksChan := make(chan *sources.Chunk, 10)
+	if err := s.Chunks(ctx, chunksChan); err != nil {
+		t.Fatal(err)
+	}
+	close(chunksChan)
+	var got []*sources.Chunk
+	for chunk := range chunksChan {
+		got = append(got, chunk)
+	}
+
+	chunk := func(unit string) *sources.Chunk {
+		return &sources.Chunk{
+			SourceName: "wiki",
+			SourceID:   7,
+			SourceType: sourcespb.SourceType_SOURCE_TYPE_PLUGIN,
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Plugin{Plugin: &source_metadatapb.Plugin{Unit: unit, Line: 1}},
+			},
+			Data:   []byte("page of " + unit + " on https://wiki.corp"),
+			Verify: true,
+		}
+	}
+	want := []*sources.Chunk{chunk("engineering"), chunk("security")}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("chunks diff: (-want +got)\n%s", diff)
+	}
+	if s.GetProgress().PercentComplete != 100 {
+		t.Errorf("expected the scan to be complete, got %d%%", s.GetProgress().PercentComplete)
+	}
+}
@@ -0,0 +1,325 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// WASM detectors are modules compiled to WebAssembly. They run without access
+// to the network, filesystem, environment or clock of the host, and can only
+// reach the outside world through the http_request function trufflehog
+// imports into them, which is only available while verifying.
+//
+// A module exports its memory as "memory" and the functions below. Strings
+// and buffers are passed as a pointer and length packed into a 64 bit value,
+// ptr<<32 | len.
+//
+//	alloc(size i32) i32                          // Allocates a buffer for the host to write input into.
+//	name() i64                                   // The detector name, reported in results.
+//	keywords() i64                               // A JSON array of keywords.
+//	from_data(ptr i32, len i32, verify i32) i64  // A JSON array of results for the data.
+//
+// A result has the fields raw, raw_v2, redacted, verified and extra_data.
+//
+// The module can import http_request from the "trufflehog" module:
+//
+//	http_request(ptr i32, len i32) i64
+//
+// It takes a JSON request with the fields method, url, headers and body, and
+// returns a JSON response with the fields status and body, or 0 if the request
+// could not be sent. Requests go through the same allowlist, rate limits and
+// retries as the requests of built-in detectors.
+
+const (
+	// wasmMemoryLimitPages caps the memory of a module at 64 MiB.
+	wasmMemoryLimitPages = 1024
+	// wasmCallTimeout stops modules that don't return, e.g. because of an
+	// infinite loop.
+	wasmCallTimeout = 30 * time.Second
+	// wasmMaxResponseSize caps the size of response bodies passed to modules.
+	wasmMaxResponseSize = 1 << 20
+)
+
+var (
+	runtimesMu sync.Mutex
+	runtimes   []wazero.Runtime
+)
+
+// wasmDetector is a detector compiled to WebAssembly.
+type wasmDetector struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	// instances are idle instances of the module. Instances aren't safe for
+	// concurrent use, so each FromData call takes its own.
+	instances sync.Pool
+
+	name     string
+	keywords []string
+}
+
+// Ensure the detector satisfies the interface at compile time.
+var _ detectors.Detector = (*wasmDetector)(nil)
+
+// loadWASM compiles the WebAssembly module at path.
+func loadWASM(ctx context.Context, path string) (*wasmDetector, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(wasmMemoryLimitPages).
+		WithCloseOnContextDone(true))
+	d, err := newWASMDetector(ctx, runtime, code)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+
+	runtimesMu.Lock()
+	runtimes = append(runtimes, runtime)
+	runtimesMu.Unlock()
+	return d, nil
+}
+
+func newWASMDetector(ctx context.Context, runtime wazero.Runtime, code []byte) (*wasmDetector, error) {
+	// Modules built for WASI get its functions, but no files, environment,
+	// arguments or output.
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, err
+	}
+	_, err := runtime.NewHostModuleBuilder("trufflehog").
+		NewFunctionBuilder().WithFunc(httpRequest).Export("http_request").
+		Instantiate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := runtime.CompileModule(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &wasmDetector{runtime: runtime, compiled: compiled}
+	mod, err := d.instance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer d.release(mod)
+
+	name, err := call(ctx, mod, "name")
+	if err != nil {
+		return nil, err
+	}
+	d.name = string(name)
+	keywords, err := call(ctx, mod, "keywords")
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(keywords, &d.keywords); err != nil {
+		return nil, fmt.Errorf("invalid keywords: %w", err)
+	}
+	return d, nil
+}
+
+// instance returns an idle instance of the module, or a new one.
+func (d *wasmDetector) instance(ctx context.Context) (api.Module, error) {
+	if mod, ok := d.instances.Get().(api.Module); ok {
+		return mod, nil
+	}
+	// Modules without a name can be instantiated more than once.
+	config := wazero.NewModuleConfig().WithName("").WithStartFunctions("_initialize")
+	return d.runtime.InstantiateModule(ctx, d.compiled, config)
+}
+
+func (d *wasmDetector) release(mod api.Module) {
+	d.instances.Put(mod)
+}
+
+func (d *wasmDetector) FromData(ctx context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, wasmCallTimeout)
+	defer cancel()
+
+	mod, err := d.instance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output, err := d.fromData(context.WithValue(ctx, verifyKey{}, verify), mod, verify, data)
+	if err != nil {
+		// The instance may be in a bad state after a trap.
+		_ = mod.Close(ctx)
+		return nil, fmt.Errorf("wasm detector %s: %w", d.name, err)
+	}
+	d.release(mod)
+
+	var wasmResults []wasmResult
+	if err := json.Unmarshal(output, &wasmResults); err != nil {
+		return nil, fmt.Errorf("wasm detector %s: invalid results: %w", d.name, err)
+	}
+	results := make([]detectors.Result, 0, len(wasmResults))
+	for _, r := range wasmResults {
+		result := detectors.Result{
+			DetectorType: detectorspb.DetectorType_CustomRegex,
+			DetectorName: d.name,
+			Verified:     verify && r.Verified,
+			Raw:          []byte(r.Raw),
+			Redacted:     r.Redacted,
+			ExtraData:    r.ExtraData,
+		}
+		if r.RawV2 != "" {
+			result.RawV2 = []byte(r.RawV2)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *wasmDetector) fromData(ctx context.Context, mod api.Module, verify bool, data []byte) ([]byte, error) {
+	ptr, err := write(ctx, mod, data)
+	if err != nil {
+		return nil, err
+	}
+	var verifyArg uint64
+	if verify {
+		verifyArg = 1
+	}
+	return call(ctx, mod, "from_data", uint64(ptr), uint64(len(data)), verifyArg)
+}
+
+func (d *wasmDetector) Keywords() []string {
+	return d.keywords
+}
+
+func (d *wasmDetector) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_CustomRegex
+}
+
+type wasmResult struct {
+	Raw       string            `json:"raw"`
+	RawV2     string            `json:"raw_v2"`
+	Redacted  string            `json:"redacted"`
+	Verified  bool              `json:"verified"`
+	ExtraData map[string]string `json:"extra_data"`
+}
+
+type wasmRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+type wasmResponse struct {
+	Status int    `json:"status"`
+	Body   string `json:"body"`
+}
+
+// verifyKey marks the contexts of calls that may verify.
+type verifyKey struct{}
+
+var wasmHTTPClient = common.SaneHttpClient()
+
+// httpRequest sends an HTTP request for a module. It returns 0 if the module
+// isn't verifying or the request fails.
+func httpRequest(ctx context.Context, mod api.Module, ptr, size uint32) uint64 {
+	if verify, _ := ctx.Value(verifyKey{}).(bool); !verify {
+		return 0
+	}
+	input, ok := mod.Memory().Read(ptr, size)
+	if !ok {
+		return 0
+	}
+	var wasmReq wasmRequest
+	if err := json.Unmarshal(input, &wasmReq); err != nil {
+		return 0
+	}
+	if wasmReq.Method == "" {
+		wasmReq.Method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, wasmReq.Method, wasmReq.URL, bytes.NewBufferString(wasmReq.Body))
+	if err != nil {
+		return 0
+	}
+	for key, value := range wasmReq.Headers {
+		req.Header.Set(key, value)
+	}
+	res, err := wasmHTTPClient.Do(req)
+	if err != nil {
+		return 0
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, wasmMaxResponseSize))
+	if err != nil {
+		return 0
+	}
+
+	output, err := json.Marshal(wasmResponse{Status: res.StatusCode, Body: string(body)})
+	if err != nil {
+		return 0
+	}
+	outPtr, err := write(ctx, mod, output)
+	if err != nil {
+		return 0
+	}
+	return uint64(outPtr)<<32 | uint64(len(output))
+}
+
+// write copies data into a buffer allocated by the module.
+func write(ctx context.Context, mod api.Module, data []byte) (uint32, error) {
+	alloc := mod.ExportedFunction("alloc")
+	if alloc == nil {
+		return 0, fmt.Errorf("module doesn't export alloc")
+	}
+	ret, err := alloc.Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, err
+	}
+	ptr := uint32(ret[0])
+	if !mod.Memory().Write(ptr, data) {
+		return 0, fmt.Errorf("alloc returned a buffer out of memory bounds")
+	}
+	return ptr, nil
+}
+
+// call calls a function of the module returning a packed pointer and length,
+// and returns a copy of the memory it points to.
+func call(ctx context.Context, mod api.Module, name string, params ...uint64) ([]byte, error) {
+	fn := mod.ExportedFunction(name)
+	if fn == nil {
+		return nil, fmt.Errorf("module doesn't export %s", name)
+	}
+	ret, err := fn.Call(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+	ptr, size := uint32(ret[0]>>32), uint32(ret[0])
+	output, ok := mod.Memory().Read(ptr, size)
+	if !ok {
+		return nil, fmt.Errorf("%s returned a buffer out of memory bounds", name)
+	}
+	return bytes.Clone(output), nil
+}
+
+// closeWASM closes the runtimes of the WASM detectors.
+func closeWASM() {
+	runtimesMu.Lock()
+	defer runtimesMu.Unlock()
+	for _, runtime := range runtimes {
+		_ = runtime.Close(context.Background())
+	}
+	runtimes = nil
+}
@@ -0,0 +1,243 @@
+package plugins
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tetratelabs/wazero"
+
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// wasmModule assembles a module that implements the detector ABI with
+// constant outputs.
+func wasmModule(name, keywords, results string) []byte {
+	const (
+		nameAt     = 0
+		keywordsAt = 256
+		resultsAt  = 512
+		inputAt    = 4096
+	)
+	packed := func(ptr, size int) []byte {
+		return append([]byte{0x42}, sleb128(int64(ptr)<<32|int64(size))...)
+	}
+
+	var module []byte
+	module = append(module, 0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00)
+	// Types: () -> i64, (i32) -> i32, (i32, i32, i32) -> i64.
+	module = append(module, section(1, vector(
+		[]byte{0x60, 0x00, 0x01, 0x7e},
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x01, 0x7e},
+	))...)
+	// Functions: alloc, name, keywords, from_data.
+	module = append(module, section(3, vector([]byte{1}, []byte{0}, []byte{0}, []byte{2}))...)
+	// One page of memory.
+	module = append(module, section(5, vector([]byte{0x00, 0x01}))...)
+	module = append(module, section(7, vector(
+		append(wasmName("memory"), 0x02, 0x00),
+		append(wasmName("alloc"), 0x00, 0x00),
+		append(wasmName("name"), 0x00, 0x01),
+		append(wasmName("keywords"), 0x00, 0x02),
+		append(wasmName("from_data"), 0x00, 0x03),
+	))...)
+	module = append(module, section(10, vector(
+		body(append([]byte{0x41}, sleb128(inputAt)...)),
+		body(packed(nameAt, len(name))),
+		body(packed(keywordsAt, len(keywords))),
+		body(packed(resultsAt, len(results))),
+	))...)
+	module = append(module, section(11, vector(
+		data(nameAt, name),
+		data(keywordsAt, keywords),
+		data(resultsAt, results),
+	))...)
+	return module
+}
+
+func section(id byte, contents []byte) []byte {
+	return append(append([]byte{id}, uleb128(uint64(len(contents)))...), contents...)
+}
+
+func vector(items ...[]byte) []byte {
+	v := uleb128(uint64(len(items)))
+	for _, item := range items {
+		v = append(v, item...)
+	}
+	return v
+}
+
+func wasmName(s string) []byte {
+	return append(uleb128(uint64(len(s))), s...)
+}
+
+func body(instructions []byte) []byte {
+	code := append([]byte{0x00}, instructions...)
+	code = append(code, 0x0b)
+	return append(uleb128(uint64(len(code))), code...)
+}
+
+func data(offset int, s string) []byte {
+	segment := append([]byte{0x00, 0x41}, sleb128(int64(offset))...)
+	segment = append(segment, 0x0b)
+	return append(segment, wasmName(s)...)
+}
+
+func uleb128(v uint64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+		if v == 0 {
+			return out
+		}
+	}
+}
+
+func sleb128(v int64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func writeWASM(t *testing.T, dir string, module []byte) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, "corp.wasm"), module, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadWASM(t *testing.T) {
+	dir := t.TempDir()
+	writeWASM(t, dir, wasmModule("corp", `["corp_"]`,
+		`[{"raw":"corp_1234","redacted":"corp_","verified":true,"extra_data":{"team":"security"}}]`))
+	t.Cleanup(Shutdown)
+
+	loaded, err := Load(logContext.Background(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 1 {
+		t.Fatalf("expected 1 detector, got %d", len(loaded))
+	}
+	d := loaded[0]
+	if diff := cmp.Diff([]string{"corp_"}, d.Keywords()); diff != "" {
+		t.Errorf("keywords diff: (-want +got)\n%s", diff)
+	}
+
+	tests := []struct {
+		name   string
+		verify bool
+		want   []detectors.Result
+	}{
+		{
+			name:   "verify",
+			verify: true,
+			want: []detectors.Result{{
+				DetectorType: detectorspb.DetectorType_CustomRegex,
+				DetectorName: "corp",
+				Verified:     true,
+				Raw:          []byte("corp_1234"),
+				Redacted:     "corp_",
+				ExtraData:    map[string]string{"team": "security"},
+			}},
+		},
+		{
+			name:   "no verification",
+			verify: false,
+			want: []detectors.Result{{
+				DetectorType: detectorspb.DetectorType_CustomRegex,
+				DetectorName: "corp",
+				Raw:          []byte("corp_1234"),
+				Redacted:     "corp_",
+				ExtraData:    map[string]string{"team": "security"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := d.FromData(context.Background(), tt.verify, []byte("token corp_1234"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, results, cmpopts.IgnoreUnexported(detectors.Result{})); diff != "" {
+				t.Errorf("results diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadWASMInvalidKeywords(t *testing.T) {
+	dir := t.TempDir()
+	writeWASM(t, dir, wasmModule("corp", `corp_`, `[]`))
+	t.Cleanup(Shutdown)
+
+	if _, err := Load(logContext.Background(), dir); err == nil {
+		t.Error("expected an error loading a module with invalid keywords")
+	}
+}
+
+func TestWASMHTTPRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer corp_1234" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"user":"alice"}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	runtime := wazero.NewRuntime(ctx)
+	defer runtime.Close(ctx)
+	d, err := newWASMDetector(ctx, runtime, wasmModule("corp", `["corp_"]`, `[]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := d.instance(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := `{"method":"GET","url":"` + server.URL + `","headers":{"Authorization":"Bearer corp_1234"}}`
+	send := func(verify bool) string {
+		ptr, err := write(ctx, mod, []byte(request))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packed := httpRequest(context.WithValue(ctx, verifyKey{}, verify), mod, ptr, uint32(len(request)))
+		if packed == 0 {
+			return ""
+		}
+		output, ok := mod.Memory().Read(uint32(packed>>32), uint32(packed))
+		if !ok {
+			t.Fatal("response out of memory bounds")
+		}
+		return string(output)
+	}
+
+	if got, want := send(true), `{"status":200,"body":"{\"user\":\"alice\"}"}`; got != want {
+		t.Errorf("expected response %s, got %s", want, got)
+	}
+	if got := send(false); got != "" {
+		t.Errorf("expected no response without verification, got %s", got)
+	}
+}
@@ -0,0 +1,117 @@
+// Package processors runs programs that process the results of a scan before
+// they're output, so that results can be dropped, enriched or transformed
+// with rules of an organization without changing the detectors.
+package processors
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/output"
+)
+
+// Command is a program that processes results. It reads a result per line on
+// its standard input, in the JSON format of --json, and answers each with a
+// line on its standard output: null to drop the result, or a JSON object
+// whose ExtraData and Redacted, if set, replace those of the result.
+type Command struct {
+	path   string
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+
+	// mu keeps the results in the order the program answers them.
+	mu sync.Mutex
+	// err is set once the program failed, after which results are kept
+	// unprocessed.
+	err error
+}
+
+// Start starts a processor program. Its standard error goes to the standard
+// error of the scan.
+func Start(path string, args ...string) (*Command, error) {
+	cmd := exec.Command(path, args...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("could not start result processor %s: %w", path, err)
+	}
+	return &Command{path: path, cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+// answer is the answer of a program to a result.
+type answer struct {
+	ExtraData map[string]string
+	Redacted  *string
+}
+
+// Process processes a result with the program, and reports whether it's
+// kept. It has the signature of engine.ResultProcessor. If the program
+// fails, the result is kept, so that no finding is lost to a broken
+// processor.
+func (c *Command) Process(ctx context.Context, r *detectors.ResultWithMetadata) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return true
+	}
+
+	a, err := c.ask(r)
+	if err != nil {
+		c.err = err
+		ctx.Logger().Error(err, "result processor failed, the results are output unprocessed", "processor", c.path)
+		return true
+	}
+	if a == nil {
+		return false
+	}
+	if a.ExtraData != nil {
+		r.ExtraData = a.ExtraData
+	}
+	if a.Redacted != nil {
+		r.Redacted = *a.Redacted
+	}
+	return true
+}
+
+func (c *Command) ask(r *detectors.ResultWithMetadata) (*answer, error) {
+	line, err := output.MarshalJSON(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.stdin.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("could not send the result: %w", err)
+	}
+	reply, err := c.stdout.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("could not read the answer: %w", err)
+	}
+	var a *answer
+	if err := json.Unmarshal(bytes.TrimSpace(reply), &a); err != nil {
+		return nil, fmt.Errorf("invalid answer %q: %w", bytes.TrimSpace(reply), err)
+	}
+	return a, nil
+}
+
+// Close closes the standard input of the program, and waits for it to exit.
+func (c *Command) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return errors.Join(c.stdin.Close(), c.cmd.Wait())
+}
@@ -0,0 +1,55 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func result(raw string) *detectors.ResultWithMetadata {
+	return &detectors.ResultWithMetadata{Result: detectors.Result{
+		DetectorType: detectorspb.DetectorType_AWS,
+		Raw:          []byte(raw),
+		Redacted:     raw,
+	}}
+}
+
+func TestCommand(t *testing.T) {
+	ctx := context.Background()
+	c, err := Start("sh", "-c", `
+while IFS= read -r line; do
+	case "$line" in
+	*'"Raw":"allowed'*) echo null ;;
+	*) echo '{"ExtraData":{"team":"security"},"Redacted":"AKIA****"}' ;;
+	esac
+done`)
+	assert.NoError(t, err)
+
+	assert.False(t, c.Process(ctx, result("allowed_key")))
+	r := result("AKIA1234")
+	assert.True(t, c.Process(ctx, r))
+	assert.Equal(t, map[string]string{"team": "security"}, r.ExtraData)
+	assert.Equal(t, "AKIA****", r.Redacted)
+	assert.NoError(t, c.Close())
+}
+
+func TestCommandFailure(t *testing.T) {
+	ctx := context.Background()
+	c, err := Start("sh", "-c", `read -r line; echo 'not json'`)
+	assert.NoError(t, err)
+
+	// Results are kept unprocessed once the program fails.
+	r := result("AKIA1234")
+	assert.True(t, c.Process(ctx, r))
+	assert.Nil(t, r.ExtraData)
+	assert.True(t, c.Process(ctx, result("allowed_key")))
+	assert.Error(t, c.err)
+	_ = c.Close()
+
+	_, err = Start("/nonexistent/processor")
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+// Package remediation provides guidance for rotating the secrets found by the
+// detectors, from the knowledge base in remediation.yaml.
+package remediation
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+//go:embed "remediation.yaml"
+var knowledgeBase []byte
+
+// Guidance tells how to rotate the secret of a finding, and who to ask.
+type Guidance struct {
+	// Docs links to the provider's instructions for rotating or revoking the
+	// secret.
+	Docs string `json:"docs,omitempty"`
+	// Rotate is the API call or command that rotates or revokes the secret.
+	Rotate string `json:"rotate,omitempty"`
+	// Owner hints at how to find who owns the secret.
+	Owner string `json:"owner,omitempty"`
+	// Steps are what to do, in order.
+	Steps []string `json:"steps,omitempty"`
+}
+
+var (
+	loadOnce sync.Once
+	guidance map[detectorspb.DetectorType]*Guidance
+	loadErr  error
+)
+
+// For returns the guidance for the findings of a detector type, or nil if the
+// knowledge base has none.
+func For(detectorType detectorspb.DetectorType) *Guidance {
+	loadOnce.Do(func() {
+		guidance, loadErr = parse(knowledgeBase)
+	})
+	if loadErr != nil {
+		// The knowledge base is embedded, and its test checks that it parses.
+		panic(loadErr)
+	}
+	return guidance[detectorType]
+}
+
+// parse parses a knowledge base keyed by the names of detector types.
+func parse(input []byte) (map[detectorspb.DetectorType]*Guidance, error) {
+	var entries map[string]*Guidance
+	if err := yaml.UnmarshalStrict(input, &entries); err != nil {
+		return nil, fmt.Errorf("could not parse the remediation knowledge base: %w", err)
+	}
+	byType := make(map[detectorspb.DetectorType]*Guidance, len(entries))
+	for name, g := range entries {
+		detectorType, ok := typeOf(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown detector %q in the remediation knowledge base", name)
+		}
+		byType[detectorType] = g
+	}
+	return byType, nil
+}
+
+// typeOf returns the detector type with a case-insensitive name.
+func typeOf(name string) (detectorspb.DetectorType, bool) {
+	for value, typeName := range detectorspb.DetectorType_name {
+		if strings.EqualFold(typeName, name) {
+			return detectorspb.DetectorType(value), true
+		}
+	}
+	return 0, false
+}
+
+// String formats the guidance as plain text, e.g. for the description of a
+// ticket.
+func (g *Guidance) String() string {
+	var b strings.Builder
+	if g.Docs != "" {
+		fmt.Fprintf(&b, "Docs: %s\n", g.Docs)
+	}
+	if g.Rotate != "" {
+		fmt.Fprintf(&b, "Rotate: %s\n", g.Rotate)
+	}
+	if g.Owner != "" {
+		fmt.Fprintf(&b, "Owner: %s\n", g.Owner)
+	}
+	for i, step := range g.Steps {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, step)
+	}
+	return b.String()
+}
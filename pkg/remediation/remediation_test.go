@@ -0,0 +1,47 @@
+package remediation
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+func TestKnowledgeBase(t *testing.T) {
+	entries, err := parse(knowledgeBase)
+	assert.NoError(t, err)
+	for detectorType, g := range entries {
+		assert.NotEmpty(t, g.Rotate, detectorType.String())
+		if g.Docs != "" {
+			u, err := url.Parse(g.Docs)
+			assert.NoError(t, err, detectorType.String())
+			assert.Equal(t, "https", u.Scheme, detectorType.String())
+		}
+	}
+
+	assert.NotNil(t, For(detectorspb.DetectorType_AWS))
+	assert.Nil(t, For(detectorspb.DetectorType_CustomRegex))
+}
+
+func TestParse(t *testing.T) {
+	entries, err := parse([]byte(`
+GitHub:
+  docs: https://docs.example.com
+  rotate: revoke it
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, &Guidance{Docs: "https://docs.example.com", Rotate: "revoke it"}, entries[detectorspb.DetectorType_Github])
+
+	_, err = parse([]byte("nosuchdetector:\n  rotate: revoke it\n"))
+	assert.Error(t, err)
+
+	_, err = parse([]byte("github:\n  rotation: revoke it\n"))
+	assert.Error(t, err)
+}
+
+func TestGuidanceString(t *testing.T) {
+	g := &Guidance{Docs: "https://docs.example.com", Owner: "the admins", Steps: []string{"revoke", "replace"}}
+	assert.Equal(t, "Docs: https://docs.example.com\nOwner: the admins\n1. revoke\n2. replace\n", g.String())
+}
@@ -0,0 +1,120 @@
+package circleci
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// maxArtifactSize caps the size of the artifacts that are downloaded.
+const maxArtifactSize = 100 * 1024 * 1024
+
+type artifact struct {
+	Path string `json:"path"`
+	URL  string `json:"url"`
+}
+
+// scanArtifacts downloads and scans the artifacts stored for a build.
+func (s *Source) scanArtifacts(ctx context.Context, proj project, bld build, chunksChan chan *sources.Chunk) error {
+	reqURL := fmt.Sprintf("%sproject/%s/%s/%s/%d/artifacts", baseURL, proj.VCS, proj.Username, proj.RepoName, bld.BuildNum)
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Circle-Token", s.token)
+	req.Header.Set("Accept", "application/json")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var artifacts []artifact
+	if err := json.NewDecoder(res.Body).Decode(&artifacts); err != nil {
+		return err
+	}
+
+	for _, art := range artifacts {
+		if !s.pathFilter.Pass(art.Path) {
+			ctx.Logger().V(5).Info("skipping artifact excluded by the path filters", "build", bld.BuildNum, "artifact", art.Path)
+			continue
+		}
+		if err := s.scanArtifact(ctx, proj, bld, art, chunksChan); err != nil {
+			ctx.Logger().V(2).Info("could not scan artifact", "build", bld.BuildNum, "artifact", art.Path, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanArtifact(ctx context.Context, proj project, bld build, art artifact, chunksChan chan *sources.Chunk) error {
+	req, err := http.NewRequest("GET", art.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Circle-Token", s.token)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	if res.ContentLength > maxArtifactSize {
+		return fmt.Errorf("artifact is larger than %d bytes", maxArtifactSize)
+	}
+	if !s.pathFilter.PassSize(res.ContentLength) {
+		ctx.Logger().V(5).Info("skipping artifact larger than the max size of the path filters", "build", bld.BuildNum, "artifact", art.Path)
+		return nil
+	}
+
+	reader, err := diskbufferreader.New(io.LimitReader(res.Body, maxArtifactSize))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType: s.Type(),
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Circleci{
+				Circleci: &source_metadatapb.CircleCI{
+					VcsType:     proj.VCS,
+					Username:    proj.Username,
+					Repository:  proj.RepoName,
+					BuildNumber: int64(bld.BuildNum),
+					Link:        sanitizer.UTF8(art.URL),
+					Artifact:    sanitizer.UTF8(art.Path),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+
+	if err := reader.Reset(); err != nil {
+		return err
+	}
+	reader.Stop()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	chunksChan <- &chunk
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"sync/atomic"
+	"time"
 
 	"github.com/go-errors/errors"
 	"golang.org/x/sync/errgroup"
@@ -20,7 +22,13 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
-const baseURL = "https://circleci.com/api/v1.1/"
+const (
+	baseURL   = "https://circleci.com/api/v1.1/"
+	baseURLv2 = "https://circleci.com/api/v2/"
+
+	// buildsPageSize is the largest number of builds the API returns at once.
+	buildsPageSize = 100
+)
 
 type Source struct {
 	name     string
@@ -31,6 +39,12 @@ type Source struct {
 	jobPool  *errgroup.Group
 	sources.Progress
 	client *http.Client
+
+	includeArtifacts bool
+	auditEnvVars     bool
+	filter           buildFilter
+	// pathFilter filters the artifacts of the builds by their paths.
+	pathFilter *common.Filter
 }
 
 // Ensure the Source satisfies the interface at compile time.
@@ -69,16 +83,36 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 	case *sourcespb.CircleCI_Token:
 		s.token = conn.GetToken()
 	}
+	s.includeArtifacts = conn.GetIncludeArtifacts()
+	s.auditEnvVars = conn.GetAuditEnvVars()
+	s.filter = buildFilter{
+		branches:  conn.GetBranches(),
+		workflows: conn.GetWorkflows(),
+	}
+	if conn.GetSince() != nil {
+		s.filter.since = conn.GetSince().AsTime()
+	}
+	if conn.GetUntil() != nil {
+		s.filter.until = conn.GetUntil().AsTime()
+	}
 
 	return nil
 }
 
+// WithFilter sets the path filters that the artifacts of the builds must pass.
+func (s *Source) WithFilter(filter *common.Filter) {
+	s.pathFilter = filter
+}
+
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
 	projects, err := s.projects(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting projects: %w", err)
 	}
+	if s.auditEnvVars {
+		s.logEnvVars(ctx, projects)
+	}
 
 	var scanned uint64
 	scanErrs := sources.NewScanErrors()
@@ -107,6 +141,12 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 						}
 					}
 				}
+
+				if s.includeArtifacts {
+					if err := s.scanArtifacts(ctx, proj, bld, chunksChan); err != nil {
+						scanErrs.Add(fmt.Errorf("error scanning artifacts of build %d: %w", bld.BuildNum, err))
+					}
+				}
 			}
 
 			atomic.AddUint64(&scanned, 1)
@@ -156,31 +196,104 @@ func (s *Source) projects(_ context.Context) ([]project, error) {
 }
 
 type build struct {
-	BuildNum int `json:"build_num"`
+	BuildNum  int        `json:"build_num"`
+	Branch    string     `json:"branch"`
+	StartTime *time.Time `json:"start_time"`
+	Workflows struct {
+		WorkflowName string `json:"workflow_name"`
+	} `json:"workflows"`
 }
 
-func (s *Source) buildsForProject(_ context.Context, proj project) ([]build, error) {
-	reqURL := fmt.Sprintf("%sproject/%s/%s/%s", baseURL, proj.VCS, proj.Username, proj.RepoName)
-	req, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		return nil, err
+// buildFilter selects the builds to scan. Empty fields don't filter.
+type buildFilter struct {
+	branches     []string
+	workflows    []string
+	since, until time.Time
+}
+
+// allows reports whether a build is scanned. Builds that didn't start have no
+// logs or artifacts to scan.
+func (f buildFilter) allows(bld build) bool {
+	if bld.StartTime == nil {
+		return false
 	}
-	req.Header.Set("Circle-Token", s.token)
-	req.Header.Set("Accept", "application/json")
-	res, err := s.client.Do(req)
-	if err != nil {
-		return nil, err
+	if !f.since.IsZero() && bld.StartTime.Before(f.since) {
+		return false
 	}
-	defer res.Body.Close()
+	if !f.until.IsZero() && bld.StartTime.After(f.until) {
+		return false
+	}
+	return len(f.workflows) == 0 || contains(f.workflows, bld.Workflows.WorkflowName)
+}
 
-	var builds []build
-	if err := json.NewDecoder(res.Body).Decode(&builds); err != nil {
-		return nil, err
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// buildsForProject returns the builds of a project that pass the filter, from
+// the builds of each branch of the filter, or of all branches.
+func (s *Source) buildsForProject(ctx context.Context, proj project) ([]build, error) {
+	projectURL := fmt.Sprintf("%sproject/%s/%s/%s", baseURL, proj.VCS, proj.Username, proj.RepoName)
+	if len(s.filter.branches) == 0 {
+		return s.listBuilds(ctx, projectURL)
 	}
 
+	var builds []build
+	for _, branch := range s.filter.branches {
+		branchBuilds, err := s.listBuilds(ctx, projectURL+"/tree/"+url.PathEscape(branch))
+		if err != nil {
+			return nil, err
+		}
+		builds = append(builds, branchBuilds...)
+	}
 	return builds, nil
 }
 
+// listBuilds pages through the builds at a URL, which are listed from the most
+// recent.
+func (s *Source) listBuilds(_ context.Context, buildsURL string) ([]build, error) {
+	var builds []build
+	for offset := 0; ; offset += buildsPageSize {
+		reqURL := fmt.Sprintf("%s?limit=%d&offset=%d", buildsURL, buildsPageSize, offset)
+		req, err := http.NewRequest("GET", reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Circle-Token", s.token)
+		req.Header.Set("Accept", "application/json")
+		res, err := s.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []build
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bld := range page {
+			if s.filter.allows(bld) {
+				builds = append(builds, bld)
+			}
+		}
+		if len(page) < buildsPageSize {
+			return builds, nil
+		}
+		// The rest of the builds started before the range.
+		last := page[len(page)-1]
+		if !s.filter.since.IsZero() && last.StartTime != nil && last.StartTime.Before(s.filter.since) {
+			return builds, nil
+		}
+	}
+}
+
 type action struct {
 	Index     int    `json:"index"`
 	OutputURL string `json:"output_url"`
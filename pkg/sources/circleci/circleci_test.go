@@ -91,3 +91,50 @@ func TestSource_Scan(t *testing.T) {
 		})
 	}
 }
+
+func Test_buildFilter(t *testing.T) {
+	started := func(s string) *time.Time {
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &ts
+	}
+	bld := build{BuildNum: 1, StartTime: started("2023-03-01T12:00:00Z")}
+	bld.Workflows.WorkflowName = "deploy"
+
+	tests := []struct {
+		name   string
+		filter buildFilter
+		build  build
+		want   bool
+	}{
+		{name: "no filter", build: bld, want: true},
+		{name: "not started", build: build{BuildNum: 2}, want: false},
+		{name: "within range", filter: buildFilter{since: *started("2023-03-01T00:00:00Z"), until: *started("2023-03-02T00:00:00Z")}, build: bld, want: true},
+		{name: "before range", filter: buildFilter{since: *started("2023-03-02T00:00:00Z")}, build: bld, want: false},
+		{name: "after range", filter: buildFilter{until: *started("2023-02-28T00:00:00Z")}, build: bld, want: false},
+		{name: "matching workflow", filter: buildFilter{workflows: []string{"test", "deploy"}}, build: bld, want: true},
+		{name: "other workflow", filter: buildFilter{workflows: []string{"test"}}, build: bld, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.allows(tt.build); got != tt.want {
+				t.Errorf("buildFilter.allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ownerSlug(t *testing.T) {
+	tests := map[project]string{
+		{VCS: "github", Username: "trufflesecurity"}:    "gh/trufflesecurity",
+		{VCS: "bitbucket", Username: "trufflesecurity"}: "bb/trufflesecurity",
+		{VCS: "circleci", Username: "trufflesecurity"}:  "circleci/trufflesecurity",
+	}
+	for proj, want := range tests {
+		if got := ownerSlug(proj); got != want {
+			t.Errorf("ownerSlug(%v) = %q, want %q", proj, got, want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package circleci
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// logEnvVars logs the names of the environment variables of the projects, and
+// of the contexts of the organizations they belong to. The API only returns
+// masked values, so there's nothing to scan, but the names show where secrets
+// are stored. Listing contexts requires an organization admin token.
+func (s *Source) logEnvVars(ctx context.Context, projects []project) {
+	orgs := map[string]struct{}{}
+	for _, proj := range projects {
+		reqURL := fmt.Sprintf("%sproject/%s/%s/%s/envvar", baseURL, proj.VCS, proj.Username, proj.RepoName)
+		var vars []struct {
+			Name string `json:"name"`
+		}
+		if err := s.getJSON(reqURL, &vars); err != nil {
+			ctx.Logger().V(2).Info("could not list project environment variables", "project", proj.RepoName, "error", err)
+		} else {
+			names := make([]string, 0, len(vars))
+			for _, v := range vars {
+				names = append(names, v.Name)
+			}
+			ctx.Logger().Info("project environment variables", "project", fmt.Sprintf("%s/%s", proj.Username, proj.RepoName), "names", names)
+		}
+		orgs[ownerSlug(proj)] = struct{}{}
+	}
+
+	for org := range orgs {
+		if err := s.logContextEnvVars(ctx, org); err != nil {
+			ctx.Logger().V(2).Info("could not list contexts", "org", org, "error", err)
+		}
+	}
+}
+
+type circleContext struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (s *Source) logContextEnvVars(ctx context.Context, org string) error {
+	query := url.Values{"owner-slug": {org}}
+	var contexts []circleContext
+	err := s.listPages(baseURLv2+"context", query, func(items json.RawMessage) error {
+		var page []circleContext
+		if err := json.Unmarshal(items, &page); err != nil {
+			return err
+		}
+		contexts = append(contexts, page...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range contexts {
+		var names []string
+		err := s.listPages(baseURLv2+"context/"+url.PathEscape(c.ID)+"/environment-variable", url.Values{}, func(items json.RawMessage) error {
+			var page []struct {
+				Variable string `json:"variable"`
+			}
+			if err := json.Unmarshal(items, &page); err != nil {
+				return err
+			}
+			for _, v := range page {
+				names = append(names, v.Variable)
+			}
+			return nil
+		})
+		if err != nil {
+			ctx.Logger().V(2).Info("could not list context environment variables", "org", org, "context", c.Name, "error", err)
+			continue
+		}
+		ctx.Logger().Info("context environment variables", "org", org, "context", c.Name, "names", names)
+	}
+	return nil
+}
+
+// ownerSlug returns the slug of the organization of a project in the v2 API.
+func ownerSlug(proj project) string {
+	vcs := proj.VCS
+	switch vcs {
+	case "github":
+		vcs = "gh"
+	case "bitbucket":
+		vcs = "bb"
+	}
+	return vcs + "/" + proj.Username
+}
+
+// listPages calls fn with the items of each page of a v2 API list.
+func (s *Source) listPages(listURL string, query url.Values, fn func(items json.RawMessage) error) error {
+	for {
+		var page struct {
+			Items         json.RawMessage `json:"items"`
+			NextPageToken string          `json:"next_page_token"`
+		}
+		reqURL := listURL
+		if len(query) > 0 {
+			reqURL += "?" + query.Encode()
+		}
+		if err := s.getJSON(reqURL, &page); err != nil {
+			return err
+		}
+		if err := fn(page.Items); err != nil {
+			return err
+		}
+		if page.NextPageToken == "" {
+			return nil
+		}
+		query.Set("page-token", page.NextPageToken)
+	}
+}
+
+func (s *Source) getJSON(reqURL string, v any) error {
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Circle-Token", s.token)
+	req.Header.Set("Accept", "application/json")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode > 399 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,162 @@
+package containers
+
+import (
+	stdcontext "context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// DefaultEndpoint is the address of the Docker Engine API on Linux hosts.
+const DefaultEndpoint = "unix:///var/run/docker.sock"
+
+// client is a client of the Docker Engine API, which Podman also serves.
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// newClient returns a client of the Docker Engine API at a unix socket, a
+// tcp:// address, or an http(s):// URL.
+func newClient(endpoint string) (*client, error) {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+
+	switch endpointURL.Scheme {
+	case "unix":
+		socket := endpointURL.Path
+		transport := &http.Transport{
+			DialContext: func(ctx stdcontext.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		}
+		// The host is ignored, since every request is sent to the socket.
+		return &client{baseURL: "http://docker", httpClient: &http.Client{Transport: transport}}, nil
+	case "tcp":
+		return &client{baseURL: "http://" + endpointURL.Host, httpClient: http.DefaultClient}, nil
+	case "http", "https":
+		return &client{baseURL: strings.TrimSuffix(endpoint, "/"), httpClient: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("unsupported endpoint %q, expected a unix://, tcp:// or http(s):// address", endpoint)
+	}
+}
+
+type containerSummary struct {
+	ID    string   `json:"Id"`
+	Names []string `json:"Names"`
+}
+
+type containerInfo struct {
+	ID     string `json:"Id"`
+	Name   string `json:"Name"`
+	Config struct {
+		Image string   `json:"Image"`
+		Env   []string `json:"Env"`
+	} `json:"Config"`
+	Mounts []mount `json:"Mounts"`
+}
+
+type mount struct {
+	Type        string `json:"Type"`
+	Name        string `json:"Name"`
+	Source      string `json:"Source"`
+	Destination string `json:"Destination"`
+}
+
+// change is a path that was changed in the writable layer of a container.
+type change struct {
+	Path string `json:"Path"`
+	Kind int    `json:"Kind"`
+}
+
+// The kinds of changes.
+const (
+	changeModified = 0
+	changeAdded    = 1
+	changeDeleted  = 2
+)
+
+// listContainers returns the running containers, and the stopped ones if all
+// is set.
+func (c *client) listContainers(ctx context.Context, all bool) ([]containerSummary, error) {
+	var containers []containerSummary
+	query := url.Values{"all": {fmt.Sprint(all)}}
+	if err := c.getJSON(ctx, "/containers/json", query, &containers); err != nil {
+		return nil, fmt.Errorf("could not list containers: %w", err)
+	}
+	return containers, nil
+}
+
+// inspect returns the configuration of a container, given by its ID or name.
+func (c *client) inspect(ctx context.Context, container string) (containerInfo, error) {
+	var info containerInfo
+	if err := c.getJSON(ctx, "/containers/"+url.PathEscape(container)+"/json", nil, &info); err != nil {
+		return info, fmt.Errorf("could not inspect container %s: %w", container, err)
+	}
+	return info, nil
+}
+
+// changes returns the paths that were changed in the writable layer of a
+// container.
+func (c *client) changes(ctx context.Context, container string) ([]change, error) {
+	var changes []change
+	if err := c.getJSON(ctx, "/containers/"+url.PathEscape(container)+"/changes", nil, &changes); err != nil {
+		return nil, fmt.Errorf("could not get the changes of container %s: %w", container, err)
+	}
+	return changes, nil
+}
+
+// archive returns a tar archive of a path in a container. The names in the
+// archive are relative to the parent directory of the path.
+func (c *client) archive(ctx context.Context, container, path string) (io.ReadCloser, error) {
+	res, err := c.get(ctx, "/containers/"+url.PathEscape(container)+"/archive", url.Values{"path": {path}})
+	if err != nil {
+		return nil, fmt.Errorf("could not archive %s in container %s: %w", path, container, err)
+	}
+	return res.Body, nil
+}
+
+func (c *client) getJSON(ctx context.Context, path string, query url.Values, v any) error {
+	res, err := c.get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (c *client) get(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		defer res.Body.Close()
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(res.Body).Decode(&apiErr)
+		return nil, fmt.Errorf("unexpected status %d: %s", res.StatusCode, apiErr.Message)
+	}
+	return res, nil
+}
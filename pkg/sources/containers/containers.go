@@ -0,0 +1,307 @@
+package containers
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/go-errors/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// envLocation is the location of the chunks of the environment variables of a
+// container.
+const envLocation = "env"
+
+// Source scans the containers of a Docker daemon as they are on the host: the
+// files they wrote to their writable layer, their mounted volumes, and their
+// environment variables. The files of their images aren't scanned.
+type Source struct {
+	name     string
+	sourceId int64
+	jobId    int64
+	verify   bool
+	client   *client
+	// containers are the IDs or names of the containers to scan. All of them
+	// are scanned if empty.
+	containers     []string
+	includeStopped bool
+	skipVolumes    bool
+	skipEnv        bool
+	filter         *common.Filter
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return sourcespb.SourceType_SOURCE_TYPE_CONTAINERS
+}
+
+func (s *Source) SourceID() int64 {
+	return s.sourceId
+}
+
+func (s *Source) JobID() int64 {
+	return s.jobId
+}
+
+// Init returns an initialized containers source.
+func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.sourceId = sourceId
+	s.jobId = jobId
+	s.verify = verify
+
+	var conn sourcespb.Containers
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
+	}
+
+	client, err := newClient(conn.GetEndpoint())
+	if err != nil {
+		return err
+	}
+	s.client = client
+	s.containers = conn.GetContainers()
+	s.includeStopped = conn.GetIncludeStopped()
+	s.skipVolumes = conn.GetSkipVolumes()
+	s.skipEnv = conn.GetSkipEnv()
+
+	return nil
+}
+
+// WithFilter sets the path filters that the files of the containers must
+// pass.
+func (s *Source) WithFilter(filter *common.Filter) {
+	s.filter = filter
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	containers := s.containers
+	if len(containers) == 0 {
+		summaries, err := s.client.listContainers(ctx, s.includeStopped)
+		if err != nil {
+			return err
+		}
+		for _, summary := range summaries {
+			containers = append(containers, summary.ID)
+		}
+	}
+
+	// Volumes that are mounted in several containers are only scanned once.
+	scannedVolumes := make(map[string]struct{})
+	for i, container := range containers {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		s.SetProgressComplete(i, len(containers), fmt.Sprintf("Container: %s", container), "")
+
+		if err := s.scanContainer(ctx, container, scannedVolumes, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning container", "container", container)
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanContainer(ctx context.Context, container string, scannedVolumes map[string]struct{}, chunksChan chan *sources.Chunk) error {
+	info, err := s.client.inspect(ctx, container)
+	if err != nil {
+		return err
+	}
+	logger := ctx.Logger().WithValues("container", info.ID)
+	meta := &source_metadatapb.Container{
+		ContainerId:   info.ID,
+		ContainerName: strings.TrimPrefix(info.Name, "/"),
+		Image:         sanitizer.UTF8(info.Config.Image),
+	}
+
+	if !s.skipEnv && len(info.Config.Env) > 0 {
+		chunksChan <- &sources.Chunk{
+			SourceType:     s.Type(),
+			SourceName:     s.name,
+			SourceID:       s.SourceID(),
+			Data:           []byte(strings.Join(info.Config.Env, "\n")),
+			SourceMetadata: s.metadata(meta, envLocation, ""),
+			Verify:         s.verify,
+		}
+	}
+
+	changes, err := s.client.changes(ctx, info.ID)
+	if err != nil {
+		return err
+	}
+	for _, layerPath := range layerPaths(changes) {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		if err := s.scanArchive(ctx, info.ID, layerPath.path, layerPath.tree, meta, "", chunksChan); err != nil {
+			logger.Info("error scanning changed path", "path", layerPath.path, "error", err)
+		}
+	}
+
+	if s.skipVolumes {
+		return nil
+	}
+	for _, m := range info.Mounts {
+		if m.Type != "volume" && m.Type != "bind" {
+			continue
+		}
+		volume := m.Name
+		if m.Type == "bind" {
+			volume = m.Source
+		}
+		if _, ok := scannedVolumes[volume]; ok {
+			logger.V(3).Info("skipping volume that was already scanned", "volume", volume)
+			continue
+		}
+		scannedVolumes[volume] = struct{}{}
+		if err := s.scanArchive(ctx, info.ID, m.Destination, true, meta, volume, chunksChan); err != nil {
+			logger.Info("error scanning volume", "volume", volume, "error", err)
+		}
+	}
+	return nil
+}
+
+// layerPath is a path to scan in the writable layer of a container.
+type layerPath struct {
+	path string
+	// tree is set for added directories, whose files are all new. The
+	// directories that were modified are only changed by the files in them,
+	// which are changes of their own.
+	tree bool
+}
+
+// layerPaths returns the paths to scan for the files that were added or
+// modified in the writable layer of a container.
+func layerPaths(changes []change) []layerPath {
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	added := make(map[string]struct{})
+	parents := make(map[string]struct{})
+	for _, c := range changes {
+		if c.Kind == changeAdded {
+			added[c.Path] = struct{}{}
+		}
+		parents[path.Dir(c.Path)] = struct{}{}
+	}
+
+	var paths []layerPath
+	for _, c := range changes {
+		if c.Kind == changeDeleted || hasAddedParent(c.Path, added) {
+			continue
+		}
+		_, isParent := parents[c.Path]
+		switch {
+		case c.Kind == changeAdded:
+			paths = append(paths, layerPath{path: c.Path, tree: isParent})
+		case !isParent:
+			paths = append(paths, layerPath{path: c.Path})
+		}
+	}
+	return paths
+}
+
+func hasAddedParent(p string, added map[string]struct{}) bool {
+	for dir := path.Dir(p); dir != "/" && dir != "."; dir = path.Dir(dir) {
+		if _, ok := added[dir]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// scanArchive scans the files of an archive of a path in a container. Only the
+// file at the path is scanned, unless tree is set.
+func (s *Source) scanArchive(ctx context.Context, container, p string, tree bool, meta *source_metadatapb.Container, volume string, chunksChan chan *sources.Chunk) error {
+	archive, err := s.client.archive(ctx, container, p)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	// The names in the archive are relative to the parent of the path.
+	parent := path.Dir(p)
+	tarReader := tar.NewReader(archive)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read archive: %w", err)
+		}
+		location := path.Join(parent, header.Name)
+		if header.Typeflag == tar.TypeReg && s.filter.PassFile(location, header.Size) {
+			if err := s.scanFile(ctx, tarReader, s.metadata(meta, location, volume), chunksChan); err != nil {
+				ctx.Logger().Info("error scanning file", "container", container, "path", location, "error", err)
+			}
+		}
+		if !tree {
+			return nil
+		}
+	}
+}
+
+func (s *Source) scanFile(ctx context.Context, file io.Reader, metadata *source_metadatapb.MetaData, chunksChan chan *sources.Chunk) error {
+	reReader, err := diskbufferreader.New(file)
+	if err != nil {
+		return fmt.Errorf("could not create re-readable reader: %w", err)
+	}
+	defer reReader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceType:     s.Type(),
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		SourceMetadata: metadata,
+		Verify:         s.verify,
+	}
+	if handlers.HandleFile(ctx, reReader, chunkSkel, chunksChan) {
+		return nil
+	}
+
+	if err := reReader.Reset(); err != nil {
+		return err
+	}
+	reReader.Stop()
+	data, err := io.ReadAll(reReader)
+	if err != nil {
+		return fmt.Errorf("could not read file: %w", err)
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	chunksChan <- &chunk
+	return nil
+}
+
+func (s *Source) metadata(meta *source_metadatapb.Container, location, volume string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Container{
+			Container: &source_metadatapb.Container{
+				ContainerId:   meta.ContainerId,
+				ContainerName: meta.ContainerName,
+				Image:         meta.Image,
+				Location:      sanitizer.UTF8(location),
+				Volume:        sanitizer.UTF8(volume),
+			},
+		},
+	}
+}
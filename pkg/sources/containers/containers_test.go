@@ -0,0 +1,136 @@
+package containers
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func Test_layerPaths(t *testing.T) {
+	changes := []change{
+		{Path: "/etc", Kind: changeModified},
+		{Path: "/etc/app.conf", Kind: changeModified},
+		{Path: "/etc/hosts", Kind: changeDeleted},
+		{Path: "/app", Kind: changeAdded},
+		{Path: "/app/config", Kind: changeAdded},
+		{Path: "/app/config/.env", Kind: changeAdded},
+		{Path: "/app-data", Kind: changeAdded},
+		{Path: "/tmp", Kind: changeModified},
+	}
+	want := []layerPath{
+		{path: "/app", tree: true},
+		{path: "/app-data"},
+		{path: "/etc/app.conf"},
+		{path: "/tmp"},
+	}
+	assert.Equal(t, want, layerPaths(changes))
+}
+
+func TestSource_Chunks(t *testing.T) {
+	archives := map[string]map[string]string{
+		"/etc/app.conf": {"app.conf": "password=hunter2"},
+		"/app":          {"app/": "", "app/config/.env": "TOKEN=abc"},
+		"/data":         {"data/": "", "data/dump.sql": "INSERT INTO users"},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []containerSummary{{ID: "abc", Names: []string{"/web"}}})
+	})
+	mux.HandleFunc("/containers/abc/json", func(w http.ResponseWriter, r *http.Request) {
+		info := containerInfo{ID: "abc", Name: "/web"}
+		info.Config.Image = "nginx:latest"
+		info.Config.Env = []string{"PATH=/usr/bin", "API_KEY=secret"}
+		info.Mounts = []mount{
+			{Type: "volume", Name: "db", Destination: "/data"},
+			{Type: "tmpfs", Destination: "/run"},
+		}
+		writeJSON(t, w, info)
+	})
+	mux.HandleFunc("/containers/abc/changes", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, []change{
+			{Path: "/etc", Kind: changeModified},
+			{Path: "/etc/app.conf", Kind: changeModified},
+			{Path: "/app", Kind: changeAdded},
+			{Path: "/app/config", Kind: changeAdded},
+			{Path: "/app/config/.env", Kind: changeAdded},
+		})
+	})
+	mux.HandleFunc("/containers/abc/archive", func(w http.ResponseWriter, r *http.Request) {
+		files, ok := archives[r.URL.Query().Get("path")]
+		if !ok {
+			http.Error(w, `{"message": "no such file"}`, http.StatusNotFound)
+			return
+		}
+		writeTar(t, w, files)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	conn, err := anypb.New(&sourcespb.Containers{Endpoint: server.URL})
+	assert.NoError(t, err)
+	s := Source{}
+	assert.NoError(t, s.Init(context.Background(), "test", 0, 0, false, conn, 1))
+
+	chunksCh := make(chan *sources.Chunk, 10)
+	assert.NoError(t, s.Chunks(context.Background(), chunksCh))
+	close(chunksCh)
+
+	var got []string
+	for chunk := range chunksCh {
+		meta := chunk.SourceMetadata.GetContainer()
+		assert.Equal(t, "abc", meta.GetContainerId())
+		assert.Equal(t, "web", meta.GetContainerName())
+		assert.Equal(t, "nginx:latest", meta.GetImage())
+		got = append(got, strings.Join([]string{meta.GetLocation(), meta.GetVolume(), string(chunk.Data)}, " "))
+	}
+	sort.Strings(got)
+	want := []string{
+		"/app/config/.env  TOKEN=abc",
+		"/data/dump.sql db INSERT INTO users",
+		"/etc/app.conf  password=hunter2",
+		"env  PATH=/usr/bin\nAPI_KEY=secret",
+	}
+	assert.Equal(t, want, got)
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	assert.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func writeTar(t *testing.T, w http.ResponseWriter, files map[string]string) {
+	t.Helper()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range names {
+		header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(name, "/") {
+			header = &tar.Header{Name: path.Clean(name), Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+		assert.NoError(t, tw.WriteHeader(header))
+		_, err := tw.Write([]byte(files[name]))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	_, err := w.Write(buf.Bytes())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,10 @@
+//go:build !windows
+// +build !windows
+
+package filesystem
+
+// alternateDataStreams returns the paths of the NTFS alternate data streams
+// of a file. They're only supported on Windows.
+func alternateDataStreams(string) ([]string, error) {
+	return nil, nil
+}
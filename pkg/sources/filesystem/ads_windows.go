@@ -0,0 +1,58 @@
+//go:build windows
+// +build windows
+
+package filesystem
+
+import (
+	"errors"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modkernel32          = windows.NewLazySystemDLL("kernel32.dll")
+	procFindFirstStreamW = modkernel32.NewProc("FindFirstStreamW")
+	procFindNextStreamW  = modkernel32.NewProc("FindNextStreamW")
+)
+
+// win32FindStreamData is a WIN32_FIND_STREAM_DATA structure.
+type win32FindStreamData struct {
+	StreamSize int64
+	StreamName [windows.MAX_PATH + 36]uint16
+}
+
+// alternateDataStreams returns the paths of the NTFS alternate data streams
+// of a file, e.g. file.txt:Zone.Identifier:$DATA.
+func alternateDataStreams(path string) ([]string, error) {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data win32FindStreamData
+	// 0 is FindStreamInfoStandard.
+	handle, _, err := procFindFirstStreamW.Call(uintptr(unsafe.Pointer(pathPtr)), 0, uintptr(unsafe.Pointer(&data)), 0)
+	if windows.Handle(handle) == windows.InvalidHandle {
+		if errors.Is(err, windows.ERROR_HANDLE_EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer windows.FindClose(windows.Handle(handle))
+
+	var streams []string
+	for {
+		// The unnamed stream is the content of the file.
+		if name := windows.UTF16ToString(data.StreamName[:]); name != "::$DATA" {
+			streams = append(streams, path+name)
+		}
+		ok, _, err := procFindNextStreamW.Call(handle, uintptr(unsafe.Pointer(&data)))
+		if ok == 0 {
+			if errors.Is(err, windows.ERROR_HANDLE_EOF) {
+				return streams, nil
+			}
+			return streams, err
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
 	"github.com/go-errors/errors"
@@ -27,6 +28,10 @@ const (
 	// Having a peek size larger than that ensures that we have complete credential coverage in our chunks.
 	BufferSize = 10 * 1024 // 10KB
 	PeekSize   = 3 * 1024  // 3KB
+
+	// DefaultMaxDeviceReadSize is the number of bytes read from device files
+	// when the size of files isn't capped.
+	DefaultMaxDeviceReadSize = 10 * 1024 * 1024 // 10MB
 )
 
 type Source struct {
@@ -37,6 +42,23 @@ type Source struct {
 	paths    []string
 	log      logr.Logger
 	filter   *common.Filter
+	// respectGitignore skips the paths ignored by .gitignore files, as well
+	// as those ignored by .trufflehogignore files.
+	respectGitignore bool
+	// watch keeps scanning the files that are created or modified under the
+	// paths after they've been scanned.
+	watch bool
+	// followSymlinks scans the directories that symlinks point to. Symlinks
+	// to files are always followed.
+	followSymlinks bool
+	// scanDevices scans device files, reading at most maxFileSize bytes, or
+	// DefaultMaxDeviceReadSize if it isn't set.
+	scanDevices bool
+	// scanAlternateDataStreams scans the NTFS alternate data streams of
+	// files on Windows.
+	scanAlternateDataStreams bool
+	// maxFileSize is the number of bytes that are read from each file, if set.
+	maxFileSize int64
 	sources.Progress
 }
 
@@ -71,6 +93,12 @@ func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64,
 		return errors.WrapPrefix(err, "error unmarshalling connection", 0)
 	}
 	s.paths = append(conn.Paths, conn.Directories...)
+	s.respectGitignore = conn.GetRespectGitignore()
+	s.watch = conn.GetWatch()
+	s.followSymlinks = conn.GetFollowSymlinks()
+	s.scanDevices = conn.GetScanDevices()
+	s.scanAlternateDataStreams = conn.GetScanAlternateDataStreams()
+	s.maxFileSize = conn.GetMaxFileSize()
 
 	return nil
 }
@@ -105,28 +133,75 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 			logger.Info("error scanning filesystem", "error", err)
 		}
 	}
+
+	if s.watch {
+		return s.watchPaths(ctx, chunksChan)
+	}
 	return nil
 }
 
 func (s *Source) scanDir(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
-	return fs.WalkDir(os.DirFS(path), ".", func(relativePath string, d fs.DirEntry, err error) error {
+	w := &dirWalk{
+		ignore:  newIgnorer(s.respectGitignore),
+		visited: make(map[string]struct{}),
+	}
+	if realPath, err := filepath.EvalSymlinks(path); err == nil {
+		w.visited[realPath] = struct{}{}
+	}
+	return s.walkDir(ctx, w, path, ".", chunksChan)
+}
+
+// dirWalk is the state of the scan of a directory tree.
+type dirWalk struct {
+	ignore *ignorer
+	// visited are the real paths of the trees that are scanned, which are the
+	// root of the scan and the targets of the symlinks that were followed.
+	visited map[string]struct{}
+}
+
+// walkDir scans the directory tree at dir, whose path relative to the root of
+// the scan is relativeDir.
+func (s *Source) walkDir(ctx context.Context, w *dirWalk, dir, relativeDir string, chunksChan chan *sources.Chunk) error {
+	return fs.WalkDir(os.DirFS(dir), ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return nil
 		}
-		fullPath := filepath.Join(path, relativePath)
+		fullPath := filepath.Join(dir, path)
+		relativePath := filepath.Join(relativeDir, path)
+
+		if w.ignore.ignored(relativePath, d.IsDir()) {
+			logSkip(ctx, fullPath, "ignored by an ignore file")
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			w.ignore.load(ctx, fullPath, relativePath)
+			return nil
+		}
 
-		// Skip over non-regular files. We do this check here to suppress noisy
-		// logs for trying to scan directories and other non-regular files in
-		// our traversal.
+		// Stat follows symlinks, so symlinks to files are scanned as the
+		// files they point to.
 		fileStat, err := os.Stat(fullPath)
 		if err != nil {
 			ctx.Logger().Info("unable to stat file", "path", fullPath, "error", err)
 			return nil
 		}
-		if !fileStat.Mode().IsRegular() {
+		if fileStat.IsDir() {
+			if !s.followSymlinks {
+				logSkip(ctx, fullPath, "symlink to a directory")
+				return nil
+			}
+			s.followSymlink(ctx, w, fullPath, relativePath, chunksChan)
+			return nil
+		}
+		if reason := s.skipReason(fileStat); reason != "" {
+			logSkip(ctx, fullPath, reason)
 			return nil
 		}
 		if s.filter != nil && !s.filter.Pass(fullPath) {
+			logSkip(ctx, fullPath, "excluded by the path filters")
 			return nil
 		}
 
@@ -137,16 +212,109 @@ func (s *Source) scanDir(ctx context.Context, path string, chunksChan chan *sour
 	})
 }
 
+// followSymlink scans the directory that a symlink points to, unless the
+// directory is already scanned. Symlinks to a directory that contains them
+// would make the scan loop forever.
+func (s *Source) followSymlink(ctx context.Context, w *dirWalk, path, relativePath string, chunksChan chan *sources.Chunk) {
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		ctx.Logger().Info("unable to resolve symlink", "path", path, "error", err)
+		return
+	}
+	realParent, err := filepath.EvalSymlinks(filepath.Dir(path))
+	if err != nil {
+		ctx.Logger().Info("unable to resolve symlink", "path", path, "error", err)
+		return
+	}
+	if isWithin(realParent, target) {
+		logSkip(ctx, path, "symlink cycle to "+target)
+		return
+	}
+	for visited := range w.visited {
+		if isWithin(target, visited) {
+			logSkip(ctx, path, "symlink to "+target+", which is already scanned")
+			return
+		}
+	}
+
+	w.visited[target] = struct{}{}
+	ctx.Logger().V(3).Info("following symlink", "path", path, "target", target)
+	// The directory is walked through the symlink, so that its files are
+	// reported under the path they were found at.
+	if err := s.walkDir(ctx, w, path, relativePath, chunksChan); err != nil {
+		ctx.Logger().Info("error scanning symlinked directory", "path", path, "error", err)
+	}
+}
+
+// isWithin reports whether path is dir or is in it.
+func isWithin(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
+
+// skipReason returns why a file isn't scanned, or an empty string if it is.
+// Device files are only scanned if enabled, since reading them can have side
+// effects. Sockets can't be read, and reading a named pipe blocks until
+// something writes to it. Regular files larger than the max size of the path
+// filters are skipped.
+func (s *Source) skipReason(fileStat fs.FileInfo) string {
+	mode := fileStat.Mode()
+	switch {
+	case mode.IsRegular():
+		if !s.filter.PassSize(fileStat.Size()) {
+			return "larger than the max size of the path filters"
+		}
+		return ""
+	case mode.IsDir():
+		return "directory"
+	case mode&fs.ModeDevice != 0:
+		if s.scanDevices {
+			return ""
+		}
+		return "device file"
+	case mode&fs.ModeSocket != 0:
+		return "socket"
+	case mode&fs.ModeNamedPipe != 0:
+		return "named pipe"
+	default:
+		return "irregular file"
+	}
+}
+
+func logSkip(ctx context.Context, path, reason string) {
+	ctx.Logger().V(3).Info("skipping file", "path", path, "reason", reason)
+}
+
 func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
-	logger := ctx.Logger().WithValues("path", path)
 	fileStat, err := os.Stat(path)
 	if err != nil {
 		return fmt.Errorf("unable to stat file: %w", err)
 	}
-	if !fileStat.Mode().IsRegular() {
-		return fmt.Errorf("not a regular file")
+	if reason := s.skipReason(fileStat); reason != "" {
+		return fmt.Errorf("not scanning %s", reason)
+	}
+
+	if err := s.scanPath(ctx, path, fileStat, chunksChan); err != nil {
+		return err
+	}
+	if !s.scanAlternateDataStreams || !fileStat.Mode().IsRegular() {
+		return nil
+	}
+	streams, err := alternateDataStreams(path)
+	if err != nil {
+		return fmt.Errorf("unable to list alternate data streams: %w", err)
 	}
+	for _, stream := range streams {
+		if err := s.scanPath(ctx, stream, nil, chunksChan); err != nil {
+			ctx.Logger().Info("error scanning alternate data stream", "path", stream, "error", err)
+		}
+	}
+	return nil
+}
 
+// scanPath scans a file, or an alternate data stream of one, in which case
+// fileStat is nil. At most maxFileSize bytes are read.
+func (s *Source) scanPath(ctx context.Context, path string, fileStat fs.FileInfo, chunksChan chan *sources.Chunk) error {
+	logger := ctx.Logger().WithValues("path", path)
 	inputFile, err := os.Open(path)
 	if err != nil {
 		return fmt.Errorf("unable to open file: %w", err)
@@ -154,7 +322,20 @@ func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sou
 	defer inputFile.Close()
 	logger.V(3).Info("scanning file")
 
-	reReader, err := diskbufferreader.New(inputFile)
+	var reader io.Reader = inputFile
+	maxSize := s.maxFileSize
+	if maxSize <= 0 && fileStat != nil && !fileStat.Mode().IsRegular() {
+		// Devices such as /dev/zero never end.
+		maxSize = DefaultMaxDeviceReadSize
+	}
+	if maxSize > 0 {
+		if fileStat != nil && fileStat.Size() > maxSize {
+			logger.V(2).Info("only scanning the start of the file", "size", fileStat.Size(), "max_size", maxSize)
+		}
+		reader = io.LimitReader(inputFile, maxSize)
+	}
+
+	reReader, err := diskbufferreader.New(reader)
 	if err != nil {
 		return fmt.Errorf("could not create re-readable reader: %w", err)
 	}
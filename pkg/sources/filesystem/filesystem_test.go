@@ -1,10 +1,14 @@
 package filesystem
 
 import (
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -31,9 +35,9 @@ func TestSource_Scan(t *testing.T) {
 		{
 			name: "get a chunk",
 			init: init{
-				name: "this repo",
+				name: "this file",
 				connection: &sourcespb.Filesystem{
-					Paths: []string{"."},
+					Paths: []string{"filesystem.go"},
 				},
 				verify: true,
 			},
@@ -78,3 +82,155 @@ func TestSource_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestSource_ScanIgnoreFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".gitignore":               "*.env\n",
+		".trufflehogignore":        "# build output\nbuild/\n",
+		"build/output.txt":         "secret",
+		"prod.env":                 "secret",
+		"main.go":                  "secret",
+		"vendor/.trufflehogignore": "*.go\n!keep.go\n",
+		"vendor/lib.go":            "secret",
+		"vendor/keep.go":           "secret",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	tests := []struct {
+		name             string
+		respectGitignore bool
+		want             []string
+	}{
+		{
+			name: "trufflehogignore",
+			want: []string{".gitignore", ".trufflehogignore", "main.go", "prod.env", "vendor/.trufflehogignore", "vendor/keep.go"},
+		},
+		{
+			name:             "gitignore and trufflehogignore",
+			respectGitignore: true,
+			want:             []string{".gitignore", ".trufflehogignore", "main.go", "vendor/.trufflehogignore", "vendor/keep.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Source{respectGitignore: tt.respectGitignore}
+			chunksCh := make(chan *sources.Chunk, len(files))
+			assert.NoError(t, s.scanDir(context.Background(), dir, chunksCh))
+			close(chunksCh)
+
+			var got []string
+			for chunk := range chunksCh {
+				got = append(got, relativeFile(t, dir, chunk))
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSource_Watch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ".trufflehogignore"), []byte("*.log\n"), 0o644))
+	s := Source{paths: []string{dir}, watch: true}
+	chunksCh := make(chan *sources.Chunk, 1)
+	go func() {
+		_ = s.Chunks(ctx, chunksCh)
+	}()
+	// The files that are there when the scan starts are scanned first.
+	<-chunksCh
+	// Give the watcher time to start.
+	time.Sleep(500 * time.Millisecond)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "app.log"), []byte("ignored"), 0o644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "config"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "config", "prod.env"), []byte("secret"), 0o644))
+
+	select {
+	case chunk := <-chunksCh:
+		assert.Equal(t, "config/prod.env", relativeFile(t, dir, chunk))
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the new file to be scanned")
+	}
+}
+
+func relativeFile(t *testing.T, dir string, chunk *sources.Chunk) string {
+	t.Helper()
+	rel, err := filepath.Rel(dir, chunk.SourceMetadata.GetFilesystem().GetFile())
+	assert.NoError(t, err)
+	return filepath.ToSlash(rel)
+}
+
+func TestSource_ScanSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	other := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("secret"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(other, "linked.env"), []byte("secret"), 0o644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	// A symlink to a directory outside of the tree, and a cycle.
+	assert.NoError(t, os.Symlink(other, filepath.Join(dir, "other")))
+	assert.NoError(t, os.Symlink(dir, filepath.Join(dir, "sub", "loop")))
+	assert.NoError(t, os.Symlink(dir, filepath.Join(other, "back")))
+
+	tests := []struct {
+		name           string
+		followSymlinks bool
+		want           []string
+	}{
+		{
+			name: "symlinks to directories aren't followed",
+			want: []string{"main.go"},
+		},
+		{
+			name:           "symlinks to directories are followed once",
+			followSymlinks: true,
+			want:           []string{"main.go", "other/linked.env"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Source{followSymlinks: tt.followSymlinks}
+			chunksCh := make(chan *sources.Chunk, 10)
+			assert.NoError(t, s.scanDir(context.Background(), dir, chunksCh))
+			close(chunksCh)
+
+			var got []string
+			for chunk := range chunksCh {
+				got = append(got, relativeFile(t, dir, chunk))
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSource_MaxFileSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "large.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("first line\nsecond line\n"), 0o644))
+
+	s := Source{maxFileSize: 10}
+	chunksCh := make(chan *sources.Chunk, 1)
+	assert.NoError(t, s.scanFile(context.Background(), path, chunksCh))
+	assert.Equal(t, "first line", string((<-chunksCh).Data))
+}
+
+func TestSource_skipReason(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	assert.NoError(t, os.WriteFile(file, nil, 0o644))
+	fileStat, err := os.Stat(file)
+	assert.NoError(t, err)
+	dirStat, err := os.Stat(dir)
+	assert.NoError(t, err)
+
+	s := Source{}
+	assert.Equal(t, "", s.skipReason(fileStat))
+	assert.Equal(t, "directory", s.skipReason(dirStat))
+}
@@ -0,0 +1,96 @@
+package filesystem
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// ignoreFileName is the name of the files with the gitignore patterns of
+	// the paths that aren't scanned in the directory they're in.
+	ignoreFileName    = ".trufflehogignore"
+	gitignoreFileName = ".gitignore"
+)
+
+// ignorer matches the paths of a directory tree against the patterns of the
+// ignore files of its directories. Patterns only apply to the directory of
+// their ignore file, and patterns of subdirectories take precedence.
+type ignorer struct {
+	fileNames []string
+	patterns  []gitignore.Pattern
+	matcher   gitignore.Matcher
+}
+
+// newIgnorer returns an ignorer that reads the .trufflehogignore files, and
+// the .gitignore files if enabled. Files ignored by git often hold secrets,
+// such as .env files, so they're scanned by default.
+func newIgnorer(respectGitignore bool) *ignorer {
+	fileNames := []string{ignoreFileName}
+	if respectGitignore {
+		fileNames = []string{gitignoreFileName, ignoreFileName}
+	}
+	return &ignorer{fileNames: fileNames}
+}
+
+// load reads the ignore files of a directory, given by its full path and its
+// path relative to the root of the tree. Directories must be loaded before
+// their subdirectories.
+func (i *ignorer) load(ctx context.Context, dir, relativeDir string) {
+	domain := splitPath(relativeDir)
+	for _, name := range i.fileNames {
+		patterns, err := readIgnoreFile(filepath.Join(dir, name), domain)
+		if err != nil {
+			ctx.Logger().V(2).Info("unable to read ignore file", "path", filepath.Join(dir, name), "error", err)
+			continue
+		}
+		i.patterns = append(i.patterns, patterns...)
+	}
+	i.matcher = gitignore.NewMatcher(i.patterns)
+}
+
+// ignored reports whether a path relative to the root of the tree is ignored.
+func (i *ignorer) ignored(relativePath string, isDir bool) bool {
+	if i.matcher == nil {
+		return false
+	}
+	return i.matcher.Match(splitPath(relativePath), isDir)
+}
+
+func readIgnoreFile(path string, domain []string) ([]gitignore.Pattern, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns, scanner.Err()
+}
+
+// splitPath splits a slash or OS separated relative path into its elements.
+func splitPath(path string) []string {
+	path = filepath.ToSlash(path)
+	if path == "." || path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
@@ -0,0 +1,216 @@
+package filesystem
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// watchDebounce is how long a file has to go unmodified before it's scanned,
+// so that a file that is being written is scanned once it's complete.
+const watchDebounce = 500 * time.Millisecond
+
+// watcher scans the files that are created or modified under the paths of a
+// source. Directories are watched recursively, except those that are ignored.
+// Changes to ignore files apply to the paths created after them.
+type watcher struct {
+	source  *Source
+	watcher *fsnotify.Watcher
+	// roots are the directories that are watched, with the ignore patterns of
+	// their trees.
+	roots []watchRoot
+	// files are the files that are watched through their directory.
+	files map[string]struct{}
+	// pending are the files that are waiting to be scanned, and ready is
+	// where they're sent once they've gone unmodified long enough.
+	pending map[string]*time.Timer
+	ready   chan string
+}
+
+type watchRoot struct {
+	path   string
+	ignore *ignorer
+}
+
+// watchPaths scans the files that are created or modified under the paths
+// until the context is done.
+func (s *Source) watchPaths(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("unable to create watcher: %w", err)
+	}
+	defer fsWatcher.Close()
+
+	w := &watcher{
+		source:  s,
+		watcher: fsWatcher,
+		files:   make(map[string]struct{}),
+		pending: make(map[string]*time.Timer),
+		ready:   make(chan string),
+	}
+	for _, path := range s.paths {
+		// Paths are made absolute, since the paths of events are relative to
+		// the watched directories.
+		cleanPath, err := filepath.Abs(path)
+		if err != nil {
+			ctx.Logger().Error(err, "unable to get absolute path", "path", path)
+			continue
+		}
+		fileInfo, err := os.Stat(cleanPath)
+		if err != nil {
+			ctx.Logger().Error(err, "unable to get file info", "path", path)
+			continue
+		}
+		if fileInfo.IsDir() {
+			root := watchRoot{path: cleanPath, ignore: newIgnorer(s.respectGitignore)}
+			w.roots = append(w.roots, root)
+			w.addTree(ctx, root, cleanPath, false, chunksChan)
+			continue
+		}
+		// Files are watched through their directory, since editors often
+		// replace files instead of writing to them.
+		w.files[cleanPath] = struct{}{}
+		if err := fsWatcher.Add(filepath.Dir(cleanPath)); err != nil {
+			ctx.Logger().Error(err, "unable to watch file", "path", cleanPath)
+		}
+	}
+	ctx.Logger().Info("watching for file changes", "paths", len(s.paths))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-fsWatcher.Errors:
+			if !ok {
+				return nil
+			}
+			ctx.Logger().Error(err, "error watching files")
+		case event, ok := <-fsWatcher.Events:
+			if !ok {
+				return nil
+			}
+			w.handle(ctx, event, chunksChan)
+		case path := <-w.ready:
+			delete(w.pending, path)
+			if err := s.scanFile(ctx, path, chunksChan); err != nil {
+				ctx.Logger().Info("error scanning file", "path", path, "error", err)
+			}
+		}
+	}
+}
+
+// addTree watches a directory and its subdirectories, and scans their files
+// if scan is set.
+func (w *watcher) addTree(ctx context.Context, root watchRoot, dir string, scan bool, chunksChan chan *sources.Chunk) {
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		relativePath, err := filepath.Rel(root.path, path)
+		if err != nil {
+			return nil
+		}
+		if path != dir && root.ignore.ignored(relativePath, d.IsDir()) {
+			logSkip(ctx, path, "ignored by an ignore file")
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			root.ignore.load(ctx, path, relativePath)
+			if err := w.watcher.Add(path); err != nil {
+				ctx.Logger().Error(err, "unable to watch directory", "path", path)
+			}
+			return nil
+		}
+		if scan && d.Type().IsRegular() && w.source.filter.Pass(path) {
+			if err := w.source.scanFile(ctx, path, chunksChan); err != nil {
+				ctx.Logger().Info("error scanning file", "path", path, "error", err)
+			}
+		}
+		return nil
+	})
+}
+
+// handle schedules the scan of a file that was created or modified, and
+// watches directories that were created.
+func (w *watcher) handle(ctx context.Context, event fsnotify.Event, chunksChan chan *sources.Chunk) {
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+		return
+	}
+	path := filepath.Clean(event.Name)
+	logger := ctx.Logger().WithValues("path", path)
+
+	fileInfo, err := os.Lstat(path)
+	if err != nil {
+		// The file was removed before it could be scanned.
+		logger.V(5).Info("unable to stat changed file", "error", err)
+		return
+	}
+
+	root, ok := w.rootOf(path)
+	if !ok {
+		if _, ok := w.files[path]; !ok || !fileInfo.Mode().IsRegular() {
+			return
+		}
+	} else {
+		relativePath, err := filepath.Rel(root.path, path)
+		if err != nil {
+			return
+		}
+		if root.ignore.ignored(relativePath, fileInfo.IsDir()) {
+			logSkip(ctx, path, "ignored by an ignore file")
+			return
+		}
+		if fileInfo.IsDir() {
+			// Files may have been created in the directory before it was
+			// watched, so its files are scanned now.
+			if event.Has(fsnotify.Create) {
+				w.addTree(ctx, root, path, true, chunksChan)
+			}
+			return
+		}
+	}
+	if !fileInfo.Mode().IsRegular() || !w.source.filter.Pass(path) {
+		return
+	}
+	w.schedule(ctx, path)
+}
+
+// schedule scans a file once it has gone unmodified for watchDebounce.
+func (w *watcher) schedule(ctx context.Context, path string) {
+	if timer, ok := w.pending[path]; ok && timer.Stop() {
+		timer.Reset(watchDebounce)
+		return
+	}
+	w.pending[path] = time.AfterFunc(watchDebounce, func() {
+		select {
+		case w.ready <- path:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// rootOf returns the innermost watched directory that contains a path.
+func (w *watcher) rootOf(path string) (watchRoot, bool) {
+	var match watchRoot
+	found := false
+	for _, root := range w.roots {
+		if path != root.path && !strings.HasPrefix(path, root.path+string(filepath.Separator)) {
+			continue
+		}
+		if !found || len(root.path) > len(match.path) {
+			match, found = root, true
+		}
+	}
+	return match, found
+}
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
@@ -56,6 +57,7 @@ type Source struct {
 	stats      *attributes
 	log        logr.Logger
 	chunksCh   chan *sources.Chunk
+	filter     *common.Filter
 
 	sources.Progress
 }
@@ -90,6 +92,11 @@ func (s *Source) Init(aCtx context.Context, name string, id int64, sourceID int6
 	return nil
 }
 
+// WithFilter sets the path filters that the object names must pass.
+func (s *Source) WithFilter(filter *common.Filter) {
+	s.filter = filter
+}
+
 func configureGCSManager(aCtx context.Context, conn *sourcespb.GCS, concurrency int) (*gcsManager, error) {
 	if conn == nil {
 		return nil, fmt.Errorf("GCS connection is nil, cannot configure GCS manager")
@@ -134,6 +141,18 @@ func configureGCSManager(aCtx context.Context, conn *sourcespb.GCS, concurrency
 	if setGCSManagerObjectOptions(conn) != nil {
 		gcsManagerOpts = append(gcsManagerOpts, setGCSManagerObjectOptions(conn))
 	}
+	if len(conn.GetIncludePrefixes()) > 0 {
+		gcsManagerOpts = append(gcsManagerOpts, withIncludePrefixes(conn.GetIncludePrefixes()))
+	}
+	if len(conn.GetExcludePrefixes()) > 0 {
+		gcsManagerOpts = append(gcsManagerOpts, withExcludePrefixes(conn.GetExcludePrefixes()))
+	}
+	if conn.GetUserProject() != "" {
+		gcsManagerOpts = append(gcsManagerOpts, withUserProject(conn.GetUserProject()))
+	}
+	if conn.GetIncludeGenerations() {
+		gcsManagerOpts = append(gcsManagerOpts, withGenerations())
+	}
 
 	gcsManager, err := newGCSManager(conn.ProjectId, gcsManagerOpts...)
 	if err != nil {
@@ -216,6 +235,10 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 			ctx.Logger().Error(fmt.Errorf("unexpected object type: %T", obj), "GCS source unexpected object type", "name", s.name)
 			continue
 		}
+		if !s.filter.PassFile(o.name, o.size) {
+			ctx.Logger().V(5).Info("skipping object excluded by the path filters", "name", o.name)
+			continue
+		}
 
 		wg.Add(1)
 		go func(obj object) {
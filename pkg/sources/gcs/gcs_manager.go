@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -65,6 +66,13 @@ type gcsManager struct {
 	includeObjects,
 	excludeObjects map[string]struct{}
 
+	// includePrefixes are listed instead of the whole bucket.
+	includePrefixes,
+	excludePrefixes []string
+	// userProject is billed for the requests to requester pays buckets.
+	userProject        string
+	includeGenerations bool
+
 	buckets map[string]bucket
 	attr    *attributes
 
@@ -279,6 +287,44 @@ func withExcludeObjects(objects []string) gcsManagerOption {
 	}
 }
 
+// withIncludePrefixes sets the object name prefixes that should be scanned.
+// Only the objects with these prefixes are listed, which is much faster than
+// filtering the objects of large buckets with withIncludeObjects.
+func withIncludePrefixes(prefixes []string) gcsManagerOption {
+	return func(m *gcsManager) error {
+		m.includePrefixes = prefixes
+		return nil
+	}
+}
+
+// withExcludePrefixes sets the object name prefixes that should be excluded
+// from the scan.
+func withExcludePrefixes(prefixes []string) gcsManagerOption {
+	return func(m *gcsManager) error {
+		m.excludePrefixes = prefixes
+		return nil
+	}
+}
+
+// withUserProject sets the project that is billed for the requests to
+// requester pays buckets. Listing or reading these buckets fails without it.
+func withUserProject(project string) gcsManagerOption {
+	return func(m *gcsManager) error {
+		m.userProject = project
+		return nil
+	}
+}
+
+// withGenerations enables scanning the noncurrent generations of objects,
+// which hold the previous and deleted contents of objects in buckets with
+// object versioning.
+func withGenerations() gcsManagerOption {
+	return func(m *gcsManager) error {
+		m.includeGenerations = true
+		return nil
+	}
+}
+
 // withConcurrency sets the number of concurrent workers that will be used
 // to process objects.
 // If not set, or set to a negative number the default value is runtime.NumCPU().
@@ -407,34 +453,14 @@ func (g *gcsManager) enumerate(ctx context.Context, bkts []bucket) (*attributes,
 			// List all the objects in the bucket and calculate a attributes.
 			g.setupBktHandle(&bkt)
 
-			q, err := setObjectQuery(&bkt)
-			if err != nil {
-				logger.Error(err, "failed to set object query", "bucket", bkt.name)
-				return nil
-			}
-
 			var count uint64
-			objs := bkt.Objects(ctx, q)
-			for {
-				obj, err := objs.Next()
-				if errors.Is(err, iterator.Done) {
-					logger.V(5).Info("finished listing objects in bucket")
-					break
-				}
-				if err != nil {
-					logger.V(1).Info("failed to list objects", "bucket", bkt.name, "error", err)
-					return nil
-				}
-				if obj == nil {
-					logger.V(5).Info("object is nil")
-					continue
-				}
-
-				if !g.shouldIncludeObject(ctx, obj.Name) || g.shouldExcludeObject(ctx, obj.Name) {
-					continue
-				}
+			err := g.walkObjects(ctx, &bkt, func(*storage.ObjectAttrs) {
 				count++
 				stats.incObjects()
+			})
+			if err != nil {
+				logger.V(1).Info("failed to list objects", "bucket", bkt.name, "error", err)
+				return nil
 			}
 
 			stats.setBucketCnt(bkt.name, count)
@@ -564,42 +590,88 @@ func (g *gcsManager) setupBktHandle(bkt *bucket) {
 		}),
 		storage.WithPolicy(storage.RetryAlways),
 	)
+	if g.userProject != "" {
+		b = b.UserProject(g.userProject)
+	}
 	bkt.BucketHandle = b
 }
 
 func (g *gcsManager) bucketObjects(ctx context.Context, bkt *bucket, ch chan<- io.Reader) error {
-	q, err := setObjectQuery(bkt)
+	return g.walkObjects(ctx, bkt, func(obj *storage.ObjectAttrs) {
+		handle := bkt.Object(obj.Name)
+		if g.includeGenerations {
+			handle = handle.Generation(obj.Generation)
+		}
+		o, err := g.constructObject(ctx, handle)
+		if err != nil {
+			ctx.Logger().V(1).Info("failed to create object", "object-name", obj.Name, "error", err)
+			return
+		}
+		ch <- o
+	})
+}
+
+// walkObjects calls fn for each object in the bucket that passes the object
+// filters.
+func (g *gcsManager) walkObjects(ctx context.Context, bkt *bucket, fn func(*storage.ObjectAttrs)) error {
+	queries, err := g.objectQueries(bkt)
 	if err != nil {
 		return fmt.Errorf("failed to set object query: %w", err)
 	}
 
-	objs := bkt.Objects(ctx, q)
-	for {
-		obj, err := objs.Next()
-		if errors.Is(err, iterator.Done) {
-			ctx.Logger().V(5).Info("finished listing objects in bucket")
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("failed to retrieve object iterator: %w", err)
-		}
-		if obj == nil {
-			ctx.Logger().V(5).Info("object is nil")
-			continue
-		}
+	for _, q := range queries {
+		objs := bkt.Objects(ctx, q)
+		for {
+			obj, err := objs.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to retrieve object iterator: %w", err)
+			}
+			if obj == nil {
+				ctx.Logger().V(5).Info("object is nil")
+				continue
+			}
 
-		if !g.shouldIncludeObject(ctx, obj.Name) || g.shouldExcludeObject(ctx, obj.Name) {
-			continue
+			if !g.shouldIncludeObject(ctx, obj.Name) || g.shouldExcludeObject(ctx, obj.Name) || g.hasExcludedPrefix(obj.Name) {
+				continue
+			}
+			fn(obj)
 		}
+	}
+	ctx.Logger().V(5).Info("finished listing objects in bucket")
+	return nil
+}
 
-		o, err := g.constructObject(ctx, bkt.Object(obj.Name))
+// objectQueries returns the queries that list the objects of a bucket, one
+// for each included prefix.
+func (g *gcsManager) objectQueries(bkt *bucket) ([]*storage.Query, error) {
+	prefixes := g.includePrefixes
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+
+	queries := make([]*storage.Query, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		q, err := setObjectQuery(bkt)
 		if err != nil {
-			ctx.Logger().V(1).Info("failed to create object", "object-name", obj.Name, "error", err)
-			continue
+			return nil, err
 		}
-		ch <- o
+		q.Prefix = prefix
+		q.Versions = g.includeGenerations
+		queries = append(queries, q)
 	}
-	return nil
+	return queries, nil
+}
+
+func (g *gcsManager) hasExcludedPrefix(name string) bool {
+	for _, prefix := range g.excludePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 func setObjectQuery(bkt *bucket) (*storage.Query, error) {
@@ -614,6 +686,7 @@ func setObjectQuery(bkt *bucket) (*storage.Query, error) {
 		"Updated",
 		"Created",
 		"ACL",
+		"Generation",
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to set attribute selection: %w", err)
@@ -638,8 +711,19 @@ func (g *gcsManager) constructObject(ctx context.Context, obj *storage.ObjectHan
 		return o, fmt.Errorf("object is not valid")
 	}
 
+	// Objects encrypted with a customer-supplied key can't be read without
+	// the key, which the scanner doesn't have.
+	if attrs.CustomerKeySHA256 != "" {
+		return o, fmt.Errorf("object is encrypted with a customer-supplied key")
+	}
+
 	rc, err := obj.NewReader(ctx)
 	if err != nil {
+		// Reading objects encrypted with a customer-managed key requires
+		// permission to use the key as well as the object.
+		if attrs.KMSKeyName != "" {
+			return o, fmt.Errorf("failed to retrieve reader of object encrypted with KMS key %s, check that the key can be used to decrypt: %w", attrs.KMSKeyName, err)
+		}
 		return o, fmt.Errorf("failed to retrieve object reader: %w", err)
 	}
 
@@ -895,3 +895,33 @@ func Test_isObjectSizeValid(t *testing.T) {
 		})
 	}
 }
+
+func TestGCSManagerObjectQueries(t *testing.T) {
+	g := &gcsManager{
+		includePrefixes:    []string{"config/", "secrets/"},
+		includeGenerations: true,
+	}
+	queries, err := g.objectQueries(&bucket{name: bucket1, startOffset: "config/b"})
+	assert.Nil(t, err)
+	assert.Len(t, queries, 2)
+	for i, prefix := range g.includePrefixes {
+		assert.Equal(t, prefix, queries[i].Prefix)
+		assert.Equal(t, "config/b", queries[i].StartOffset)
+		assert.True(t, queries[i].Versions)
+	}
+
+	// Without prefixes, the whole bucket is listed.
+	queries, err = (&gcsManager{}).objectQueries(&bucket{name: bucket1})
+	assert.Nil(t, err)
+	assert.Len(t, queries, 1)
+	assert.Equal(t, "", queries[0].Prefix)
+	assert.False(t, queries[0].Versions)
+}
+
+func Test_hasExcludedPrefix(t *testing.T) {
+	g := &gcsManager{excludePrefixes: []string{"logs/", "tmp"}}
+
+	assert.True(t, g.hasExcludedPrefix("logs/app.log"))
+	assert.True(t, g.hasExcludedPrefix("tmp.txt"))
+	assert.False(t, g.hasExcludedPrefix("config/logs/app.log"))
+}
@@ -112,6 +112,24 @@ func TestConfigureGCSManager(t *testing.T) {
 				excludeBuckets: map[string]struct{}{perfTestBucketGlob: {}},
 			},
 		},
+		{
+			name: "valid conn, requester pays, prefixes and generations",
+			conn: &sourcespb.GCS{
+				ProjectId:          testProjectID,
+				Credential:         &sourcespb.GCS_Adc{},
+				UserProject:        "billing-project",
+				IncludePrefixes:    []string{"config/"},
+				ExcludePrefixes:    []string{"config/tmp/"},
+				IncludeGenerations: true,
+			},
+			want: &gcsManager{
+				projectID:          testProjectID,
+				userProject:        "billing-project",
+				includePrefixes:    []string{"config/"},
+				excludePrefixes:    []string{"config/tmp/"},
+				includeGenerations: true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,91 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// SecretAge tells when a secret was added to a repository and whether it's
+// still there.
+type SecretAge struct {
+	// Commit is the first commit that added the secret, and Time is when it
+	// was committed.
+	Commit string
+	Time   time.Time
+	// AtHead reports whether the files of HEAD still have the secret.
+	AtHead bool
+}
+
+// FindSecretAge finds the first commit of any ref of the repo that added the
+// secret, with the pickaxe of git log, and whether HEAD still has it.
+func FindSecretAge(ctx context.Context, path, secret string) (SecretAge, error) {
+	var age SecretAge
+	if secret == "" {
+		return age, errors.New("the secret is empty")
+	}
+
+	out, err := exec.CommandContext(ctx, "git", "-C", path, "log", "--all", "--format=%H %cI", "-S"+secret).Output()
+	if err != nil {
+		return age, fmt.Errorf("could not search the history for the secret: %w", err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		hash, date, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		when, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return age, fmt.Errorf("could not parse the date of commit %s: %w", hash, err)
+		}
+		// Commits of different refs aren't ordered by date.
+		if age.Commit == "" || when.Before(age.Time) {
+			age.Commit, age.Time = hash, when
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return age, err
+	}
+	if age.Commit == "" {
+		return age, errors.New("no commit added the secret")
+	}
+
+	// git grep exits with 1 if it finds nothing.
+	err = exec.CommandContext(ctx, "git", "-C", path, "grep", "--quiet", "--fixed-strings", "-e", secret, "HEAD", "--").Run()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		age.AtHead = true
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == 1:
+	default:
+		return age, fmt.Errorf("could not search HEAD for the secret: %w", err)
+	}
+	return age, nil
+}
+
+// ExtraData returns the age as the extra data of a finding, with how long the
+// secret has been exposed until now.
+func (a SecretAge) ExtraData(now time.Time) map[string]string {
+	return map[string]string{
+		"introduced_commit": a.Commit,
+		"introduced_at":     a.Time.UTC().Format(time.RFC3339),
+		"exposed_days":      fmt.Sprint(int(now.Sub(a.Time).Hours() / 24)),
+		"present_at_head":   fmt.Sprint(a.AtHead),
+	}
+}
+
+// ReadFileAtHead reads a file of the repo at HEAD.
+func ReadFileAtHead(ctx context.Context, path, name string) ([]byte, error) {
+	out, err := exec.CommandContext(ctx, "git", "-C", path, "show", "HEAD:"+name).Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s at HEAD: %w", name, err)
+	}
+	return out, nil
+}
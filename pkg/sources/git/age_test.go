@@ -0,0 +1,66 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+func TestFindSecretAge(t *testing.T) {
+	ctx := context.Background()
+	repoPath := t.TempDir()
+	run := func(date string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repoPath}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+			"GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, output)
+		}
+		return string(output)
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("", "init", "--quiet")
+	write("config", "nothing yet\n")
+	run("2023-01-01T00:00:00Z", "add", "config")
+	run("2023-01-01T00:00:00Z", "commit", "--quiet", "-m", "add config")
+	write("config", "token=removed_secret\ntoken=kept_secret\n")
+	run("2023-02-01T00:00:00Z", "commit", "--quiet", "-am", "add secrets")
+	added := run("", "rev-parse", "HEAD")[:40]
+	write("config", "token=kept_secret\n")
+	run("2023-03-01T00:00:00Z", "commit", "--quiet", "-am", "remove a secret")
+
+	age, err := FindSecretAge(ctx, repoPath, "removed_secret")
+	assert.NoError(t, err)
+	assert.Equal(t, added, age.Commit)
+	assert.True(t, age.Time.Equal(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, age.AtHead)
+
+	age, err = FindSecretAge(ctx, repoPath, "kept_secret")
+	assert.NoError(t, err)
+	assert.Equal(t, added, age.Commit)
+	assert.True(t, age.AtHead)
+	assert.Equal(t, map[string]string{
+		"introduced_commit": added,
+		"introduced_at":     "2023-02-01T00:00:00Z",
+		"exposed_days":      "10",
+		"present_at_head":   "true",
+	}, age.ExtraData(time.Date(2023, 2, 11, 12, 0, 0, 0, time.UTC)))
+
+	_, err = FindSecretAge(ctx, repoPath, "never_committed")
+	assert.Error(t, err)
+}
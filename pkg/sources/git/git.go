@@ -366,53 +366,72 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 		}
 		depth++
 		logger.V(5).Info("scanning commit", "commit", commit.Hash)
-		for _, diff := range commit.Diffs {
-			if !scanOptions.Filter.Pass(diff.PathB) {
-				continue
-			}
+		s.scanCommit(ctx, repo, path, commit, scanOptions, urlMetadata, chunksChan)
+	}
+	return nil
+}
 
-			fileName := diff.PathB
-			if fileName == "" {
-				continue
-			}
-			var email, hash, when string
-			email = commit.Author
-			hash = commit.Hash
-			when = commit.Date.Format("2006-01-02 15:04:05 -0700")
+// scanCommit sends the diffs of a commit to be scanned.
+func (s *Git) scanCommit(ctx context.Context, repo *git.Repository, path string, commit gitparse.Commit, scanOptions *ScanOptions, urlMetadata string, chunksChan chan *sources.Chunk) {
+	for _, diff := range commit.Diffs {
+		if !scanOptions.Filter.PassFile(diff.PathB, int64(diff.Content.Len())) {
+			continue
+		}
 
-			// Handle binary files by reading the entire file rather than using the diff.
-			if diff.IsBinary {
-				commitHash := plumbing.NewHash(hash)
-				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, 0)
-				chunkSkel := &sources.Chunk{
-					SourceName:     s.sourceName,
-					SourceID:       s.sourceID,
-					SourceType:     s.sourceType,
-					SourceMetadata: metadata,
-					Verify:         s.verify,
-				}
-				if err := handleBinary(ctx, repo, chunksChan, chunkSkel, commitHash, fileName); err != nil {
-					logger.V(1).Info("error handling binary file", "error", err, "filename", fileName, "commit", commitHash, "file", diff.PathB)
-				}
-				continue
+		fileName := diff.PathB
+		if fileName == "" {
+			continue
+		}
+		var email, hash, when string
+		email = commit.Author
+		hash = commit.Hash
+		when = commit.Date.Format("2006-01-02 15:04:05 -0700")
+
+		// Handle binary files by reading the entire file rather than using the diff.
+		if diff.IsBinary {
+			commitHash := plumbing.NewHash(hash)
+			metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, 0)
+			chunkSkel := &sources.Chunk{
+				SourceName:     s.sourceName,
+				SourceID:       s.sourceID,
+				SourceType:     s.sourceType,
+				SourceMetadata: metadata,
+				Verify:         s.verify,
 			}
-
-			if diff.Content.Len() > sources.ChunkSize+sources.PeekSize {
-				s.gitChunk(ctx, diff, fileName, email, hash, when, urlMetadata, chunksChan)
-				continue
+			if err := handleBinary(ctx, repo, path, chunksChan, chunkSkel, commitHash, fileName); err != nil {
+				ctx.Logger().V(1).Info("error handling binary file", "repo", urlMetadata, "error", err, "filename", fileName, "commit", commitHash, "file", diff.PathB)
 			}
-			metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, int64(diff.LineStart))
-			chunksChan <- &sources.Chunk{
+			continue
+		}
+
+		if scanOptions.ScanLFS && mayBeLFSPointer(diff.Content.Bytes()) {
+			metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, 0)
+			chunkSkel := &sources.Chunk{
 				SourceName:     s.sourceName,
 				SourceID:       s.sourceID,
 				SourceType:     s.sourceType,
 				SourceMetadata: metadata,
-				Data:           diff.Content.Bytes(),
 				Verify:         s.verify,
 			}
+			if err := handleLFSPointer(ctx, repo, path, scanOptions, chunksChan, chunkSkel, plumbing.NewHash(hash), fileName); err != nil {
+				ctx.Logger().V(1).Info("error handling LFS object", "repo", urlMetadata, "error", err, "filename", fileName, "commit", hash)
+			}
+		}
+
+		if diff.Content.Len() > sources.ChunkSize+sources.PeekSize {
+			s.gitChunk(ctx, diff, fileName, email, hash, when, urlMetadata, chunksChan)
+			continue
+		}
+		metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, int64(diff.LineStart))
+		chunksChan <- &sources.Chunk{
+			SourceName:     s.sourceName,
+			SourceID:       s.sourceID,
+			SourceType:     s.sourceType,
+			SourceMetadata: metadata,
+			Data:           diff.Content.Bytes(),
+			Verify:         s.verify,
 		}
 	}
-	return nil
 }
 
 func (s *Git) gitChunk(ctx context.Context, diff gitparse.Diff, fileName, email, hash, when, urlMetadata string, chunksChan chan *sources.Chunk) {
@@ -509,7 +528,7 @@ func (s *Git) ScanStaged(ctx context.Context, repo *git.Repository, path string,
 				}
 			}
 
-			if !scanOptions.Filter.Pass(diff.PathB) {
+			if !scanOptions.Filter.PassFile(diff.PathB, int64(diff.Content.Len())) {
 				continue
 			}
 
@@ -517,14 +536,14 @@ func (s *Git) ScanStaged(ctx context.Context, repo *git.Repository, path string,
 			if fileName == "" {
 				continue
 			}
-			var email, hash, when string
+			var email, when string
 			email = commit.Author
-			hash = commit.Hash
 			when = commit.Date.Format("2006-01-02 15:04:05 -0700")
 
-			// Handle binary files by reading the entire file rather than using the diff.
+			// Handle binary files by reading the entire file rather than using
+			// the diff. Staged files are read from the index, since they
+			// aren't in a commit yet.
 			if diff.IsBinary {
-				commitHash := plumbing.NewHash(hash)
 				metadata := s.sourceMetadataFunc(fileName, email, "Staged", when, urlMetadata, 0)
 				chunkSkel := &sources.Chunk{
 					SourceName:     s.sourceName,
@@ -533,7 +552,7 @@ func (s *Git) ScanStaged(ctx context.Context, repo *git.Repository, path string,
 					SourceMetadata: metadata,
 					Verify:         s.verify,
 				}
-				if err := handleBinary(ctx, repo, chunksChan, chunkSkel, commitHash, fileName); err != nil {
+				if err := handleStagedBinary(ctx, repo, chunksChan, chunkSkel, fileName); err != nil {
 					logger.V(1).Info("error handling binary file", "error", err, "filename", fileName)
 				}
 				continue
@@ -567,6 +586,14 @@ func (s *Git) ScanRepo(ctx context.Context, repo *git.Repository, repoPath strin
 	if err := s.ScanStaged(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
 		ctx.Logger().V(1).Info("error scanning unstaged changes", "error", err)
 	}
+	if err := s.ScanRefs(ctx, repo, repoPath, scanOptions, chunksChan); err != nil {
+		ctx.Logger().V(1).Info("error scanning notes, stash and reflog", "error", err)
+	}
+	if scanOptions.SubmoduleDepth > 0 {
+		if err := s.scanSubmodules(ctx, repo, scanOptions, chunksChan); err != nil {
+			ctx.Logger().V(1).Info("error scanning submodules", "error", err)
+		}
+	}
 
 	// We're logging time, but the repoPath is usally a dynamically generated folder in /tmp
 	// To make this duration logging useful, we need to log the remote as well
@@ -825,9 +852,18 @@ func getSafeRemoteURL(repo *git.Repository, preferred string) string {
 	return safeURL
 }
 
-func handleBinary(ctx context.Context, repo *git.Repository, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, commitHash plumbing.Hash, path string) error {
+func handleBinary(ctx context.Context, repo *git.Repository, repoPath string, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, commitHash plumbing.Hash, path string) error {
 	ctx.Logger().V(5).Info("handling binary file", "path", path)
 	commit, err := repo.CommitObject(commitHash)
+	if err == plumbing.ErrObjectNotFound {
+		// The commit may be in the quarantine of a pre-receive hook, which
+		// only the git CLI reads.
+		data, err := exec.Command("git", "-C", repoPath, "cat-file", "blob", commitHash.String()+":"+path).Output()
+		if err != nil {
+			return fmt.Errorf("could not read %s of commit %s: %w", path, commitHash, err)
+		}
+		return chunkBinary(ctx, bytes.NewReader(data), chunksChan, chunkSkel, path)
+	}
 	if err != nil {
 		return err
 	}
@@ -843,6 +879,37 @@ func handleBinary(ctx context.Context, repo *git.Repository, chunksChan chan *so
 	}
 	defer fileReader.Close()
 
+	return chunkBinary(ctx, fileReader, chunksChan, chunkSkel, path)
+}
+
+// handleStagedBinary chunks the version of a binary file staged in the index.
+func handleStagedBinary(ctx context.Context, repo *git.Repository, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, path string) error {
+	ctx.Logger().V(5).Info("handling staged binary file", "path", path)
+	index, err := repo.Storer.Index()
+	if err != nil {
+		return err
+	}
+	entry, err := index.Entry(path)
+	if err != nil {
+		return err
+	}
+	blob, err := repo.BlobObject(entry.Hash)
+	if err != nil {
+		return err
+	}
+
+	blobReader, err := blob.Reader()
+	if err != nil {
+		return err
+	}
+	defer blobReader.Close()
+
+	return chunkBinary(ctx, blobReader, chunksChan, chunkSkel, path)
+}
+
+// chunkBinary chunks a binary file with the file handlers, or as raw data if
+// none of them handles it.
+func chunkBinary(ctx context.Context, fileReader io.Reader, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, path string) error {
 	reader, err := diskbufferreader.New(fileReader)
 	if err != nil {
 		return err
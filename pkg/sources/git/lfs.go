@@ -0,0 +1,121 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	// DefaultMaxLFSObjectSize is the size of the largest LFS object that is
+	// fetched and scanned by default.
+	DefaultMaxLFSObjectSize = 50 * 1024 * 1024
+
+	lfsPointerVersion = "version https://git-lfs.github.com/spec/v1"
+	// lfsMaxPointerSize is the size of the largest file that is read as an LFS
+	// pointer. Pointers are around 130 bytes.
+	lfsMaxPointerSize = 1024
+)
+
+// lfsPointer is a pointer file that stands in for an LFS object in the git
+// history.
+type lfsPointer struct {
+	oid  string
+	size int64
+}
+
+// String returns the canonical content of the pointer file.
+func (p lfsPointer) String() string {
+	return fmt.Sprintf("%s\noid sha256:%s\nsize %d\n", lfsPointerVersion, p.oid, p.size)
+}
+
+// parseLFSPointer parses the content of an LFS pointer file.
+func parseLFSPointer(data []byte) (lfsPointer, bool) {
+	var pointer lfsPointer
+	if len(data) > lfsMaxPointerSize || !bytes.HasPrefix(data, []byte(lfsPointerVersion)) {
+		return pointer, false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, _ := strings.Cut(scanner.Text(), " ")
+		switch key {
+		case "oid":
+			pointer.oid = strings.TrimPrefix(value, "sha256:")
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return pointer, false
+			}
+			pointer.size = size
+		}
+	}
+	if len(pointer.oid) != 64 {
+		return pointer, false
+	}
+	return pointer, true
+}
+
+// mayBeLFSPointer reports whether a diff may add an LFS pointer. Only the
+// changed lines of the pointer are in the diff, so the object id is looked
+// for.
+func mayBeLFSPointer(content []byte) bool {
+	return len(content) <= lfsMaxPointerSize && bytes.Contains(content, []byte("oid sha256:"))
+}
+
+// handleLFSPointer fetches and scans the LFS object that a file in a commit
+// points to, if it's an LFS pointer. Objects are fetched with git-lfs, so that
+// they're read from the local LFS store when present, and downloaded with the
+// credentials of the remote otherwise.
+func handleLFSPointer(ctx context.Context, repo *git.Repository, repoPath string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk, chunkSkel *sources.Chunk, commitHash plumbing.Hash, path string) error {
+	commit, err := repo.CommitObject(commitHash)
+	if err != nil {
+		return err
+	}
+	file, err := commit.File(path)
+	if err != nil {
+		return err
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return err
+	}
+	pointer, ok := parseLFSPointer([]byte(content))
+	if !ok {
+		return nil
+	}
+
+	maxSize := scanOptions.MaxLFSObjectSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxLFSObjectSize
+	}
+	if pointer.size > maxSize {
+		ctx.Logger().V(2).Info("skipping LFS object larger than the max size", "path", path, "size", pointer.size, "max_size", maxSize)
+		return nil
+	}
+
+	cmd := exec.Command("git", "-C", repoPath, "lfs", "smudge", "--", path)
+	cmd.Stdin = strings.NewReader(pointer.String())
+	data, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("could not fetch LFS object %s: %w", pointer.oid, err)
+	}
+	ctx.Logger().V(5).Info("fetched LFS object", "path", path, "oid", pointer.oid)
+
+	if handlers.HandleFile(ctx, bytes.NewReader(data), chunkSkel, chunksChan) {
+		return nil
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	chunksChan <- &chunk
+	return nil
+}
@@ -0,0 +1,71 @@
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/gitparse"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// PushedRevisions reads the ref updates that git passes to a pre-receive hook
+// on standard input, one "<old-value> <new-value> <ref-name>" line per ref, and
+// returns the new values of the refs that aren't deleted.
+func PushedRevisions(r io.Reader) ([]string, error) {
+	var revisions []string
+	seen := map[string]struct{}{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: expected <old-value> <new-value> <ref-name>, got %q", line, scanner.Text())
+		}
+		newValue := fields[1]
+		if plumbing.NewHash(newValue).IsZero() {
+			// The ref is deleted.
+			continue
+		}
+		if _, ok := seen[newValue]; ok {
+			continue
+		}
+		seen[newValue] = struct{}{}
+		revisions = append(revisions, newValue)
+	}
+	return revisions, scanner.Err()
+}
+
+// ScanPush scans the commits that a push adds to a repo, from a pre-receive
+// hook: the commits reachable from the pushed revisions but not from any ref,
+// so commits that the repo already has aren't scanned again.
+func (s *Git) ScanPush(ctx context.Context, repo *git.Repository, path string, revisions []string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+	if err := gitCmdCheck(); err != nil {
+		return err
+	}
+	if scanOptions == nil {
+		scanOptions = NewScanOptions()
+	}
+
+	commitChan, err := gitparse.NewParser().Pushed(ctx, path, revisions...)
+	if err != nil {
+		return err
+	}
+
+	urlMetadata := getSafeRemoteURL(repo, "origin")
+	for commit := range commitChan {
+		ctx.Logger().V(5).Info("scanning pushed commit", "commit", commit.Hash)
+		s.scanCommit(ctx, repo, path, commit, scanOptions, urlMetadata, chunksChan)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/gitparse"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// ScanRefs scans the git notes, the stash entries and the reflog-only commits
+// of a repo, as enabled by the scan options. None of them are part of the
+// history of a branch, so secrets that were removed from a branch can still be
+// found there.
+func (s *Git) ScanRefs(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	if !scanOptions.ScanNotes && !scanOptions.ScanStash && !scanOptions.ScanReflog {
+		return nil
+	}
+	if err := gitCmdCheck(); err != nil {
+		return err
+	}
+
+	var revisions [][]string
+	if scanOptions.ScanNotes {
+		revisions = append(revisions, []string{"--glob=refs/notes/*"})
+	}
+	if scanOptions.ScanStash {
+		stashes, err := stashCommits(path)
+		if err != nil {
+			ctx.Logger().V(1).Info("could not list stash entries", "error", err)
+		} else if len(stashes) > 0 {
+			revisions = append(revisions, append([]string{"--no-walk"}, stashes...))
+		}
+	}
+	if scanOptions.ScanReflog {
+		// Commits that are reachable from a reflog, but not from any ref.
+		revisions = append(revisions, []string{"--reflog", "--not", "--all"})
+	}
+
+	urlMetadata := getSafeRemoteURL(repo, "origin")
+	for _, revs := range revisions {
+		commitChan, err := gitparse.NewParser().Revisions(ctx, path, revs...)
+		if err != nil {
+			return err
+		}
+		for commit := range commitChan {
+			s.scanCommit(ctx, repo, path, commit, scanOptions, urlMetadata, chunksChan)
+		}
+	}
+	return nil
+}
+
+// stashCommits returns the commits of the stash entries of a repo. Besides the
+// commit of each entry, which holds the changes to tracked files, this
+// includes the commit of the untracked files that were stashed with them.
+func stashCommits(path string) ([]string, error) {
+	if exec.Command("git", "-C", path, "rev-parse", "--verify", "--quiet", "refs/stash").Run() != nil {
+		// There are no stash entries.
+		return nil, nil
+	}
+	out, err := exec.Command("git", "-C", path, "log", "--walk-reflogs", "--format=%H %P", "refs/stash").Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not list stash entries: %w", err)
+	}
+
+	var commits []string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		// The parents of a stash entry are the HEAD it was made on, the
+		// index, and the untracked files if they were stashed.
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		commits = append(commits, fields[0])
+		if len(fields) > 3 {
+			commits = append(commits, fields[3])
+		}
+	}
+	return commits, scanner.Err()
+}
+
+// FetchNotes fetches the git notes refs of the origin of a repo, which aren't
+// fetched when a repo is cloned.
+func FetchNotes(ctx context.Context, path string) error {
+	output, err := exec.Command("git", "-C", path, "fetch", "--quiet", "origin", "+refs/notes/*:refs/notes/*").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("could not fetch notes: %w: %s", err, output)
+	}
+	ctx.Logger().V(2).Info("fetched notes", "path", path)
+	return nil
+}
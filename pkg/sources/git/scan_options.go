@@ -12,6 +12,22 @@ type ScanOptions struct {
 	MaxDepth     int64
 	ExcludeGlobs []string
 	LogOptions   *git.LogOptions
+	// ScanNotes, ScanStash and ScanReflog extend the scan to the git notes
+	// refs, the stash entries, and the commits that are only reachable from
+	// the reflogs, e.g. commits that were amended or force-pushed away.
+	ScanNotes  bool
+	ScanStash  bool
+	ScanReflog bool
+	// SubmoduleDepth is how many levels of submodules are cloned and
+	// scanned. Zero disables scanning submodules.
+	SubmoduleDepth int
+	// SubmoduleAllowlist is a list of URL globs of the submodules that may be
+	// cloned. If it's empty, only submodules on the same host as the repo are.
+	SubmoduleAllowlist []string
+	// ScanLFS enables fetching and scanning the LFS objects that LFS pointers
+	// in the history point to, up to MaxLFSObjectSize bytes each.
+	ScanLFS          bool
+	MaxLFSObjectSize int64
 }
 
 type ScanOption func(*ScanOptions)
@@ -52,6 +68,38 @@ func ScanOptionLogOptions(logOptions *git.LogOptions) ScanOption {
 	}
 }
 
+func ScanOptionNotes(scanNotes bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.ScanNotes = scanNotes
+	}
+}
+
+func ScanOptionStash(scanStash bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.ScanStash = scanStash
+	}
+}
+
+func ScanOptionReflog(scanReflog bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.ScanReflog = scanReflog
+	}
+}
+
+func ScanOptionSubmodules(depth int, allowlist []string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.SubmoduleDepth = depth
+		scanOptions.SubmoduleAllowlist = allowlist
+	}
+}
+
+func ScanOptionLFS(scanLFS bool, maxObjectSize int64) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.ScanLFS = scanLFS
+		scanOptions.MaxLFSObjectSize = maxObjectSize
+	}
+}
+
 func NewScanOptions(options ...ScanOption) *ScanOptions {
 	scanOptions := &ScanOptions{
 		Filter:   common.FilterEmpty(),
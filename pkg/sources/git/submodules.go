@@ -0,0 +1,119 @@
+package git
+
+import (
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/gobwas/glob"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanSubmodules clones and scans the submodules of a repo. Their own
+// submodules are scanned until the submodule depth of the scan options is
+// reached.
+func (s *Git) scanSubmodules(ctx context.Context, repo *git.Repository, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	wt, err := repo.Worktree()
+	if err != nil {
+		// Bare repos don't have a .gitmodules file to read.
+		return nil
+	}
+	submodules, err := wt.Submodules()
+	if err != nil {
+		return err
+	}
+
+	var parentURL string
+	if remote, err := repo.Remote("origin"); err == nil && len(remote.Config().URLs) > 0 {
+		parentURL = remote.Config().URLs[0]
+	}
+
+	for _, submodule := range submodules {
+		cfg := submodule.Config()
+		submoduleURL := resolveSubmoduleURL(parentURL, cfg.URL)
+		safeURL, _ := stripPassword(submoduleURL)
+		logger := ctx.Logger().WithValues("submodule", cfg.Path, "url", safeURL)
+		if !submoduleAllowed(parentURL, submoduleURL, scanOptions.SubmoduleAllowlist) {
+			logger.V(2).Info("skipping submodule that isn't allowed")
+			continue
+		}
+		if err := s.scanSubmodule(ctx, parentURL, submoduleURL, scanOptions, chunksChan); err != nil {
+			logger.Error(err, "unable to scan submodule, continuing")
+		}
+	}
+	return nil
+}
+
+func (s *Git) scanSubmodule(ctx context.Context, parentURL, submoduleURL string, scanOptions *ScanOptions, chunksChan chan *sources.Chunk) error {
+	// Submodules on the same host as the repo are cloned with its credentials.
+	var userInfo *url.Userinfo
+	if parent, err := gitURLParse(parentURL); err == nil && sameHost(parentURL, submoduleURL) {
+		userInfo = parent.User
+	}
+	subPath, subRepo, err := CloneRepo(ctx, userInfo, submoduleURL)
+	defer os.RemoveAll(subPath)
+	if err != nil {
+		return err
+	}
+
+	// The base and head commits are those of the repo, not the submodule.
+	subOptions := *scanOptions
+	subOptions.BaseHash = ""
+	subOptions.HeadHash = ""
+	subOptions.SubmoduleDepth--
+	return s.ScanRepo(ctx, subRepo, subPath, &subOptions, chunksChan)
+}
+
+// resolveSubmoduleURL resolves the URL of a submodule, which can be relative
+// to the URL of the repo.
+func resolveSubmoduleURL(parentURL, submoduleURL string) string {
+	if !strings.HasPrefix(submoduleURL, "./") && !strings.HasPrefix(submoduleURL, "../") {
+		return submoduleURL
+	}
+	parent, err := gitURLParse(parentURL)
+	if err != nil || parentURL == "" {
+		return submoduleURL
+	}
+	parent.Path = path.Join(parent.Path, submoduleURL)
+	return parent.String()
+}
+
+// submoduleAllowed reports whether a submodule may be cloned. Without an
+// allowlist, only submodules on the same host as the repo are allowed, so that
+// a scanned repo can't make the scan reach out to arbitrary hosts.
+func submoduleAllowed(parentURL, submoduleURL string, allowlist []string) bool {
+	if len(allowlist) == 0 {
+		return sameHost(parentURL, submoduleURL)
+	}
+	safeURL, err := stripPassword(submoduleURL)
+	if err != nil {
+		return false
+	}
+	for _, pattern := range allowlist {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			continue
+		}
+		if g.Match(safeURL) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameHost reports whether two git URLs are on the same host.
+func sameHost(a, b string) bool {
+	ua, err := gitURLParse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := gitURLParse(b)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(ua.Hostname(), ub.Hostname())
+}
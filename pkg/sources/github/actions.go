@@ -0,0 +1,156 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// maxActionsLogSize caps the size of the log archive of a workflow run. The
+// archive is read into memory, since zip files can't be read as a stream.
+const maxActionsLogSize = 100 * 1024 * 1024
+
+// scanActionsLogs scans the logs of the workflow runs of a repository that
+// were created since the configured time, or of all runs that still have logs
+// if it's not set.
+func (s *Source) scanActionsLogs(ctx context.Context, repoURL string, chunksChan chan *sources.Chunk) error {
+	owner, repo, ok := ownerAndRepo(repoURL)
+	if !ok {
+		// Gists don't have workflows.
+		return nil
+	}
+	opts := &github.ListWorkflowRunsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: defaultPagination,
+		},
+	}
+	if since := s.conn.GetActionsLogsSince(); since != nil {
+		opts.Created = ">=" + since.AsTime().UTC().Format(time.RFC3339)
+	}
+	for {
+		runs, res, err := s.apiClient.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not list workflow runs for repo %s: %w", repoURL, err)
+		}
+		for _, run := range runs.WorkflowRuns {
+			if err := s.scanWorkflowRunLogs(ctx, repoURL, owner, repo, run, chunksChan); err != nil {
+				// Logs expire, and runs that are in progress don't have any yet.
+				s.log.V(2).Info("could not scan workflow run logs", "repo", repoURL, "run", run.GetID(), "error", err)
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	return nil
+}
+
+// scanWorkflowRunLogs downloads the log archive of a workflow run and scans
+// the log of each of its steps.
+func (s *Source) scanWorkflowRunLogs(ctx context.Context, repoURL, owner, repo string, run *github.WorkflowRun, chunksChan chan *sources.Chunk) error {
+	var logsURL string
+	for {
+		u, res, err := s.apiClient.Actions.GetWorkflowRunLogs(ctx, owner, repo, run.GetID(), true)
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		logsURL = u.String()
+		break
+	}
+
+	// The logs are served from a different host, which must not get the
+	// credentials of the API client.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logsURL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := common.RetryableHttpClientTimeout(60).Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code downloading logs: %d", res.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxActionsLogSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxActionsLogSize {
+		return fmt.Errorf("log archive is larger than %d bytes", maxActionsLogSize)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("could not open log archive: %w", err)
+	}
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		if err := s.chunkWorkflowLog(ctx, repoURL, run, file, chunksChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chunkWorkflowLog sends the log of a step of a workflow run to be scanned.
+func (s *Source) chunkWorkflowLog(ctx context.Context, repoURL string, run *github.WorkflowRun, file *zip.File, chunksChan chan *sources.Chunk) error {
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+
+	chunk := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: &source_metadatapb.Github{
+					Link:       sanitizer.UTF8(run.GetHTMLURL()),
+					File:       sanitizer.UTF8(file.Name),
+					Commit:     sanitizer.UTF8(run.GetHeadSHA()),
+					Repository: sanitizer.UTF8(repoURL),
+					Timestamp:  run.GetCreatedAt().Format("2006-01-02 15:04:05 -0700"),
+					Visibility: s.visibilityOf(repoURL),
+				},
+			},
+		},
+		Data:   data,
+		Verify: s.verify,
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- chunk:
+		return nil
+	}
+}
@@ -0,0 +1,115 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bradleyfalzon/ghinstallation/v2"
+	"github.com/go-errors/errors"
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// installationTransport authenticates each request as the installation of the
+// app on the account the request is about, so that every organization is
+// scanned with the tokens and rate limit of its own installation. The
+// installation tokens are minted on first use and refreshed before they
+// expire.
+type installationTransport struct {
+	// installations are keyed by the lowercase login of their account.
+	installations map[string]*ghinstallation.Transport
+	// fallback is used for requests about other accounts, and requests that
+	// aren't about an account.
+	fallback *ghinstallation.Transport
+}
+
+func (t *installationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.forAccount(accountOf(req.URL.Path)).RoundTrip(req)
+}
+
+// forAccount returns the installation of the app on the account.
+func (t *installationTransport) forAccount(account string) *ghinstallation.Transport {
+	if itr, ok := t.installations[strings.ToLower(account)]; ok {
+		return itr
+	}
+	return t.fallback
+}
+
+// token returns an installation token to clone the repository with.
+func (t *installationTransport) token(ctx context.Context, repoURL string) (string, error) {
+	var account string
+	if u, err := url.Parse(repoURL); err == nil {
+		account = strings.Split(strings.Trim(u.Path, "/"), "/")[0]
+	}
+	return t.forAccount(account).Token(ctx)
+}
+
+// containsFold reports whether the logins contain the login, ignoring case.
+func containsFold(logins []string, login string) bool {
+	for _, l := range logins {
+		if strings.EqualFold(l, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// accountOf returns the account an API request path is about, e.g. octocat
+// for /repos/octocat/hello-world or /api/v3/orgs/octocat/members.
+func accountOf(path string) string {
+	path = strings.TrimPrefix(path, "/api/v3")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	switch parts[0] {
+	case "repos", "orgs", "users":
+		return parts[1]
+	}
+	return ""
+}
+
+// newInstallationTransport creates the transports of the installations of an
+// app. If no installation is configured, every installation of the app is
+// used.
+func (s *Source) newInstallationTransport(ctx context.Context, appItr *ghinstallation.AppsTransport, installationID int64, installationClient *github.Client) (*installationTransport, error) {
+	t := &installationTransport{installations: map[string]*ghinstallation.Transport{}}
+	if installationID != 0 {
+		t.fallback = ghinstallation.NewFromAppsTransport(appItr, installationID)
+		return t, nil
+	}
+
+	opts := &github.ListOptions{
+		PerPage: defaultPagination,
+	}
+	for {
+		installs, res, err := installationClient.Apps.ListInstallations(ctx, opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return nil, errors.WrapPrefix(err, "unable to list app installations", 0)
+		}
+		for _, install := range installs {
+			itr := ghinstallation.NewFromAppsTransport(appItr, install.GetID())
+			t.installations[strings.ToLower(install.GetAccount().GetLogin())] = itr
+			if t.fallback == nil {
+				t.fallback = itr
+			}
+			s.log.V(2).Info("Found app installation", "account", install.GetAccount().GetLogin())
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	if t.fallback == nil {
+		return nil, errors.New("the app is not installed on any account")
+	}
+	return t, nil
+}
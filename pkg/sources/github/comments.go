@@ -0,0 +1,267 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanComments scans the issues, pull requests and discussions of a
+// repository, and their comments. Tokens pasted into them never show up in the
+// git history.
+func (s *Source) scanComments(ctx context.Context, repoURL string, chunksChan chan *sources.Chunk) error {
+	owner, repo, ok := ownerAndRepo(repoURL)
+	if !ok {
+		// Gists don't have issues.
+		return nil
+	}
+	if err := s.scanIssues(ctx, repoURL, owner, repo, chunksChan); err != nil {
+		return err
+	}
+	if err := s.scanIssueComments(ctx, repoURL, owner, repo, chunksChan); err != nil {
+		return err
+	}
+	if err := s.scanReviewComments(ctx, repoURL, owner, repo, chunksChan); err != nil {
+		return err
+	}
+	return s.scanDiscussions(ctx, repoURL, owner, repo, chunksChan)
+}
+
+// scanIssues scans the titles and bodies of the issues and pull requests of a
+// repository.
+func (s *Source) scanIssues(ctx context.Context, repoURL, owner, repo string, chunksChan chan *sources.Chunk) error {
+	opts := &github.IssueListByRepoOptions{
+		State: "all",
+		ListOptions: github.ListOptions{
+			PerPage: defaultPagination,
+		},
+	}
+	for {
+		issues, res, err := s.apiClient.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not list issues for repo %s: %w", repoURL, err)
+		}
+		for _, issue := range issues {
+			body := issue.GetTitle() + "\n" + issue.GetBody()
+			if err := s.chunkComment(ctx, repoURL, issue.GetHTMLURL(), issue.GetUser().GetLogin(), issue.GetCreatedAt(), body, chunksChan); err != nil {
+				return err
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	return nil
+}
+
+// scanIssueComments scans the comments on the issues and pull requests of a
+// repository.
+func (s *Source) scanIssueComments(ctx context.Context, repoURL, owner, repo string, chunksChan chan *sources.Chunk) error {
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: defaultPagination,
+		},
+	}
+	for {
+		// Listing the comments of issue 0 lists the comments of all issues.
+		comments, res, err := s.apiClient.Issues.ListComments(ctx, owner, repo, 0, opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not list issue comments for repo %s: %w", repoURL, err)
+		}
+		for _, comment := range comments {
+			if err := s.chunkComment(ctx, repoURL, comment.GetHTMLURL(), comment.GetUser().GetLogin(), comment.GetCreatedAt(), comment.GetBody(), chunksChan); err != nil {
+				return err
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	return nil
+}
+
+// scanReviewComments scans the review comments on the diffs of the pull
+// requests of a repository.
+func (s *Source) scanReviewComments(ctx context.Context, repoURL, owner, repo string, chunksChan chan *sources.Chunk) error {
+	opts := &github.PullRequestListCommentsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: defaultPagination,
+		},
+	}
+	for {
+		// Listing the comments of pull request 0 lists the comments of all
+		// pull requests.
+		comments, res, err := s.apiClient.PullRequests.ListComments(ctx, owner, repo, 0, opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not list review comments for repo %s: %w", repoURL, err)
+		}
+		for _, comment := range comments {
+			if err := s.chunkComment(ctx, repoURL, comment.GetHTMLURL(), comment.GetUser().GetLogin(), comment.GetCreatedAt(), comment.GetBody(), chunksChan); err != nil {
+				return err
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	return nil
+}
+
+const discussionsQuery = `query($owner: String!, $name: String!, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    discussions(first: 50, after: $cursor) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        url title body createdAt author { login }
+        comments(first: 100) {
+          nodes {
+            url body createdAt author { login }
+            replies(first: 100) {
+              nodes { url body createdAt author { login } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+type discussionPost struct {
+	URL       string    `json:"url"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	Author    struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+type discussionsResponse struct {
+	Repository struct {
+		Discussions struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []struct {
+				discussionPost
+				Comments struct {
+					Nodes []struct {
+						discussionPost
+						Replies struct {
+							Nodes []discussionPost `json:"nodes"`
+						} `json:"replies"`
+					} `json:"nodes"`
+				} `json:"comments"`
+			} `json:"nodes"`
+		} `json:"discussions"`
+	} `json:"repository"`
+}
+
+// scanDiscussions scans the discussions of a repository and their comment
+// threads. Discussions are only available through the GraphQL API.
+func (s *Source) scanDiscussions(ctx context.Context, repoURL, owner, repo string, chunksChan chan *sources.Chunk) error {
+	variables := map[string]interface{}{"owner": owner, "name": repo}
+	for {
+		var data discussionsResponse
+		if err := s.graphQL(ctx, discussionsQuery, variables, &data); err != nil {
+			return fmt.Errorf("could not list discussions for repo %s: %w", repoURL, err)
+		}
+		discussions := data.Repository.Discussions
+		for _, discussion := range discussions.Nodes {
+			posts := []discussionPost{discussion.discussionPost}
+			posts[0].Body = discussion.Title + "\n" + discussion.Body
+			for _, comment := range discussion.Comments.Nodes {
+				posts = append(posts, comment.discussionPost)
+				posts = append(posts, comment.Replies.Nodes...)
+			}
+			for _, post := range posts {
+				if err := s.chunkComment(ctx, repoURL, post.URL, post.Author.Login, post.CreatedAt, post.Body, chunksChan); err != nil {
+					return err
+				}
+			}
+		}
+		if !discussions.PageInfo.HasNextPage {
+			return nil
+		}
+		variables["cursor"] = discussions.PageInfo.EndCursor
+	}
+}
+
+// chunkComment sends the body of an issue, pull request, discussion, comment
+// or release to be scanned.
+func (s *Source) chunkComment(ctx context.Context, repoURL, link, user string, created time.Time, body string, chunksChan chan *sources.Chunk) error {
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+	chunk := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: &source_metadatapb.Github{
+					Link:       sanitizer.UTF8(link),
+					Username:   sanitizer.UTF8(user),
+					Repository: sanitizer.UTF8(repoURL),
+					Timestamp:  created.Format("2006-01-02 15:04:05 -0700"),
+					Visibility: s.visibilityOf(repoURL),
+				},
+			},
+		},
+		Data:   []byte(body),
+		Verify: s.verify,
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- chunk:
+		return nil
+	}
+}
+
+// ownerAndRepo returns the owner and name of the repository at repoURL. It
+// returns false for gists.
+func ownerAndRepo(repoURL string) (string, string, bool) {
+	if isGist(repoURL) {
+		return "", "", false
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", false
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], strings.TrimSuffix(parts[1], ".git"), true
+}
@@ -63,6 +63,9 @@ type Source struct {
 	resumeInfoMutex sync.Mutex
 	resumeInfoSlice []string
 	apiClient       *github.Client
+	appTransport    *installationTransport
+	rateLimit       *common.RateLimitController
+	useGraphQL      bool
 	mu              sync.Mutex
 	publicMap       map[string]source_metadatapb.Visibility
 	sources.Progress
@@ -95,17 +98,15 @@ func (s *Source) UserAndToken(ctx context.Context, installationClient *github.Cl
 	case *sourcespb.GitHub_Unauthenticated:
 		// do nothing
 	case *sourcespb.GitHub_GithubApp:
-		id, err := strconv.ParseInt(cred.GithubApp.InstallationId, 10, 64)
-		if err != nil {
-			return "", "", errors.New(err)
+		if s.appTransport == nil {
+			return "", "", errors.New("app installations have not been enumerated")
 		}
-		// TODO: Check rate limit for this call.
-		token, _, err := installationClient.Apps.CreateInstallationToken(
-			ctx, id, &github.InstallationTokenOptions{})
+		// The token is cached until it is about to expire.
+		token, err := s.appTransport.fallback.Token(ctx)
 		if err != nil {
 			return "", "", errors.WrapPrefix(err, "unable to create installation token", 0)
 		}
-		return "x-access-token", token.GetToken(), nil // TODO: multiple workers request this, track the TTL
+		return "x-access-token", token, nil
 	case *sourcespb.GitHub_Token:
 		var (
 			ghUser *github.User
@@ -140,6 +141,10 @@ func (s *Source) Init(aCtx context.Context, name string, jobID, sourceID int64,
 	s.jobPool.SetLimit(concurrency)
 
 	s.httpClient = common.RetryableHttpClientTimeout(60)
+	// Throttle API requests as the rate limit runs low, instead of hitting it
+	// halfway through enumeration or scanning.
+	s.rateLimit = common.NewRateLimitController(concurrency)
+	s.httpClient.Transport = s.rateLimit.Transport(s.httpClient.Transport)
 	s.apiClient = github.NewClient(s.httpClient)
 
 	var conn sourcespb.GitHub
@@ -170,7 +175,7 @@ func (s *Source) Init(aCtx context.Context, name string, jobID, sourceID int64,
 						File:       sanitizer.UTF8(file),
 						Email:      sanitizer.UTF8(email),
 						Repository: sanitizer.UTF8(repository),
-						Link:       git.GenerateLink(repository, commit, file),
+						Link:       generateLink(repository, commit, file),
 						Timestamp:  sanitizer.UTF8(timestamp),
 						Line:       line,
 						Visibility: s.visibilityOf(repository),
@@ -234,6 +239,10 @@ func (s *Source) visibilityOf(repoURL string) (visibility source_metadatapb.Visi
 		owner := urlPathParts[1]
 		repoName := urlPathParts[2]
 		repoName = strings.TrimSuffix(repoName, ".git")
+		if isWiki(repoURL) {
+			// Wikis have the visibility of their repository.
+			repoName = strings.TrimSuffix(repoName, ".wiki")
+		}
 		for {
 			repo, resp, err = s.apiClient.Repositories.Get(context.TODO(), owner, repoName)
 			if !s.handleRateLimit(err, resp) {
@@ -308,11 +317,15 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 		}
 	}
 
+	// Enumerate repos through the GraphQL API, which requires authentication,
+	// before falling back to the REST API.
+	s.useGraphQL = true
+
 	// TODO: this should support scanning users too
 
 	specificScope := false
 
-	if len(s.repos) > 0 {
+	if len(s.repos) > 0 || len(s.conn.GistUsers) > 0 {
 		specificScope = true
 	}
 
@@ -404,9 +417,13 @@ func (s *Source) enumerateWithToken(ctx context.Context, apiEndpoint, token stri
 }
 
 func (s *Source) enumerateWithApp(ctx context.Context, apiEndpoint string, app *credentialspb.GitHubApp) (installationClient *github.Client, err error) {
-	installationID, err := strconv.ParseInt(app.InstallationId, 10, 64)
-	if err != nil {
-		return nil, errors.New(err)
+	// Without an installation ID, every installation of the app is scanned.
+	var installationID int64
+	if app.InstallationId != "" {
+		installationID, err = strconv.ParseInt(app.InstallationId, 10, 64)
+		if err != nil {
+			return nil, errors.New(err)
+		}
 	}
 
 	appID, err := strconv.ParseInt(app.AppId, 10, 64)
@@ -414,40 +431,53 @@ func (s *Source) enumerateWithApp(ctx context.Context, apiEndpoint string, app *
 		return nil, errors.New(err)
 	}
 
-	// This client is used for most APIs.
-	itr, err := ghinstallation.New(
+	// This client is required to list installations and create installation
+	// tokens. Otherwise, the required JWT is not in the request :/
+	appItr, err := ghinstallation.NewAppsTransport(
 		s.httpClient.Transport,
 		appID,
-		installationID,
 		[]byte(app.PrivateKey))
 	if err != nil {
 		return nil, errors.New(err)
 	}
-	itr.BaseURL = apiEndpoint
-	s.apiClient, err = github.NewEnterpriseClient(apiEndpoint, apiEndpoint, &http.Client{Transport: itr})
+	appItr.BaseURL = apiEndpoint
+	installationClient, err = github.NewEnterpriseClient(apiEndpoint, apiEndpoint, &http.Client{Transport: appItr})
 	if err != nil {
 		return nil, errors.New(err)
 	}
 
-	// This client is required to create installation tokens for cloning.
-	// Otherwise, the required JWT is not in the request for the token :/
-	appItr, err := ghinstallation.NewAppsTransport(
-		s.httpClient.Transport,
-		appID,
-		[]byte(app.PrivateKey))
+	// This client is used for most APIs.
+	s.appTransport, err = s.newInstallationTransport(ctx, appItr, installationID, installationClient)
 	if err != nil {
-		return nil, errors.New(err)
+		return nil, err
 	}
-	appItr.BaseURL = apiEndpoint
-	installationClient, err = github.NewEnterpriseClient(apiEndpoint, apiEndpoint, &http.Client{Transport: appItr})
+	s.apiClient, err = github.NewEnterpriseClient(apiEndpoint, apiEndpoint, &http.Client{Transport: s.appTransport})
 	if err != nil {
 		return nil, errors.New(err)
 	}
+	s.useGraphQL = true
 
 	// If no repos were provided, enumerate them.
 	if len(s.repos) == 0 {
-		if err = s.addReposByApp(ctx); err != nil {
-			return nil, err
+		itrs := []*ghinstallation.Transport{s.appTransport.fallback}
+		if len(s.appTransport.installations) > 0 {
+			itrs = itrs[:0]
+			for account, itr := range s.appTransport.installations {
+				// Only scan the installations on the given orgs, if any.
+				if len(s.orgs) > 0 && !containsFold(s.orgs, account) {
+					continue
+				}
+				itrs = append(itrs, itr)
+			}
+		}
+		for _, itr := range itrs {
+			apiClient, err := github.NewEnterpriseClient(apiEndpoint, apiEndpoint, &http.Client{Transport: itr})
+			if err != nil {
+				return nil, errors.New(err)
+			}
+			if err = s.addReposByApp(ctx, apiClient); err != nil {
+				return nil, err
+			}
 		}
 
 		// Check if we need to find user repos.
@@ -498,6 +528,7 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 		return errors.Errorf("Invalid configuration given for source. Name: %s, Type: %s", s.name, s.Type())
 	}
 
+	s.addGists(ctx)
 	s.normalizeRepos(ctx)
 
 	// We must sort the repos so we can resume later if necessary.
@@ -552,6 +583,9 @@ func (s *Source) scan(ctx context.Context, installationClient *github.Client, ch
 			var repo *gogit.Repository
 			var err error
 
+			if err = s.rateLimit.Wait(ctx); err != nil {
+				return nil
+			}
 			path, repo, err = s.cloneRepo(ctx, repoURL, installationClient)
 			if err != nil {
 				scanErrs = append(scanErrs, err)
@@ -569,6 +603,26 @@ func (s *Source) scan(ctx context.Context, installationClient *github.Client, ch
 				logger.Error(err, "unable to scan repo, continuing")
 				return nil
 			}
+			if s.conn.IncludeComments {
+				if err = s.scanComments(ctx, repoURL, chunksChan); err != nil {
+					logger.Error(err, "unable to scan repo comments, continuing")
+				}
+			}
+			if s.conn.IncludeWikis {
+				if err = s.scanWiki(ctx, repoURL, installationClient, chunksChan); err != nil {
+					logger.Error(err, "unable to scan repo wiki, continuing")
+				}
+			}
+			if s.conn.IncludeReleases {
+				if err = s.scanReleases(ctx, repoURL, chunksChan); err != nil {
+					logger.Error(err, "unable to scan repo releases, continuing")
+				}
+			}
+			if s.conn.IncludeActionsLogs {
+				if err = s.scanActionsLogs(ctx, repoURL, chunksChan); err != nil {
+					logger.Error(err, "unable to scan repo actions logs, continuing")
+				}
+			}
 			atomic.AddUint64(&scanned, 1)
 			logger.V(2).Info(fmt.Sprintf("scanned %d/%d repos", scanned, len(s.repos)))
 
@@ -597,12 +651,13 @@ func (s *Source) cloneRepo(ctx context.Context, repoURL string, installationClie
 		}
 
 	case *sourcespb.GitHub_GithubApp:
-		s.githubUser, s.githubToken, err = s.UserAndToken(ctx, installationClient)
+		// Each repo is cloned with a token of the installation on its owner.
+		token, err := s.appTransport.token(ctx, repoURL)
 		if err != nil {
 			return "", nil, fmt.Errorf("error getting token for repo %s: %w", repoURL, err)
 		}
 
-		path, repo, err = git.CloneRepoUsingToken(ctx, s.githubToken, repoURL, s.githubUser)
+		path, repo, err = git.CloneRepoUsingToken(ctx, token, repoURL, "x-access-token")
 		if err != nil {
 			return "", nil, fmt.Errorf("error cloning repo %s: %w", repoURL, err)
 		}
@@ -662,6 +717,14 @@ func (s *Source) handleRateLimit(errIn error, res *github.Response) bool {
 func (s *Source) getReposByOrg(ctx context.Context, org string) ([]string, error) {
 	logger := s.log.WithValues("org", org)
 
+	if s.useGraphQL {
+		repos, err := s.getReposByOwnerGraphQL(ctx, org)
+		if err == nil {
+			return repos, nil
+		}
+		logger.V(2).Info("GraphQL enumeration failed, falling back to REST", "error", err)
+	}
+
 	var repos []string
 	opts := &github.RepositoryListByOrgOptions{
 		ListOptions: github.ListOptions{
@@ -725,6 +788,16 @@ func (s *Source) addRepos(ctx context.Context, entity string, getRepos func(cont
 }
 
 func (s *Source) getReposByUser(ctx context.Context, user string) ([]string, error) {
+	logger := s.log.WithValues("user", user)
+
+	if s.useGraphQL {
+		repos, err := s.getReposByOwnerGraphQL(ctx, user)
+		if err == nil {
+			return repos, nil
+		}
+		logger.V(2).Info("GraphQL enumeration failed, falling back to REST", "error", err)
+	}
+
 	var repos []string
 	opts := &github.RepositoryListOptions{
 		ListOptions: github.ListOptions{
@@ -732,7 +805,6 @@ func (s *Source) getReposByUser(ctx context.Context, user string) ([]string, err
 		},
 	}
 
-	logger := s.log.WithValues("user", user)
 	for {
 		someRepos, res, err := s.apiClient.Repositories.List(ctx, user, opts)
 		if err == nil {
@@ -845,6 +917,43 @@ func (s *Source) addGistsByUser(ctx context.Context, user string) error {
 	return nil
 }
 
+// addGists adds the gists of the gist users, and with IncludeGists the gists
+// of the members of the orgs and of the authenticated user. The API only lists
+// the secret gists of the authenticated user, so the gists of other users are
+// limited to their public gists.
+func (s *Source) addGists(ctx context.Context) {
+	for _, user := range s.conn.GistUsers {
+		if err := s.addGistsByUser(ctx, user); err != nil {
+			s.log.Error(err, "error fetching gists by user", "user", user)
+		}
+	}
+	if !s.conn.IncludeGists {
+		return
+	}
+
+	if _, ok := s.conn.GetCredential().(*sourcespb.GitHub_Token); ok {
+		// Listing the gists of no user lists the public and secret gists of
+		// the authenticated user.
+		if err := s.addGistsByUser(ctx, ""); err != nil {
+			s.log.Error(err, "error fetching gists of the authenticated user")
+		}
+	}
+	// Members were already added with ScanUsers.
+	if !s.conn.ScanUsers {
+		for _, org := range s.orgs {
+			if err := s.addMembersByOrg(ctx, org); err != nil {
+				s.log.Error(err, "Unable to add members by org", "org", org)
+			}
+		}
+	}
+	s.log.V(2).Info("Adding gists", "members", len(s.members))
+	for _, member := range s.members {
+		if err := s.addGistsByUser(ctx, member); err != nil {
+			s.log.Error(err, "error fetching gists by user", "user", member)
+		}
+	}
+}
+
 func (s *Source) addMembersByApp(ctx context.Context, installationClient *github.Client) error {
 	opts := &github.ListOptions{
 		PerPage: membersAppPagination,
@@ -868,13 +977,15 @@ func (s *Source) addMembersByApp(ctx context.Context, installationClient *github
 	return nil
 }
 
-func (s *Source) addReposByApp(ctx context.Context) error {
+// addReposByApp adds the repos the installation of the API client can
+// access.
+func (s *Source) addReposByApp(ctx context.Context, apiClient *github.Client) error {
 	// Authenticated enumeration of repos
 	opts := &github.ListOptions{
 		PerPage: defaultPagination,
 	}
 	for {
-		someRepos, res, err := s.apiClient.Apps.ListRepos(ctx, opts)
+		someRepos, res, err := apiClient.Apps.ListRepos(ctx, opts)
 		if err == nil {
 			res.Body.Close()
 		}
@@ -1071,6 +1182,38 @@ func (s *Source) normalizeRepos(ctx context.Context) {
 	}
 }
 
+// generateLink returns a link to the file at the commit. Links into gists
+// point at the revision of the gist, and links into wikis at the revision of
+// the page.
+func generateLink(repository, commit, file string) string {
+	if isWiki(repository) {
+		return wikiLink(repository, commit, file)
+	}
+	if !isGist(repository) {
+		return git.GenerateLink(repository, commit, file)
+	}
+	link := strings.TrimSuffix(repository, ".git") + "/" + commit
+	if file != "" {
+		link += "#file-" + gistFileAnchor.ReplaceAllString(strings.ToLower(file), "-")
+	}
+	return link
+}
+
+// gistFileAnchor matches the characters GitHub replaces in the anchors of
+// gist files.
+var gistFileAnchor = regexp.MustCompile(`[^a-z0-9_]`)
+
+// isGist reports whether the repository URL is a gist on GitHub or GitHub
+// Enterprise, e.g. https://gist.github.com/<id>.git or
+// https://github.example.com/gist/<id>.git.
+func isGist(repoURL string) bool {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(u.Host, "gist.") || strings.HasPrefix(u.Path, "/gist/")
+}
+
 // setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
 func (s *Source) setProgressCompleteWithRepo(index int, offset int, repoURL string) {
 	s.resumeInfoMutex.Lock()
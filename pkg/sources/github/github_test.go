@@ -1,6 +1,7 @@
 package github
 
 import (
+	"archive/zip"
 	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
@@ -18,11 +19,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/h2non/gock.v1"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
 func createTestSource(src *sourcespb.GitHub) (*Source, *anypb.Any) {
@@ -104,6 +108,57 @@ func TestAddReposByOrg_IncludeRepos(t *testing.T) {
 	assert.True(t, gock.IsDone())
 }
 
+func TestAddReposByOrg_GraphQL(t *testing.T) {
+	defer gock.Off()
+
+	repo := func(name string, fork bool) map[string]interface{} {
+		return map[string]interface{}{"nameWithOwner": "super-secret-org/" + name, "url": "https://github.com/super-secret-org/" + name, "isFork": fork}
+	}
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"login":"super-secret-org"`).
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"repositoryOwner": map[string]interface{}{"repositories": map[string]interface{}{
+			"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "page2"},
+			"nodes":    []map[string]interface{}{repo("repo1", false), repo("fork", true)},
+		}}}})
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"cursor":"page2"`).
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"repositoryOwner": map[string]interface{}{"repositories": map[string]interface{}{
+			"pageInfo": map[string]interface{}{"hasNextPage": false},
+			"nodes":    []map[string]interface{}{repo("repo2", false)},
+		}}}})
+
+	s := initTestSource(nil)
+	s.useGraphQL = true
+	err := s.addRepos(context.TODO(), "super-secret-org", s.getReposByOrg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://github.com/super-secret-org/repo1.git", "https://github.com/super-secret-org/repo2.git"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
+func TestAddReposByOrg_GraphQLFallback(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(map[string]interface{}{"errors": []map[string]string{{"message": "Resource protected by organization SAML enforcement."}}})
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/repos").
+		Reply(200).
+		JSON([]map[string]string{{"clone_url": "super-secret-repo"}})
+
+	s := initTestSource(nil)
+	s.useGraphQL = true
+	err := s.addRepos(context.TODO(), "super-secret-org", s.getReposByOrg)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"super-secret-repo"}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
 func TestAddReposByUser(t *testing.T) {
 	defer gock.Off()
 
@@ -140,6 +195,221 @@ func TestAddGistsByUser(t *testing.T) {
 	assert.True(t, gock.IsDone())
 }
 
+func TestAddGists(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/users/gist-user/gists").
+		Reply(200).
+		JSON([]map[string]string{{"git_pull_url": "https://gist.github.com/1.git"}})
+	gock.New("https://api.github.com").
+		Get("/gists").
+		Reply(200).
+		JSON([]map[string]string{{"git_pull_url": "https://gist.github.com/2.git"}})
+	gock.New("https://api.github.com").
+		Get("/orgs/super-secret-org/members").
+		Reply(200).
+		JSON([]map[string]string{{"login": "ssm1"}})
+	gock.New("https://api.github.com").
+		Get("/users/ssm1/gists").
+		Reply(200).
+		JSON([]map[string]string{{"git_pull_url": "https://gist.github.com/3.git"}})
+
+	s := initTestSource(&sourcespb.GitHub{
+		Organizations: []string{"super-secret-org"},
+		IncludeGists:  true,
+		GistUsers:     []string{"gist-user"},
+		Credential:    &sourcespb.GitHub_Token{Token: "token"},
+	})
+	s.addGists(context.TODO())
+	assert.Equal(t, []string{
+		"https://gist.github.com/1.git",
+		"https://gist.github.com/2.git",
+		"https://gist.github.com/3.git",
+	}, s.repos)
+	assert.True(t, gock.IsDone())
+}
+
+func TestScanComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/issues").
+		MatchParam("state", "all").
+		Reply(200).
+		JSON([]map[string]string{{"title": "leak", "body": "issue body", "html_url": "https://github.com/super-secret-org/repo/issues/1"}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/issues/comments").
+		Reply(200).
+		JSON([]map[string]string{{"body": "", "html_url": "https://github.com/super-secret-org/repo/issues/1#issuecomment-1"}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/pulls/comments").
+		Reply(200).
+		JSON([]map[string]string{{"body": "review comment", "html_url": "https://github.com/super-secret-org/repo/pull/2#discussion_r1"}})
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"repository": map[string]interface{}{"discussions": map[string]interface{}{
+			"nodes": []map[string]interface{}{{
+				"url":   "https://github.com/super-secret-org/repo/discussions/3",
+				"title": "question",
+				"body":  "discussion body",
+				"comments": map[string]interface{}{"nodes": []map[string]interface{}{{
+					"body":    "discussion comment",
+					"replies": map[string]interface{}{"nodes": []map[string]string{{"body": "discussion reply"}}},
+				}}},
+			}},
+		}}}})
+
+	repoURL := "https://github.com/super-secret-org/repo.git"
+	s := initTestSource(&sourcespb.GitHub{IncludeComments: true})
+	s.publicMap[repoURL] = source_metadatapb.Visibility_public
+	chunksChan := make(chan *sources.Chunk, 10)
+	err := s.scanComments(context.TODO(), repoURL, chunksChan)
+	close(chunksChan)
+	assert.Nil(t, err)
+
+	var got []string
+	for chunk := range chunksChan {
+		got = append(got, string(chunk.Data))
+	}
+	assert.Equal(t, []string{
+		"leak\nissue body",
+		"review comment",
+		"question\ndiscussion body",
+		"discussion comment",
+		"discussion reply",
+	}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestScanCommentsGist(t *testing.T) {
+	s := initTestSource(&sourcespb.GitHub{IncludeComments: true})
+	chunksChan := make(chan *sources.Chunk, 1)
+	assert.Nil(t, s.scanComments(context.TODO(), "https://gist.github.com/aa5a315d61ae9438b18d.git", chunksChan))
+	assert.Equal(t, 0, len(chunksChan))
+}
+
+func TestScanReleases(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/releases").
+		Reply(200).
+		JSON([]map[string]interface{}{{
+			"name":     "v1.0.0",
+			"body":     "release notes",
+			"html_url": "https://github.com/super-secret-org/repo/releases/tag/v1.0.0",
+			"assets": []map[string]interface{}{{
+				"id":                   1,
+				"name":                 "config.txt",
+				"browser_download_url": "https://github.com/super-secret-org/repo/releases/download/v1.0.0/config.txt",
+			}},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/releases/assets/1").
+		Reply(200).
+		BodyString("asset contents")
+
+	repoURL := "https://github.com/super-secret-org/repo.git"
+	s := initTestSource(&sourcespb.GitHub{IncludeReleases: true})
+	s.publicMap[repoURL] = source_metadatapb.Visibility_public
+	chunksChan := make(chan *sources.Chunk, 10)
+	err := s.scanReleases(context.TODO(), repoURL, chunksChan)
+	close(chunksChan)
+	assert.Nil(t, err)
+
+	var got []string
+	for chunk := range chunksChan {
+		got = append(got, string(chunk.Data))
+	}
+	assert.Equal(t, []string{"v1.0.0\nrelease notes", "asset contents"}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestScanActionsLogs(t *testing.T) {
+	defer gock.Off()
+
+	var logs bytes.Buffer
+	archive := zip.NewWriter(&logs)
+	w, err := archive.Create("build/1_Run tests.txt")
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("echo $TOKEN"))
+	assert.Nil(t, err)
+	assert.Nil(t, archive.Close())
+
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/actions/runs").
+		MatchParam("created", ">=2023-01-01T00:00:00Z").
+		Reply(200).
+		JSON(map[string]interface{}{"workflow_runs": []map[string]interface{}{{"id": 1, "head_sha": "abc123"}}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-org/repo/actions/runs/1/logs").
+		Reply(302).
+		SetHeader("Location", "https://pipelines.actions.githubusercontent.com/logs.zip")
+	gock.New("https://pipelines.actions.githubusercontent.com").
+		Get("/logs.zip").
+		Reply(200).
+		Body(bytes.NewReader(logs.Bytes()))
+
+	repoURL := "https://github.com/super-secret-org/repo.git"
+	s := initTestSource(&sourcespb.GitHub{
+		IncludeActionsLogs: true,
+		ActionsLogsSince:   timestamppb.New(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	s.publicMap[repoURL] = source_metadatapb.Visibility_public
+	chunksChan := make(chan *sources.Chunk, 10)
+	err = s.scanActionsLogs(context.TODO(), repoURL, chunksChan)
+	close(chunksChan)
+	assert.Nil(t, err)
+
+	var got []string
+	for chunk := range chunksChan {
+		got = append(got, string(chunk.Data))
+		assert.Equal(t, "build/1_Run tests.txt", chunk.SourceMetadata.GetGithub().GetFile())
+	}
+	assert.Equal(t, []string{"echo $TOKEN"}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGenerateLink(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		file       string
+		want       string
+	}{
+		{
+			name:       "repository",
+			repository: "https://github.com/trufflesecurity/trufflehog.git",
+			file:       "main.go",
+			want:       "https://github.com/trufflesecurity/trufflehog/blob/abc123/main.go",
+		},
+		{
+			name:       "gist",
+			repository: "https://gist.github.com/aa5a315d61ae9438b18d.git",
+			file:       "Config.yml",
+			want:       "https://gist.github.com/aa5a315d61ae9438b18d/abc123#file-config-yml",
+		},
+		{
+			name:       "enterprise gist without file",
+			repository: "https://github.example.com/gist/aa5a315d61ae9438b18d.git",
+			want:       "https://github.example.com/gist/aa5a315d61ae9438b18d/abc123",
+		},
+		{
+			name:       "wiki",
+			repository: "https://github.com/trufflesecurity/trufflehog.wiki.git",
+			file:       "Getting-Started.md",
+			want:       "https://github.com/trufflesecurity/trufflehog/wiki/Getting-Started/abc123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, generateLink(tt.repository, "abc123", tt.file))
+		})
+	}
+}
+
 func TestAddMembersByApp(t *testing.T) {
 	defer gock.Off()
 
@@ -180,7 +450,7 @@ func TestAddReposByApp(t *testing.T) {
 		})
 
 	s := initTestSource(nil)
-	err := s.addReposByApp(context.TODO())
+	err := s.addReposByApp(context.TODO(), s.apiClient)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s.repos))
 	assert.Equal(t, []string{"ssr1", "ssr2"}, s.repos)
@@ -350,26 +620,28 @@ func TestEnumerateWithToken_IncludeRepos(t *testing.T) {
 	assert.True(t, gock.IsDone())
 }
 
+// generate a private key (it just needs to be in the right format)
+func testAppPrivateKey() string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	data := x509.MarshalPKCS1PrivateKey(key)
+	var pemKey bytes.Buffer
+	if err := pem.Encode(&pemKey, &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: data,
+	}); err != nil {
+		panic(err)
+	}
+	return pemKey.String()
+}
+
 func TestEnumerateWithApp(t *testing.T) {
 	defer gock.Off()
 
-	// generate a private key (it just needs to be in the right format)
-	privateKey := func() string {
-		key, err := rsa.GenerateKey(rand.Reader, 2048)
-		if err != nil {
-			panic(err)
-		}
-
-		data := x509.MarshalPKCS1PrivateKey(key)
-		var pemKey bytes.Buffer
-		if err := pem.Encode(&pemKey, &pem.Block{
-			Type:  "RSA PRIVATE KEY",
-			Bytes: data,
-		}); err != nil {
-			panic(err)
-		}
-		return pemKey.String()
-	}()
+	privateKey := testAppPrivateKey()
 
 	gock.New("https://api.github.com").
 		Post("/app/installations/1337/access_tokens").
@@ -397,6 +669,71 @@ func TestEnumerateWithApp(t *testing.T) {
 	assert.True(t, gock.IsDone())
 }
 
+func TestEnumerateWithApp_AllInstallations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/app/installations").
+		Reply(200).
+		JSON([]map[string]interface{}{
+			{"id": 1, "account": map[string]string{"login": "org-a"}},
+			{"id": 2, "account": map[string]string{"login": "Org-B"}},
+		})
+	gock.New("https://api.github.com").
+		Post("/app/installations/1/access_tokens").
+		Reply(200).
+		JSON(map[string]string{"token": "token-a", "expires_at": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	gock.New("https://api.github.com").
+		Post("/app/installations/2/access_tokens").
+		Reply(200).
+		JSON(map[string]string{"token": "token-b", "expires_at": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	gock.New("https://api.github.com").
+		Get("/installation/repositories").
+		MatchHeader("Authorization", "token token-a").
+		Reply(200).
+		JSON(map[string]interface{}{"repositories": []map[string]string{{"clone_url": "https://github.com/org-a/repo.git"}}})
+	gock.New("https://api.github.com").
+		Get("/installation/repositories").
+		MatchHeader("Authorization", "token token-b").
+		Reply(200).
+		JSON(map[string]interface{}{"repositories": []map[string]string{{"clone_url": "https://github.com/org-b/repo.git"}}})
+
+	s := initTestSource(nil)
+	_, err := s.enumerateWithApp(
+		context.TODO(),
+		"https://api.github.com",
+		&credentialspb.GitHubApp{
+			AppId:      "4141",
+			PrivateKey: testAppPrivateKey(),
+		},
+	)
+	assert.Nil(t, err)
+	sort.Strings(s.repos)
+	assert.Equal(t, []string{"https://github.com/org-a/repo.git", "https://github.com/org-b/repo.git"}, s.repos)
+	assert.True(t, gock.IsDone())
+
+	// Tokens are cached, and picked by the owner of the repo.
+	token, err := s.appTransport.token(context.TODO(), "https://github.com/org-b/repo.git")
+	assert.Nil(t, err)
+	assert.Equal(t, "token-b", token)
+	token, err = s.appTransport.token(context.TODO(), "https://github.com/someone-else/repo.git")
+	assert.Nil(t, err)
+	assert.Equal(t, "token-a", token)
+}
+
+func TestAccountOf(t *testing.T) {
+	tests := map[string]string{
+		"/repos/octocat/hello-world":         "octocat",
+		"/api/v3/orgs/octocat/members":       "octocat",
+		"/users/octocat/gists":               "octocat",
+		"/installation/repositories":         "",
+		"/app/installations/1/access_tokens": "",
+	}
+	for path, want := range tests {
+		assert.Equal(t, want, accountOf(path), path)
+	}
+}
+
 // This only tests the resume info slice portion of setProgressCompleteWithRepo.
 func Test_setProgressCompleteWithRepo_resumeInfo(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// graphQL runs a query against the GraphQL API and decodes the data of the
+// response into data.
+func (s *Source) graphQL(ctx context.Context, query string, variables map[string]interface{}, data interface{}) error {
+	body := map[string]interface{}{"query": query, "variables": variables}
+	var response struct {
+		Data   interface{} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	response.Data = data
+	for {
+		// The GraphQL API is at /graphql on github.com and at /api/graphql
+		// next to the /api/v3 REST API on GitHub Enterprise.
+		req, err := s.apiClient.NewRequest(http.MethodPost, "../graphql", body)
+		if err != nil {
+			return err
+		}
+		res, err := s.apiClient.Do(ctx, req, &response)
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		break
+	}
+	if len(response.Errors) > 0 {
+		return fmt.Errorf("graphql: %s", response.Errors[0].Message)
+	}
+	return nil
+}
+
+const reposQuery = `query($login: String!, $cursor: String) {
+  repositoryOwner(login: $login) {
+    repositories(first: 100, after: $cursor, ownerAffiliations: OWNER) {
+      pageInfo { hasNextPage endCursor }
+      nodes { nameWithOwner url isFork }
+    }
+  }
+}`
+
+type reposResponse struct {
+	RepositoryOwner *struct {
+		Repositories struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []struct {
+				NameWithOwner string `json:"nameWithOwner"`
+				URL           string `json:"url"`
+				IsFork        bool   `json:"isFork"`
+			} `json:"nodes"`
+		} `json:"repositories"`
+	} `json:"repositoryOwner"`
+}
+
+// getReposByOwnerGraphQL lists the repos owned by an org or user through the
+// GraphQL API, which only returns the fields needed and costs a single point
+// per page of 100 repos. The GraphQL API requires authentication.
+func (s *Source) getReposByOwnerGraphQL(ctx context.Context, owner string) ([]string, error) {
+	logger := s.log.WithValues("owner", owner)
+
+	var repos []string
+	var numRepos, numForks int
+	variables := map[string]interface{}{"login": owner}
+	for {
+		var data reposResponse
+		if err := s.graphQL(ctx, reposQuery, variables, &data); err != nil {
+			return nil, fmt.Errorf("could not list repos for %s: %w", owner, err)
+		}
+		if data.RepositoryOwner == nil {
+			return nil, fmt.Errorf("could not find org or user %s", owner)
+		}
+		page := data.RepositoryOwner.Repositories
+		for _, r := range page.Nodes {
+			if s.ignoreRepo(r.NameWithOwner) {
+				continue
+			}
+			if !s.includeRepo(r.NameWithOwner) {
+				continue
+			}
+
+			numRepos++
+			if r.IsFork {
+				numForks++
+				if !s.conn.IncludeForks {
+					continue
+				}
+			}
+			repos = append(repos, r.URL+".git")
+		}
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = page.PageInfo.EndCursor
+	}
+	logger.V(2).Info("found repos", "total", numRepos, "forks", numForks)
+	return repos, nil
+}
@@ -0,0 +1,116 @@
+package github
+
+import (
+	"fmt"
+	"io"
+
+	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanReleases scans the notes and the uploaded assets of the releases of a
+// repository. The source code archives of releases are left out, since they
+// are built from the git history.
+func (s *Source) scanReleases(ctx context.Context, repoURL string, chunksChan chan *sources.Chunk) error {
+	owner, repo, ok := ownerAndRepo(repoURL)
+	if !ok {
+		// Gists don't have releases.
+		return nil
+	}
+	opts := &github.ListOptions{
+		PerPage: defaultPagination,
+	}
+	for {
+		releases, res, err := s.apiClient.Repositories.ListReleases(ctx, owner, repo, opts)
+		if err == nil {
+			res.Body.Close()
+		}
+		if handled := s.handleRateLimit(err, res); handled {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not list releases for repo %s: %w", repoURL, err)
+		}
+		for _, release := range releases {
+			notes := release.GetName() + "\n" + release.GetBody()
+			if err := s.chunkComment(ctx, repoURL, release.GetHTMLURL(), release.GetAuthor().GetLogin(), release.GetCreatedAt().Time, notes, chunksChan); err != nil {
+				return err
+			}
+			for _, asset := range release.Assets {
+				if err := s.scanReleaseAsset(ctx, repoURL, owner, repo, asset, chunksChan); err != nil {
+					s.log.Error(err, "unable to scan release asset, continuing", "repo", repoURL, "asset", asset.GetName())
+				}
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	return nil
+}
+
+// scanReleaseAsset downloads a release asset and scans it, unpacking it if
+// it's an archive.
+func (s *Source) scanReleaseAsset(ctx context.Context, repoURL, owner, repo string, asset *github.ReleaseAsset, chunksChan chan *sources.Chunk) error {
+	// Assets are served from a different host through a redirect, which
+	// must not get the credentials of the API client.
+	rc, _, err := s.apiClient.Repositories.DownloadReleaseAsset(ctx, owner, repo, asset.GetID(), common.RetryableHttpClientTimeout(60))
+	if err != nil {
+		return fmt.Errorf("could not download release asset: %w", err)
+	}
+	defer rc.Close()
+
+	reader, err := diskbufferreader.New(rc)
+	if err != nil {
+		return fmt.Errorf("error creating disk buffer reader: %w", err)
+	}
+	defer reader.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: &source_metadatapb.Github{
+					Link:       sanitizer.UTF8(asset.GetBrowserDownloadURL()),
+					File:       sanitizer.UTF8(asset.GetName()),
+					Username:   sanitizer.UTF8(asset.GetUploader().GetLogin()),
+					Repository: sanitizer.UTF8(repoURL),
+					Timestamp:  asset.GetCreatedAt().Format("2006-01-02 15:04:05 -0700"),
+					Visibility: s.visibilityOf(repoURL),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+	if handlers.HandleFile(ctx, reader, chunkSkel, chunksChan) {
+		return nil
+	}
+
+	if err := reader.Reset(); err != nil {
+		return fmt.Errorf("error resetting reader: %w", err)
+	}
+	reader.Stop()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("error reading release asset: %w", err)
+	}
+	chunk := *chunkSkel
+	chunk.Data = data
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- &chunk:
+		return nil
+	}
+}
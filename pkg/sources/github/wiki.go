@@ -0,0 +1,54 @@
+package github
+
+import (
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/go-github/v42/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanWiki clones and scans the wiki of a repository. Wikis are separate git
+// repositories next to the repository they belong to.
+func (s *Source) scanWiki(ctx context.Context, repoURL string, installationClient *github.Client, chunksChan chan *sources.Chunk) error {
+	if isGist(repoURL) {
+		return nil
+	}
+	wikiURL := strings.TrimSuffix(repoURL, ".git") + ".wiki.git"
+
+	wikiPath, repo, err := s.cloneRepo(ctx, wikiURL, installationClient)
+	defer os.RemoveAll(wikiPath)
+	if err != nil {
+		// The wiki repository only exists once its first page is created,
+		// even if the wiki is enabled.
+		s.log.V(2).Info("could not clone wiki", "repo", repoURL, "error", err)
+		return nil
+	}
+
+	// The base and head commits are those of the repository, not the wiki.
+	scanOptions := *s.scanOptions
+	scanOptions.BaseHash = ""
+	scanOptions.HeadHash = ""
+	return s.git.ScanRepo(ctx, repo, wikiPath, &scanOptions, chunksChan)
+}
+
+// isWiki reports whether the repository URL is the wiki of a repository, e.g.
+// https://github.com/<owner>/<repo>.wiki.git.
+func isWiki(repoURL string) bool {
+	return strings.HasSuffix(repoURL, ".wiki.git")
+}
+
+// wikiLink returns a link to the revision of a wiki page. Pages are named
+// after their file, without the extension.
+func wikiLink(repository, commit, file string) string {
+	link := strings.TrimSuffix(repository, ".wiki.git") + "/wiki"
+	if file == "" {
+		return link
+	}
+	page := path.Base(file)
+	page = strings.TrimSuffix(page, path.Ext(page))
+	return link + "/" + page + "/" + commit
+}
@@ -0,0 +1,244 @@
+package gitlab
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// maxJobArtifactsSize caps the size of the artifacts archive of a job. The
+// archive is read into memory by the API client.
+const maxJobArtifactsSize = 100 * 1024 * 1024
+
+// scanCIVariables scans the CI/CD variables of the projects of the repos and
+// of the groups they belong to. Listing variables requires the maintainer
+// role.
+func (s *Source) scanCIVariables(ctx context.Context, apiClient *gitlab.Client, repos []string, chunksChan chan *sources.Chunk) error {
+	groups := map[string]struct{}{}
+	for _, repoURL := range repos {
+		project := s.projectPath(repoURL)
+		if err := s.scanProjectVariables(ctx, apiClient, repoURL, project, chunksChan); err != nil {
+			ctx.Logger().V(2).Info("could not scan project variables", "repo", repoURL, "error", err)
+		}
+		// Variables of parent groups are inherited by their subgroups' projects.
+		parts := strings.Split(project, "/")
+		for i := 1; i < len(parts); i++ {
+			groups[strings.Join(parts[:i], "/")] = struct{}{}
+		}
+	}
+
+	for group := range groups {
+		if err := s.scanGroupVariables(ctx, apiClient, group, chunksChan); err != nil {
+			// The namespace of personal projects is a user, not a group.
+			ctx.Logger().V(2).Info("could not scan group variables", "group", group, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanProjectVariables(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, chunksChan chan *sources.Chunk) error {
+	link := strings.TrimSuffix(repoURL, ".git") + "/-/settings/ci_cd"
+	opts := &gitlab.ListProjectVariablesOptions{PerPage: defaultPagination}
+	for {
+		vars, res, err := apiClient.ProjectVariables.ListVariables(project, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		for _, v := range vars {
+			if err := s.chunkVariable(ctx, repoURL, link, v.Key, v.Value, chunksChan); err != nil {
+				return err
+			}
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanGroupVariables(ctx context.Context, apiClient *gitlab.Client, group string, chunksChan chan *sources.Chunk) error {
+	link := s.url + "groups/" + group + "/-/settings/ci_cd"
+	opts := &gitlab.ListGroupVariablesOptions{PerPage: defaultPagination}
+	for {
+		vars, res, err := apiClient.GroupVariables.ListVariables(group, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		for _, v := range vars {
+			if err := s.chunkVariable(ctx, "", link, v.Key, v.Value, chunksChan); err != nil {
+				return err
+			}
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// chunkVariable sends a CI/CD variable to be scanned. The key is included
+// since detectors often rely on it to recognize the value.
+func (s *Source) chunkVariable(ctx context.Context, repoURL, link, key, value string, chunksChan chan *sources.Chunk) error {
+	if value == "" {
+		return nil
+	}
+	chunk := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitlab{
+				Gitlab: &source_metadatapb.Gitlab{
+					Link:       sanitizer.UTF8(link),
+					File:       sanitizer.UTF8(key),
+					Repository: sanitizer.UTF8(repoURL),
+				},
+			},
+		},
+		Data:   []byte(key + "=" + value),
+		Verify: s.verify,
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- chunk:
+		return nil
+	}
+}
+
+// scanJobs scans the logs and, if enabled, the artifacts of the CI/CD jobs of
+// the projects of the repos that were created since the configured time, or
+// of all jobs if it's not set.
+func (s *Source) scanJobs(ctx context.Context, apiClient *gitlab.Client, repos []string, chunksChan chan *sources.Chunk) error {
+	for _, repoURL := range repos {
+		if err := s.scanProjectJobs(ctx, apiClient, repoURL, s.projectPath(repoURL), chunksChan); err != nil {
+			ctx.Logger().Error(err, "unable to scan jobs, continuing", "repo", repoURL)
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanProjectJobs(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, chunksChan chan *sources.Chunk) error {
+	opts := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: defaultPagination},
+	}
+	for {
+		jobs, res, err := apiClient.Jobs.ListProjectJobs(project, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("could not list jobs for repo %s: %w", repoURL, err)
+		}
+		for _, job := range jobs {
+			// Jobs are listed newest first.
+			if !s.jobLogsSince.IsZero() && job.CreatedAt != nil && job.CreatedAt.Before(s.jobLogsSince) {
+				return nil
+			}
+			if s.includeJobLogs {
+				if err := s.scanJobLog(ctx, apiClient, repoURL, project, job, chunksChan); err != nil {
+					// Logs expire, and jobs that haven't run don't have any.
+					ctx.Logger().V(2).Info("could not scan job log", "repo", repoURL, "job", job.ID, "error", err)
+				}
+			}
+			if s.includeJobArtifacts && job.ArtifactsFile.Filename != "" {
+				if err := s.scanJobArtifacts(ctx, apiClient, repoURL, project, job, chunksChan); err != nil {
+					ctx.Logger().V(2).Info("could not scan job artifacts", "repo", repoURL, "job", job.ID, "error", err)
+				}
+			}
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanJobLog(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, job *gitlab.Job, chunksChan chan *sources.Chunk) error {
+	trace, _, err := apiClient.Jobs.GetTraceFile(project, job.ID, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(trace)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	chunk := s.jobChunk(repoURL, job, "job-"+strconv.Itoa(job.ID)+".log")
+	chunk.Data = data
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- chunk:
+		return nil
+	}
+}
+
+// scanJobArtifacts downloads the artifacts archive of a job and scans the
+// files in it.
+func (s *Source) scanJobArtifacts(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, job *gitlab.Job, chunksChan chan *sources.Chunk) error {
+	if job.ArtifactsFile.Size > maxJobArtifactsSize {
+		return fmt.Errorf("artifacts archive is larger than %d bytes", maxJobArtifactsSize)
+	}
+	archive, _, err := apiClient.Jobs.GetJobArtifacts(project, job.ID, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	chunkSkel := s.jobChunk(repoURL, job, job.ArtifactsFile.Filename)
+	if handlers.HandleFile(ctx, archive, chunkSkel, chunksChan) {
+		return nil
+	}
+
+	// The archive couldn't be unpacked, so it's scanned as is.
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		return err
+	}
+	chunkSkel.Data = data
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- chunkSkel:
+		return nil
+	}
+}
+
+// jobChunk returns a chunk without data for a file of a job.
+func (s *Source) jobChunk(repoURL string, job *gitlab.Job, file string) *sources.Chunk {
+	var timestamp string
+	if job.CreatedAt != nil {
+		timestamp = job.CreatedAt.Format("2006-01-02 15:04:05 -0700")
+	}
+	return &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitlab{
+				Gitlab: &source_metadatapb.Gitlab{
+					Link:       sanitizer.UTF8(job.WebURL),
+					File:       sanitizer.UTF8(file),
+					Commit:     sanitizer.UTF8(job.Pipeline.Sha),
+					Repository: sanitizer.UTF8(repoURL),
+					Timestamp:  sanitizer.UTF8(timestamp),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+}
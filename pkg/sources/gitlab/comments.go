@@ -0,0 +1,185 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// scanComments scans the descriptions and discussions of the merge requests
+// and issues of the projects of the repos. If comment projects are
+// configured, only the projects that match one of them are scanned.
+func (s *Source) scanComments(ctx context.Context, apiClient *gitlab.Client, repos []string, chunksChan chan *sources.Chunk) error {
+	for _, repoURL := range repos {
+		project := s.projectPath(repoURL)
+		if !s.matchCommentProject(ctx, project) {
+			continue
+		}
+		if err := s.scanMergeRequests(ctx, apiClient, repoURL, project, chunksChan); err != nil {
+			ctx.Logger().Error(err, "unable to scan merge requests, continuing", "repo", repoURL)
+		}
+		if err := s.scanIssues(ctx, apiClient, repoURL, project, chunksChan); err != nil {
+			ctx.Logger().Error(err, "unable to scan issues, continuing", "repo", repoURL)
+		}
+	}
+	return nil
+}
+
+// scanMergeRequests scans the descriptions and discussions of the merge
+// requests of a project.
+func (s *Source) scanMergeRequests(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, chunksChan chan *sources.Chunk) error {
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: defaultPagination},
+	}
+	for {
+		mrs, res, err := apiClient.MergeRequests.ListProjectMergeRequests(project, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("could not list merge requests for repo %s: %w", repoURL, err)
+		}
+		for _, mr := range mrs {
+			if err := s.chunkComment(ctx, repoURL, mr.WebURL, mr.CreatedAt, mr.Title+"\n"+mr.Description, chunksChan); err != nil {
+				return err
+			}
+			if err := s.scanMergeRequestDiscussions(ctx, apiClient, repoURL, project, mr, chunksChan); err != nil {
+				return err
+			}
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanMergeRequestDiscussions(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, mr *gitlab.MergeRequest, chunksChan chan *sources.Chunk) error {
+	opts := &gitlab.ListMergeRequestDiscussionsOptions{PerPage: defaultPagination}
+	for {
+		discussions, res, err := apiClient.Discussions.ListMergeRequestDiscussions(project, mr.IID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("could not list discussions of merge request %d for repo %s: %w", mr.IID, repoURL, err)
+		}
+		if err := s.chunkDiscussions(ctx, repoURL, mr.WebURL, discussions, chunksChan); err != nil {
+			return err
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// scanIssues scans the descriptions and comments of the issues of a project.
+func (s *Source) scanIssues(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, chunksChan chan *sources.Chunk) error {
+	opts := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: defaultPagination},
+	}
+	for {
+		issues, res, err := apiClient.Issues.ListProjectIssues(project, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("could not list issues for repo %s: %w", repoURL, err)
+		}
+		for _, issue := range issues {
+			if err := s.chunkComment(ctx, repoURL, issue.WebURL, issue.CreatedAt, issue.Title+"\n"+issue.Description, chunksChan); err != nil {
+				return err
+			}
+			if err := s.scanIssueDiscussions(ctx, apiClient, repoURL, project, issue, chunksChan); err != nil {
+				return err
+			}
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanIssueDiscussions(ctx context.Context, apiClient *gitlab.Client, repoURL, project string, issue *gitlab.Issue, chunksChan chan *sources.Chunk) error {
+	opts := &gitlab.ListIssueDiscussionsOptions{PerPage: defaultPagination}
+	for {
+		discussions, res, err := apiClient.Discussions.ListIssueDiscussions(project, issue.IID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("could not list discussions of issue %d for repo %s: %w", issue.IID, repoURL, err)
+		}
+		if err := s.chunkDiscussions(ctx, repoURL, issue.WebURL, discussions, chunksChan); err != nil {
+			return err
+		}
+		opts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// chunkDiscussions sends the notes of discussions to be scanned. Notes
+// generated by GitLab, such as label changes, are skipped.
+func (s *Source) chunkDiscussions(ctx context.Context, repoURL, link string, discussions []*gitlab.Discussion, chunksChan chan *sources.Chunk) error {
+	for _, discussion := range discussions {
+		for _, note := range discussion.Notes {
+			if note.System {
+				continue
+			}
+			noteLink := link + "#note_" + strconv.Itoa(note.ID)
+			if err := s.chunkComment(ctx, repoURL, noteLink, note.CreatedAt, note.Body, chunksChan); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// chunkComment sends the text of a merge request, issue or note to be
+// scanned.
+func (s *Source) chunkComment(ctx context.Context, repoURL, link string, created *time.Time, body string, chunksChan chan *sources.Chunk) error {
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+	var timestamp string
+	if created != nil {
+		timestamp = created.Format("2006-01-02 15:04:05 -0700")
+	}
+	chunk := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitlab{
+				Gitlab: &source_metadatapb.Gitlab{
+					Link:       sanitizer.UTF8(link),
+					Repository: sanitizer.UTF8(repoURL),
+					Timestamp:  sanitizer.UTF8(timestamp),
+				},
+			},
+		},
+		Data:   []byte(body),
+		Verify: s.verify,
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case chunksChan <- chunk:
+		return nil
+	}
+}
+
+// matchCommentProject reports whether the comments of a project are scanned.
+func (s *Source) matchCommentProject(ctx context.Context, project string) bool {
+	return len(s.commentProjects) == 0 || matchGlobs(ctx, s.commentProjects, project)
+}
+
+// projectPath returns the path of the project of a repo, e.g. org/repo for
+// https://gitlab.com/org/repo.git.
+func (s *Source) projectPath(repoURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(repoURL, s.url), ".git")
+}
@@ -2,12 +2,14 @@ package gitlab
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"runtime"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -46,7 +48,22 @@ type Source struct {
 	resumeInfoSlice []string
 	resumeInfoMutex sync.Mutex
 	sources.Progress
-	jobPool *errgroup.Group
+	jobPool         *errgroup.Group
+	rateLimit       *common.RateLimitController
+	includeSnippets bool
+	includeComments bool
+	commentProjects []string
+
+	includeCIVariables  bool
+	includeJobLogs      bool
+	includeJobArtifacts bool
+	jobLogsSince        time.Time
+
+	includeGroups []string
+	ignoreGroups  []string
+	includeRepos  []string
+	skipArchived  bool
+	skipForks     bool
 }
 
 // Ensure the Source satisfies the interface at compile time.
@@ -74,6 +91,7 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 	s.verify = verify
 	s.jobPool = &errgroup.Group{}
 	s.jobPool.SetLimit(concurrency)
+	s.rateLimit = common.NewRateLimitController(concurrency)
 
 	var conn sourcespb.GitLab
 	err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{})
@@ -83,7 +101,21 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 
 	s.repos = conn.Repositories
 	s.ignoreRepos = conn.IgnoreRepos
+	s.includeRepos = conn.IncludeRepos
+	s.includeGroups = conn.IncludeGroups
+	s.ignoreGroups = conn.IgnoreGroups
+	s.skipArchived = conn.SkipArchived
+	s.skipForks = conn.SkipForks
 	s.url = conn.Endpoint
+	s.includeSnippets = conn.IncludeSnippets
+	s.includeComments = conn.IncludeComments
+	s.commentProjects = conn.CommentProjects
+	s.includeCIVariables = conn.IncludeCiVariables
+	s.includeJobLogs = conn.IncludeJobLogs
+	s.includeJobArtifacts = conn.IncludeJobArtifacts
+	if conn.JobLogsSince != nil {
+		s.jobLogsSince = conn.JobLogsSince.AsTime()
+	}
 
 	if conn.Endpoint != "" && !strings.HasSuffix(s.url, "/") {
 		s.url = s.url + "/"
@@ -120,7 +152,7 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 						File:       sanitizer.UTF8(file),
 						Email:      sanitizer.UTF8(email),
 						Repository: sanitizer.UTF8(repository),
-						Link:       git.GenerateLink(repository, commit, file),
+						Link:       generateLink(repository, commit, file),
 						Timestamp:  sanitizer.UTF8(timestamp),
 						Line:       line,
 					},
@@ -157,14 +189,14 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 		}
 		// Turn projects into URLs for Git cloner.
 		for _, prj := range projects {
-			if s.ignoreRepo(ctx, prj.PathWithNamespace) {
+			if s.skipProject(ctx, prj) {
 				continue
 			}
 
 			// Ensure the urls are valid before adding them to the repo list.
 			_, err := url.Parse(prj.HTTPURLToRepo)
 			if err != nil {
-				fmt.Printf("could not parse url given by project: %s", prj.HTTPURLToRepo)
+				ctx.Logger().Error(err, "could not parse url given by project", "project", prj.WebURL)
 			}
 			repos = append(repos, prj.HTTPURLToRepo)
 		}
@@ -177,21 +209,48 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 	// We must sort the repos so we can resume later if necessary.
 	slices.Sort(s.repos)
 
-	return s.scanRepos(ctx, chunksChan)
+	if err := s.scanRepos(ctx, chunksChan); err != nil {
+		return err
+	}
+	if s.includeSnippets {
+		if err := s.scanSnippets(ctx, apiClient, repos, chunksChan); err != nil {
+			return err
+		}
+	}
+	if s.includeComments {
+		if err := s.scanComments(ctx, apiClient, repos, chunksChan); err != nil {
+			return err
+		}
+	}
+	if s.includeCIVariables {
+		if err := s.scanCIVariables(ctx, apiClient, repos, chunksChan); err != nil {
+			return err
+		}
+	}
+	if s.includeJobLogs || s.includeJobArtifacts {
+		if err := s.scanJobs(ctx, apiClient, repos, chunksChan); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *Source) newClient() (*gitlab.Client, error) {
+	// Throttle API requests as the rate limit runs low, instead of hitting it
+	// halfway through enumeration or scanning.
+	httpClient := &http.Client{Transport: s.rateLimit.Transport(nil)}
+
 	// Initialize a new api instance.
 	switch s.authMethod {
 	case "OAUTH":
-		apiClient, err := gitlab.NewOAuthClient(s.token, gitlab.WithBaseURL(s.url))
+		apiClient, err := gitlab.NewOAuthClient(s.token, gitlab.WithBaseURL(s.url), gitlab.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("could not create Gitlab OAUTH client for %s. Error: %v", s.url, err)
 		}
 		return apiClient, nil
 
 	case "BASIC_AUTH":
-		apiClient, err := gitlab.NewBasicAuthClient(s.user, s.password, gitlab.WithBaseURL(s.url))
+		apiClient, err := gitlab.NewBasicAuthClient(s.user, s.password, gitlab.WithBaseURL(s.url), gitlab.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("could not create Gitlab BASICAUTH client for %s. Error: %v", s.url, err)
 		}
@@ -204,7 +263,7 @@ func (s *Source) newClient() (*gitlab.Client, error) {
 		}
 		fallthrough
 	case "TOKEN":
-		apiClient, err := gitlab.NewOAuthClient(s.token, gitlab.WithBaseURL(s.url))
+		apiClient, err := gitlab.NewOAuthClient(s.token, gitlab.WithBaseURL(s.url), gitlab.WithHTTPClient(httpClient))
 		if err != nil {
 			return nil, fmt.Errorf("could not create Gitlab TOKEN client for %s. Error: %v", s.url, err)
 		}
@@ -257,32 +316,16 @@ func (s *Source) getAllProjects(ctx context.Context, apiClient *gitlab.Client) (
 		}
 	}
 
-	var groups []*gitlab.Group
-
-	listGroupsOptions := gitlab.ListGroupsOptions{
-		AllAvailable: gitlab.Bool(false), // This actually grabs public groups on public GitLab if set to true.
-		TopLevelOnly: gitlab.Bool(false),
-		Owned:        gitlab.Bool(false),
-	}
-	if s.url != "https://gitlab.com/" {
-		listGroupsOptions.AllAvailable = gitlab.Bool(true)
-	}
-	for {
-		groupList, res, err := apiClient.Groups.ListGroups(&listGroupsOptions)
-		if err != nil {
-			return nil, errors.Errorf("received error on listing groups, you probably don't have permissions to do that: %s\n", err)
-		}
-		groups = append(groups, groupList...)
-		listGroupsOptions.Page = res.NextPage
-		if res.NextPage == 0 {
-			break
-		}
+	groups, err := s.getAllGroups(ctx, apiClient)
+	if err != nil {
+		return nil, err
 	}
 
 	for _, group := range groups {
+		// Subgroups are enumerated on their own, so that they can be filtered.
 		listGroupProjectOptions := &gitlab.ListGroupProjectsOptions{
 			OrderBy:          gitlab.String("last_activity_at"),
-			IncludeSubGroups: gitlab.Bool(true),
+			IncludeSubGroups: gitlab.Bool(false),
 		}
 		for {
 			grpPrjs, res, err := apiClient.Groups.ListGroupProjects(group.ID, listGroupProjectOptions)
@@ -315,6 +358,110 @@ func (s *Source) getAllProjects(ctx context.Context, apiClient *gitlab.Client) (
 	return projectList, nil
 }
 
+// getAllGroups returns the groups the user is a member of, or every group on
+// self-hosted instances, and all of their nested subgroups, excluding those
+// that don't pass the group filters.
+func (s *Source) getAllGroups(ctx context.Context, apiClient *gitlab.Client) ([]*gitlab.Group, error) {
+	listGroupsOptions := gitlab.ListGroupsOptions{
+		AllAvailable: gitlab.Bool(false), // This actually grabs public groups on public GitLab if set to true.
+		TopLevelOnly: gitlab.Bool(false),
+		Owned:        gitlab.Bool(false),
+	}
+	if s.url != "https://gitlab.com/" {
+		listGroupsOptions.AllAvailable = gitlab.Bool(true)
+	}
+
+	var queue []*gitlab.Group
+	for {
+		groupList, res, err := apiClient.Groups.ListGroups(&listGroupsOptions)
+		if err != nil {
+			return nil, errors.Errorf("received error on listing groups, you probably don't have permissions to do that: %s\n", err)
+		}
+		queue = append(queue, groupList...)
+		listGroupsOptions.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+
+	// Members of a group can see its subgroups without being members of them,
+	// so they're walked explicitly.
+	seen := map[int]struct{}{}
+	var groups []*gitlab.Group
+	for len(queue) > 0 {
+		group := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[group.ID]; ok {
+			continue
+		}
+		seen[group.ID] = struct{}{}
+		if !s.skipGroup(ctx, group.FullPath) {
+			groups = append(groups, group)
+		}
+
+		listSubGroupsOptions := &gitlab.ListSubGroupsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: defaultPagination},
+		}
+		for {
+			subgroups, res, err := apiClient.Groups.ListSubGroups(group.ID, listSubGroupsOptions)
+			if err != nil {
+				ctx.Logger().V(2).Info("could not list subgroups", "group", group.FullPath, "error", err)
+				break
+			}
+			queue = append(queue, subgroups...)
+			listSubGroupsOptions.Page = res.NextPage
+			if res.NextPage == 0 {
+				break
+			}
+		}
+	}
+	return groups, nil
+}
+
+// skipGroup reports whether the projects of a group are left out of the scan
+// by the group filters. Subgroups of a skipped group are still walked, since
+// they can match the filters themselves.
+func (s *Source) skipGroup(ctx context.Context, fullPath string) bool {
+	if len(s.includeGroups) > 0 && !matchGlobs(ctx, s.includeGroups, fullPath) {
+		return true
+	}
+	if matchGlobs(ctx, s.ignoreGroups, fullPath) {
+		ctx.Logger().V(2).Info("Ignoring group", "group", fullPath)
+		return true
+	}
+	return false
+}
+
+// skipProject reports whether a project is left out of the scan by the
+// project filters.
+func (s *Source) skipProject(ctx context.Context, prj *gitlab.Project) bool {
+	if s.skipArchived && prj.Archived {
+		return true
+	}
+	if s.skipForks && prj.ForkedFromProject != nil {
+		return true
+	}
+	if len(s.includeRepos) > 0 && !matchGlobs(ctx, s.includeRepos, prj.PathWithNamespace) {
+		return true
+	}
+	return s.ignoreRepo(ctx, prj.PathWithNamespace)
+}
+
+// matchGlobs reports whether the path matches one of the globs.
+func matchGlobs(ctx context.Context, globs []string, path string) bool {
+	for _, pattern := range globs {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			ctx.Logger().Error(err, "could not compile glob", "glob", pattern)
+			continue
+		}
+		if g.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Source) getRepos() ([]string, []error) {
 	if len(s.repos) == 0 {
 		return nil, nil
@@ -364,20 +511,10 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
 			}(s)
 
-			var path string
-			var repo *gogit.Repository
-			var err error
-			if s.authMethod == "UNAUTHENTICATED" {
-				path, repo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL)
-			} else {
-				// If a username is not provided we need to use a default one in order to clone a private repo.
-				// Not setting "placeholder" as s.user on purpose in case any downstream services rely on a "" value for s.user.
-				user := s.user
-				if user == "" {
-					user = "placeholder"
-				}
-				path, repo, err = git.CloneRepoUsingToken(ctx, s.token, repoURL, user)
+			if err := s.rateLimit.Wait(ctx); err != nil {
+				return nil
 			}
+			path, repo, err := s.cloneRepo(ctx, repoURL)
 			defer os.RemoveAll(path)
 			if err != nil {
 				scanErrs.Add(err)
@@ -404,6 +541,20 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 	return nil
 }
 
+// cloneRepo clones a repository with the credentials of the source.
+func (s *Source) cloneRepo(ctx context.Context, repoURL string) (string, *gogit.Repository, error) {
+	if s.authMethod == "UNAUTHENTICATED" {
+		return git.CloneRepoUsingUnauthenticated(ctx, repoURL)
+	}
+	// If a username is not provided we need to use a default one in order to clone a private repo.
+	// Not setting "placeholder" as s.user on purpose in case any downstream services rely on a "" value for s.user.
+	user := s.user
+	if user == "" {
+		user = "placeholder"
+	}
+	return git.CloneRepoUsingToken(ctx, s.token, repoURL, user)
+}
+
 func (s *Source) ignoreRepo(ctx context.Context, r string) bool {
 	for _, ignore := range s.ignoreRepos {
 		g, err := glob.Compile(ignore)
@@ -2,13 +2,16 @@ package gitlab
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/anypb"
+	"gopkg.in/h2non/gock.v1"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -285,6 +288,7 @@ func Test_scanRepos_SetProgressComplete(t *testing.T) {
 				repos: tc.repos,
 			}
 			src.jobPool = &errgroup.Group{}
+			src.rateLimit = common.NewRateLimitController(1)
 			src.scanOptions = &git.ScanOptions{}
 
 			_ = src.scanRepos(context.Background(), nil)
@@ -299,3 +303,190 @@ func Test_scanRepos_SetProgressComplete(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateLink(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		commit     string
+		file       string
+		want       string
+	}{
+		{
+			name:       "repo file",
+			repository: "https://gitlab.com/org/repo.git",
+			commit:     "abcdef",
+			file:       "main.go",
+			want:       "https://gitlab.com/org/repo/blob/abcdef/main.go",
+		},
+		{
+			name:       "personal snippet file",
+			repository: snippetRepoURL("https://gitlab.com/-/snippets/1234"),
+			commit:     "abcdef",
+			file:       "snippet.sh",
+			want:       "https://gitlab.com/-/snippets/1234/raw/abcdef/snippet.sh",
+		},
+		{
+			name:       "project snippet commit",
+			repository: snippetRepoURL("https://gitlab.com/org/repo/-/snippets/5678"),
+			commit:     "abcdef",
+			want:       "https://gitlab.com/org/repo/-/snippets/5678",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := generateLink(tt.repository, tt.commit, tt.file); got != tt.want {
+				t.Errorf("generateLink() got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchCommentProject(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		project  string
+		want     bool
+	}{
+		{
+			name:    "no patterns",
+			project: "org/repo",
+			want:    true,
+		},
+		{
+			name:     "matching pattern",
+			patterns: []string{"other/*", "org/*"},
+			project:  "org/repo",
+			want:     true,
+		},
+		{
+			name:     "no matching pattern",
+			patterns: []string{"other/*"},
+			project:  "org/repo",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Source{url: "https://gitlab.com/", commentProjects: tt.patterns}
+			project := s.projectPath("https://gitlab.com/" + tt.project + ".git")
+			if got := s.matchCommentProject(context.Background(), project); got != tt.want {
+				t.Errorf("matchCommentProject() got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanCIVariables(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/org.+repo/variables").
+		Reply(200).
+		JSON([]map[string]string{{"key": "DEPLOY_TOKEN", "value": "project-secret"}})
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/org/variables").
+		Reply(200).
+		JSON([]map[string]string{{"key": "GROUP_TOKEN", "value": "group-secret"}, {"key": "EMPTY", "value": ""}})
+
+	// gock intercepts the default transport, which the client uses.
+	apiClient, err := gitlab.NewClient("token", gitlab.WithBaseURL("https://gitlab.com/"), gitlab.WithHTTPClient(&http.Client{}))
+	assert.Nil(t, err)
+
+	s := &Source{url: "https://gitlab.com/"}
+	chunksChan := make(chan *sources.Chunk, 10)
+	err = s.scanCIVariables(context.Background(), apiClient, []string{"https://gitlab.com/org/repo.git"}, chunksChan)
+	assert.Nil(t, err)
+	close(chunksChan)
+
+	var got []string
+	for chunk := range chunksChan {
+		got = append(got, string(chunk.Data))
+	}
+	assert.Equal(t, []string{"DEPLOY_TOKEN=project-secret", "GROUP_TOKEN=group-secret"}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestGetAllGroups(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/1/subgroups").
+		Reply(200).
+		JSON([]map[string]any{{"id": 2, "full_path": "org/team"}, {"id": 3, "full_path": "org/archive"}})
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/2/subgroups").
+		Reply(200).
+		JSON([]map[string]any{{"id": 4, "full_path": "org/team/backend"}})
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/[34]/subgroups").
+		Times(2).
+		Reply(200).
+		JSON([]map[string]any{})
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups$").
+		Reply(200).
+		JSON([]map[string]any{{"id": 1, "full_path": "org"}, {"id": 2, "full_path": "org/team"}})
+
+	apiClient, err := gitlab.NewClient("token", gitlab.WithBaseURL("https://gitlab.com/"), gitlab.WithHTTPClient(&http.Client{}))
+	assert.Nil(t, err)
+
+	s := &Source{url: "https://gitlab.com/", includeGroups: []string{"org/*"}, ignoreGroups: []string{"org/archive*"}}
+	groups, err := s.getAllGroups(context.Background(), apiClient)
+	assert.Nil(t, err)
+
+	var got []string
+	for _, group := range groups {
+		got = append(got, group.FullPath)
+	}
+	assert.Equal(t, []string{"org/team", "org/team/backend"}, got)
+	assert.True(t, gock.IsDone())
+}
+
+func TestSkipProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  *Source
+		project *gitlab.Project
+		want    bool
+	}{
+		{
+			name:    "no filters",
+			source:  &Source{},
+			project: &gitlab.Project{PathWithNamespace: "org/repo", Archived: true},
+			want:    false,
+		},
+		{
+			name:    "archived",
+			source:  &Source{skipArchived: true},
+			project: &gitlab.Project{PathWithNamespace: "org/repo", Archived: true},
+			want:    true,
+		},
+		{
+			name:    "fork",
+			source:  &Source{skipForks: true},
+			project: &gitlab.Project{PathWithNamespace: "org/repo", ForkedFromProject: &gitlab.ForkParent{}},
+			want:    true,
+		},
+		{
+			name:    "not included",
+			source:  &Source{includeRepos: []string{"other/*"}},
+			project: &gitlab.Project{PathWithNamespace: "org/repo"},
+			want:    true,
+		},
+		{
+			name:    "included but ignored",
+			source:  &Source{includeRepos: []string{"org/*"}, ignoreRepos: []string{"*/repo"}},
+			project: &gitlab.Project{PathWithNamespace: "org/repo"},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.source.skipProject(context.Background(), tt.project); got != tt.want {
+				t.Errorf("skipProject() got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package gitlab
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const defaultPagination = 100
+
+// snippetRepoPat matches the git URL of a snippet, e.g.
+// https://gitlab.com/snippets/1.git or https://gitlab.com/org/repo/snippets/1.git.
+var snippetRepoPat = regexp.MustCompile(`/snippets/\d+\.git$`)
+
+// scanSnippets clones and scans the snippets of the authenticated user and of
+// the projects of the repos. Snippets are git repositories of their own, so
+// every version of them is scanned.
+func (s *Source) scanSnippets(ctx context.Context, apiClient *gitlab.Client, repos []string, chunksChan chan *sources.Chunk) error {
+	snippetURLs, err := s.getSnippetURLs(ctx, apiClient, repos)
+	if err != nil {
+		return err
+	}
+	ctx.Logger().V(2).Info("Enumerated GitLab snippets", "count", len(snippetURLs))
+
+	scanErrs := sources.NewScanErrors()
+	for _, snippetURL := range snippetURLs {
+		snippetURL := snippetURL
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			logger := ctx.Logger().WithValues("snippet", snippetURL)
+			if err := s.rateLimit.Wait(ctx); err != nil {
+				return nil
+			}
+			path, repo, err := s.cloneRepo(ctx, snippetURL)
+			defer os.RemoveAll(path)
+			if err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+
+			// The base and head commits are those of the repos, not the snippets.
+			scanOptions := *s.scanOptions
+			scanOptions.BaseHash = ""
+			scanOptions.HeadHash = ""
+			if err = s.git.ScanRepo(ctx, repo, path, &scanOptions, chunksChan); err != nil {
+				scanErrs.Add(err)
+				return nil
+			}
+			logger.V(2).Info("Completed scanning snippet")
+			return nil
+		})
+	}
+
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning snippets", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	return nil
+}
+
+// getSnippetURLs returns the git URLs of the personal snippets of the
+// authenticated user and of the snippets of the projects of the repos.
+func (s *Source) getSnippetURLs(ctx context.Context, apiClient *gitlab.Client, repos []string) ([]string, error) {
+	seen := map[string]struct{}{}
+	var snippetURLs []string
+	addSnippets := func(snippets []*gitlab.Snippet) {
+		for _, snippet := range snippets {
+			snippetURL := snippetRepoURL(snippet.WebURL)
+			if _, ok := seen[snippetURL]; ok {
+				continue
+			}
+			seen[snippetURL] = struct{}{}
+			snippetURLs = append(snippetURLs, snippetURL)
+		}
+	}
+
+	snippetOpts := &gitlab.ListSnippetsOptions{PerPage: defaultPagination}
+	for {
+		snippets, res, err := apiClient.Snippets.ListSnippets(snippetOpts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("received error on listing snippets: %w", err)
+		}
+		addSnippets(snippets)
+		snippetOpts.Page = res.NextPage
+		if res.NextPage == 0 {
+			break
+		}
+	}
+
+	for _, repoURL := range repos {
+		project := s.projectPath(repoURL)
+		projectOpts := &gitlab.ListProjectSnippetsOptions{PerPage: defaultPagination}
+		for {
+			snippets, res, err := apiClient.ProjectSnippets.ListSnippets(project, projectOpts, gitlab.WithContext(ctx))
+			if err != nil {
+				// Snippets can be disabled for a project.
+				ctx.Logger().V(2).Info("could not list project snippets", "repo", repoURL, "error", err)
+				break
+			}
+			addSnippets(snippets)
+			projectOpts.Page = res.NextPage
+			if res.NextPage == 0 {
+				break
+			}
+		}
+	}
+	return snippetURLs, nil
+}
+
+// snippetRepoURL returns the git URL of a snippet from its web URL, e.g.
+// https://gitlab.com/snippets/1.git for https://gitlab.com/-/snippets/1.
+func snippetRepoURL(webURL string) string {
+	return strings.Replace(webURL, "/-/snippets/", "/snippets/", 1) + ".git"
+}
+
+// generateLink returns a link to the file of a commit of a repo or a snippet.
+func generateLink(repository, commit, file string) string {
+	if !snippetRepoPat.MatchString(repository) {
+		return git.GenerateLink(repository, commit, file)
+	}
+	link := strings.Replace(strings.TrimSuffix(repository, ".git"), "/snippets/", "/-/snippets/", 1)
+	if file == "" {
+		return link
+	}
+	return link + "/raw/" + commit + "/" + file
+}
@@ -0,0 +1,92 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// resumeInfo is the position of a scan in a bucket listing. It's saved as the
+// encoded resume info of the progress, so that an interrupted scan of a large
+// bucket can be resumed from the page it was scanning instead of from the start.
+type resumeInfo struct {
+	Bucket string `json:"bucket"`
+	// ContinuationToken is the token that the page being scanned was listed
+	// with. Version listings use the key and version ID markers instead.
+	ContinuationToken string `json:"continuation_token,omitempty"`
+	KeyMarker         string `json:"key_marker,omitempty"`
+	VersionIDMarker   string `json:"version_id_marker,omitempty"`
+	// Completed are the objects of the page that have been scanned.
+	Completed []string `json:"completed,omitempty"`
+}
+
+func decodeResumeInfo(encoded string) (resumeInfo, error) {
+	var info resumeInfo
+	if encoded == "" {
+		return info, nil
+	}
+	if err := json.Unmarshal([]byte(encoded), &info); err != nil {
+		return info, fmt.Errorf("could not decode resume info: %w", err)
+	}
+	return info, nil
+}
+
+// completedSet returns the objects of the page that have been scanned.
+func (r resumeInfo) completedSet() map[string]struct{} {
+	completed := make(map[string]struct{}, len(r.Completed))
+	for _, id := range r.Completed {
+		completed[id] = struct{}{}
+	}
+	return completed
+}
+
+// checkpoint keeps the resume info of the progress up to date as the pages of
+// a bucket are listed and their objects are scanned.
+type checkpoint struct {
+	mu       sync.Mutex
+	progress *sources.Progress
+	info     resumeInfo
+	// index and count are the position of the bucket in the buckets to scan.
+	index, count int
+}
+
+// startBucket records that a bucket is being scanned, from the given position.
+func (c *checkpoint) startBucket(index, count int, info resumeInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.index, c.count = index, count
+	c.info = info
+	c.saveLocked()
+}
+
+// startPage records that a page of the bucket is being scanned.
+func (c *checkpoint) startPage(continuationToken, keyMarker, versionIDMarker string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.info = resumeInfo{
+		Bucket:            c.info.Bucket,
+		ContinuationToken: continuationToken,
+		KeyMarker:         keyMarker,
+		VersionIDMarker:   versionIDMarker,
+	}
+	c.saveLocked()
+}
+
+// complete records that an object of the page has been scanned.
+func (c *checkpoint) complete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.info.Completed = append(c.info.Completed, id)
+	c.saveLocked()
+}
+
+func (c *checkpoint) saveLocked() {
+	encoded, err := json.Marshal(c.info)
+	if err != nil {
+		// A struct of strings always marshals.
+		return
+	}
+	c.progress.SetProgressComplete(c.index, c.count, fmt.Sprintf("Bucket: %s", c.info.Bucket), string(encoded))
+}
@@ -3,6 +3,7 @@ package s3
 import (
 	"fmt"
 	"io"
+	"net/url"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -10,12 +11,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	diskbufferreader "github.com/bill-rich/disk-buffer-reader"
 	"github.com/go-errors/errors"
 	"github.com/go-logr/logr"
+	"github.com/gobwas/glob"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -40,6 +43,32 @@ type Source struct {
 	errorCount *sync.Map
 	conn       *sourcespb.S3
 	jobPool    *errgroup.Group
+
+	includePrefixes []glob.Glob
+	excludePrefixes []glob.Glob
+	maxObjectSize   int64
+	filter          *common.Filter
+
+	checkpoint *checkpoint
+}
+
+// defaultMaxObjectSize is the size of the largest object that is scanned by
+// default.
+var defaultMaxObjectSize = int64(250 * common.MB)
+
+// object is an object, or a non-current version of an object, to scan.
+type object struct {
+	*s3.Object
+	// versionID is set for non-current versions.
+	versionID *string
+}
+
+// id identifies the object in the resume info.
+func (o object) id() string {
+	if o.versionID == nil {
+		return *o.Key
+	}
+	return *o.Key + "\x00" + *o.versionID
 }
 
 // Ensure the Source satisfies the interface at compile time
@@ -79,10 +108,41 @@ func (s *Source) Init(aCtx context.Context, name string, jobId, sourceId int64,
 	}
 	s.conn = &conn
 
+	if s.includePrefixes, err = compilePrefixGlobs(conn.GetIncludePrefixes()); err != nil {
+		return errors.WrapPrefix(err, "invalid include prefix", 0)
+	}
+	if s.excludePrefixes, err = compilePrefixGlobs(conn.GetExcludePrefixes()); err != nil {
+		return errors.WrapPrefix(err, "invalid exclude prefix", 0)
+	}
+	s.maxObjectSize = conn.GetMaxObjectSize()
+	if s.maxObjectSize <= 0 {
+		s.maxObjectSize = defaultMaxObjectSize
+	}
+
 	return nil
 }
 
-func (s *Source) newClient(region string) (*s3.S3, error) {
+// WithFilter sets the path filters that the object keys must pass.
+func (s *Source) WithFilter(filter *common.Filter) {
+	s.filter = filter
+}
+
+func compilePrefixGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, err
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// newClient returns a client for the region. If roles are given, they're
+// assumed in order, each with the credentials of the previous one, and the
+// client uses the credentials of the last one.
+func (s *Source) newClient(region string, roles []string) (*s3.S3, error) {
 	cfg := aws.NewConfig()
 	cfg.CredentialsChainVerboseErrors = aws.Bool(true)
 	cfg.Region = aws.String(region)
@@ -91,6 +151,9 @@ func (s *Source) newClient(region string) (*s3.S3, error) {
 	case *sourcespb.S3_AccessKey:
 		cfg.Credentials = credentials.NewStaticCredentials(cred.AccessKey.Key, cred.AccessKey.Secret, "")
 	case *sourcespb.S3_Unauthenticated:
+		if len(roles) > 0 {
+			return nil, errors.Errorf("roles can't be assumed without credentials for %s source", s.name)
+		}
 		cfg.Credentials = credentials.AnonymousCredentials
 	case *sourcespb.S3_CloudEnvironment:
 		// Nothing needs to be done!
@@ -106,14 +169,33 @@ func (s *Source) newClient(region string) (*s3.S3, error) {
 		return nil, err
 	}
 
+	for _, role := range roles {
+		creds := stscreds.NewCredentials(sess, role, func(p *stscreds.AssumeRoleProvider) {
+			p.RoleSessionName = "trufflehog"
+			if externalID := s.conn.GetExternalId(); externalID != "" {
+				p.ExternalID = aws.String(externalID)
+			}
+		})
+		sess = sess.Copy(&aws.Config{Credentials: creds})
+	}
+
 	return s3.New(sess), nil
 }
 
+// bucketRoles returns the roles to assume to scan a bucket.
+func (s *Source) bucketRoles(bucket string) []string {
+	roles := s.conn.GetRoles()
+	if role, ok := s.conn.GetBucketRoles()[bucket]; ok {
+		roles = append(roles[:len(roles):len(roles)], role)
+	}
+	return roles
+}
+
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
 	const defaultAWSRegion = "us-east-1"
 
-	client, err := s.newClient(defaultAWSRegion)
+	client, err := s.newClient(defaultAWSRegion, s.conn.GetRoles())
 	if err != nil {
 		return errors.WrapPrefix(err, "could not create s3 client", 0)
 	}
@@ -140,38 +222,92 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 		return errors.Errorf("invalid configuration given for %s source", s.name)
 	}
 
+	// Resume from the page of the bucket that an interrupted scan was
+	// scanning, if any.
+	resume, err := decodeResumeInfo(s.GetProgress().EncodedResumeInfo)
+	if err != nil {
+		s.log.Error(err, "could not resume scan, starting from the beginning")
+	}
+	startIndex := 0
+	for i, bucket := range bucketsToScan {
+		if bucket == resume.Bucket {
+			startIndex = i
+			break
+		}
+	}
+	s.checkpoint = &checkpoint{progress: &s.Progress}
+
 	objectCount := uint64(0)
 	for i, bucket := range bucketsToScan {
 		if common.IsDone(ctx) {
 			return nil
 		}
+		if i < startIndex {
+			continue
+		}
 
-		s.SetProgressComplete(i, len(bucketsToScan), fmt.Sprintf("Bucket: %s", bucket), "")
+		bucketResume := resumeInfo{Bucket: bucket}
+		if bucket == resume.Bucket {
+			bucketResume = resume
+			s.log.Info("Resuming bucket scan", "bucket", bucket, "completed_objects", len(resume.Completed))
+		}
+		s.checkpoint.startBucket(i, len(bucketsToScan), bucketResume)
+		completed := bucketResume.completedSet()
 
 		s.log.Info("Scanning bucket", "bucket", bucket)
-		region, err := s3manager.GetBucketRegionWithClient(context.Background(), client, bucket)
+		roles := s.bucketRoles(bucket)
+		bucketClient := client
+		if len(roles) != len(s.conn.GetRoles()) {
+			bucketClient, err = s.newClient(defaultAWSRegion, roles)
+			if err != nil {
+				s.log.Error(err, "could not make s3 client for bucket role", "bucket", bucket)
+				continue
+			}
+		}
+		region, err := s3manager.GetBucketRegionWithClient(context.Background(), bucketClient, bucket)
 		if err != nil {
 			s.log.Error(err, "could not get s3 region for bucket", "bucket", bucket)
 			continue
 		}
 		var regionalClient *s3.S3
 		if region != defaultAWSRegion {
-			regionalClient, err = s.newClient(region)
+			regionalClient, err = s.newClient(region, roles)
 			if err != nil {
 				s.log.Error(err, "could not make regional s3 client")
 			}
 		} else {
-			regionalClient = client
+			regionalClient = bucketClient
 		}
 
 		errorCount := sync.Map{}
 
-		err = regionalClient.ListObjectsV2PagesWithContext(
-			ctx, &s3.ListObjectsV2Input{Bucket: &bucket},
-			func(page *s3.ListObjectsV2Output, last bool) bool {
-				s.pageChunker(ctx, regionalClient, chunksChan, bucket, page, &errorCount, i+1, &objectCount)
-				return true
-			})
+		if s.conn.GetIncludeVersions() {
+			input := &s3.ListObjectVersionsInput{Bucket: &bucket}
+			if bucketResume.KeyMarker != "" {
+				input.KeyMarker = aws.String(bucketResume.KeyMarker)
+				input.VersionIdMarker = aws.String(bucketResume.VersionIDMarker)
+			}
+			err = regionalClient.ListObjectVersionsPagesWithContext(
+				ctx, input,
+				func(page *s3.ListObjectVersionsOutput, last bool) bool {
+					s.checkpoint.startPage("", aws.StringValue(page.KeyMarker), aws.StringValue(page.VersionIdMarker))
+					s.log.V(5).Info("Listed delete markers", "bucket", bucket, "count", len(page.DeleteMarkers))
+					s.pageChunker(ctx, regionalClient, chunksChan, bucket, versionObjects(page.Versions), completed, &errorCount, i+1, &objectCount)
+					return true
+				})
+		} else {
+			input := &s3.ListObjectsV2Input{Bucket: &bucket}
+			if bucketResume.ContinuationToken != "" {
+				input.ContinuationToken = aws.String(bucketResume.ContinuationToken)
+			}
+			err = regionalClient.ListObjectsV2PagesWithContext(
+				ctx, input,
+				func(page *s3.ListObjectsV2Output, last bool) bool {
+					s.checkpoint.startPage(aws.StringValue(page.ContinuationToken), "", "")
+					s.pageChunker(ctx, regionalClient, chunksChan, bucket, currentObjects(page.Contents), completed, &errorCount, i+1, &objectCount)
+					return true
+				})
+		}
 
 		if err != nil {
 			s.log.Error(err, "could not list objects in s3 bucket", "bucket", bucket)
@@ -182,44 +318,141 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 	return nil
 }
 
-// pageChunker emits chunks onto the given channel from a page
-func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan *sources.Chunk, bucket string, page *s3.ListObjectsV2Output, errorCount *sync.Map, pageNumber int, objectCount *uint64) {
-	for _, obj := range page.Contents {
+// currentObjects returns the objects of a page of a listing.
+func currentObjects(contents []*s3.Object) []object {
+	objects := make([]object, 0, len(contents))
+	for _, obj := range contents {
+		if obj != nil {
+			objects = append(objects, object{Object: obj})
+		}
+	}
+	return objects
+}
+
+// versionObjects returns the objects of a page of a version listing. The
+// objects of deleted keys are only listed as non-current versions, with a
+// delete marker as their latest version.
+func versionObjects(versions []*s3.ObjectVersion) []object {
+	objects := make([]object, 0, len(versions))
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+		obj := object{Object: &s3.Object{
+			ETag:         v.ETag,
+			Key:          v.Key,
+			LastModified: v.LastModified,
+			Owner:        v.Owner,
+			Size:         v.Size,
+			StorageClass: v.StorageClass,
+		}}
+		if !aws.BoolValue(v.IsLatest) {
+			obj.versionID = v.VersionId
+		}
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// keyAllowed reports whether an object key passes the prefix and suffix
+// filters.
+func (s *Source) keyAllowed(key string) bool {
+	if len(s.includePrefixes) > 0 && !matchPrefix(s.includePrefixes, key) {
+		return false
+	}
+	if matchPrefix(s.excludePrefixes, key) {
+		return false
+	}
+	if suffixes := s.conn.GetIncludeSuffixes(); len(suffixes) > 0 && !hasSuffix(key, suffixes) {
+		return false
+	}
+	return !hasSuffix(key, s.conn.GetExcludeSuffixes())
+}
+
+// matchPrefix reports whether one of the globs matches the key or one of its
+// parent "directories", e.g. logs/* matches logs/2023/app.log.
+func matchPrefix(globs []glob.Glob, key string) bool {
+	for _, g := range globs {
+		if g.Match(key) {
+			return true
+		}
+		for i := 0; i < len(key); i++ {
+			if key[i] == '/' && (g.Match(key[:i]) || g.Match(key[:i+1])) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasSuffix(key string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// pageChunker emits chunks onto the given channel from a page,
+// and records the objects that were scanned in the checkpoint. Objects that
+// were scanned before the scan was resumed are skipped.
+func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan *sources.Chunk, bucket string, objects []object, completed map[string]struct{}, errorCount *sync.Map, pageNumber int, objectCount *uint64) {
+	for _, obj := range objects {
 		obj := obj
 		if common.IsDone(ctx) {
 			return
 		}
 
-		if obj == nil {
+		if _, ok := completed[obj.id()]; ok {
+			s.log.V(5).Info("Skipping object scanned before resuming", "object", *obj.Key)
+			s.checkpoint.complete(obj.id())
 			continue
 		}
 
 		// skip GLACIER and GLACIER_IR objects
 		if obj.StorageClass == nil || strings.Contains(*obj.StorageClass, "GLACIER") {
-			s.log.V(5).Info("Skipping object in storage class", "storage_class", *obj.StorageClass, "object", *obj.Key)
+			s.log.V(5).Info("Skipping object in storage class", "storage_class", aws.StringValue(obj.StorageClass), "object", *obj.Key)
 			continue
 		}
 
 		// ignore large files
-		if *obj.Size > int64(250*common.MB) {
-			s.log.V(3).Info("Skipping %d byte file (over 250MB limit)", "object", *obj.Key)
-			return
+		if *obj.Size > s.maxObjectSize {
+			s.log.V(3).Info("Skipping file over the max object size", "object", *obj.Key, "size", *obj.Size, "max_size", s.maxObjectSize)
+			continue
 		}
 
 		// file empty file
 		if *obj.Size == 0 {
 			s.log.V(5).Info("Skipping 0 byte file", "object", *obj.Key)
-			return
+			continue
 		}
 
 		// skip incompatible extensions
 		if common.SkipFile(*obj.Key) {
 			s.log.V(5).Info("Skipping file with incompatible extension", "object", *obj.Key)
-			return
+			continue
+		}
+
+		if !s.keyAllowed(*obj.Key) {
+			s.log.V(5).Info("Skipping file excluded by the prefix and suffix filters", "object", *obj.Key)
+			continue
+		}
+
+		if !s.filter.PassFile(*obj.Key, *obj.Size) {
+			s.log.V(5).Info("Skipping file excluded by the path filters", "object", *obj.Key)
+			continue
 		}
 
 		s.jobPool.Go(func() error {
 			defer common.RecoverWithExit(ctx)
+			defer func() {
+				// Objects that weren't scanned because the scan was
+				// interrupted are scanned when it's resumed.
+				if !common.IsDone(ctx) {
+					s.checkpoint.complete(obj.id())
+				}
+			}()
 
 			if strings.HasSuffix(*obj.Key, "/") {
 				s.log.V(5).Info("Skipping directory", "object", *obj.Key)
@@ -243,8 +476,9 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 			ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 			defer cancel()
 			res, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
-				Bucket: &bucket,
-				Key:    obj.Key,
+				Bucket:    &bucket,
+				Key:       obj.Key,
+				VersionId: obj.versionID,
 			})
 			if err != nil {
 				if !strings.Contains(err.Error(), "AccessDenied") {
@@ -290,7 +524,7 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 						S3: &source_metadatapb.S3{
 							Bucket:    bucket,
 							File:      sanitizer.UTF8(*obj.Key),
-							Link:      sanitizer.UTF8(makeS3Link(bucket, *client.Config.Region, *obj.Key)),
+							Link:      sanitizer.UTF8(makeS3Link(bucket, *client.Config.Region, *obj.Key, aws.StringValue(obj.versionID))),
 							Email:     sanitizer.UTF8(email),
 							Timestamp: sanitizer.UTF8(modified),
 						},
@@ -336,12 +570,16 @@ func (s *Source) pageChunker(ctx context.Context, client *s3.S3, chunksChan chan
 }
 
 // S3 links currently have the general format of:
-// https://[bucket].s3[.region unless us-east-1].amazonaws.com/[key]
-func makeS3Link(bucket, region, key string) string {
+// https://[bucket].s3[.region unless us-east-1].amazonaws.com/[key][?versionId=[version] for non-current versions]
+func makeS3Link(bucket, region, key, versionID string) string {
 	if region == "us-east-1" {
 		region = ""
 	} else {
 		region = "." + region
 	}
-	return fmt.Sprintf("https://%s.s3%s.amazonaws.com/%s", bucket, region, key)
+	link := fmt.Sprintf("https://%s.s3%s.amazonaws.com/%s", bucket, region, key)
+	if versionID != "" {
+		link += "?versionId=" + url.QueryEscape(versionID)
+	}
+	return link
 }
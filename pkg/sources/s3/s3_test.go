@@ -95,3 +95,84 @@ func TestSource_Chunks(t *testing.T) {
 		})
 	}
 }
+
+func TestSource_bucketRoles(t *testing.T) {
+	s := Source{
+		conn: &sourcespb.S3{
+			Roles: []string{"arn:aws:iam::111111111111:role/hub"},
+			BucketRoles: map[string]string{
+				"other-account-bucket": "arn:aws:iam::222222222222:role/scanner",
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"arn:aws:iam::111111111111:role/hub"}, s.bucketRoles("hub-bucket"))
+	assert.Equal(t, []string{
+		"arn:aws:iam::111111111111:role/hub",
+		"arn:aws:iam::222222222222:role/scanner",
+	}, s.bucketRoles("other-account-bucket"))
+	// The configured chain must not be modified.
+	assert.Equal(t, []string{"arn:aws:iam::111111111111:role/hub"}, s.conn.Roles)
+}
+
+func TestSource_keyAllowed(t *testing.T) {
+	include, err := compilePrefixGlobs([]string{"logs/*", "config"})
+	assert.NoError(t, err)
+	exclude, err := compilePrefixGlobs([]string{"logs/debug"})
+	assert.NoError(t, err)
+	s := Source{
+		conn: &sourcespb.S3{
+			ExcludeSuffixes: []string{".gz"},
+		},
+		includePrefixes: include,
+		excludePrefixes: exclude,
+	}
+
+	tests := map[string]bool{
+		"logs/app.log":          true,
+		"logs/2023/01/app.log":  true,
+		"logs/debug/app.log":    false,
+		"logs/app.log.gz":       false,
+		"config/prod/.env":      true,
+		"configuration/app.yml": false,
+		"data/dump.sql":         false,
+	}
+	for key, want := range tests {
+		assert.Equal(t, want, s.keyAllowed(key), key)
+	}
+}
+
+func Test_makeS3Link(t *testing.T) {
+	assert.Equal(t, "https://bucket.s3.amazonaws.com/dir/file", makeS3Link("bucket", "us-east-1", "dir/file", ""))
+	assert.Equal(t, "https://bucket.s3.us-west-2.amazonaws.com/file?versionId=a%2Bb", makeS3Link("bucket", "us-west-2", "file", "a+b"))
+}
+
+func TestCheckpoint(t *testing.T) {
+	progress := &sources.Progress{}
+	c := &checkpoint{progress: progress}
+
+	c.startBucket(1, 3, resumeInfo{Bucket: "bucket"})
+	c.startPage("token", "", "")
+	c.complete("b.txt")
+	c.complete("a.txt")
+
+	assert.Equal(t, int32(1), progress.SectionsCompleted)
+	assert.Equal(t, int32(3), progress.SectionsRemaining)
+	info, err := decodeResumeInfo(progress.EncodedResumeInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, resumeInfo{
+		Bucket:            "bucket",
+		ContinuationToken: "token",
+		Completed:         []string{"b.txt", "a.txt"},
+	}, info)
+	assert.Contains(t, info.completedSet(), "a.txt")
+
+	// Starting the next page drops the objects of the previous one.
+	c.startPage("next", "", "")
+	info, err = decodeResumeInfo(progress.EncodedResumeInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, resumeInfo{Bucket: "bucket", ContinuationToken: "next"}, info)
+
+	_, err = decodeResumeInfo("not json")
+	assert.Error(t, err)
+}
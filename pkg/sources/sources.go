@@ -2,6 +2,7 @@ package sources
 
 import (
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -68,7 +69,17 @@ type GCSConfig struct {
 	// IncludeObjects is a list of objects to include in the scan.
 	IncludeObjects,
 	// ExcludeObjects is a list of objects to exclude from the scan.
-	ExcludeObjects []string
+	ExcludeObjects,
+	// IncludePrefixes is a list of object name prefixes to scan.
+	IncludePrefixes,
+	// ExcludePrefixes is a list of object name prefixes to exclude from the scan.
+	ExcludePrefixes []string
+	// UserProject is the project billed for requests to requester pays buckets.
+	UserProject string
+	// IncludeGenerations enables scanning noncurrent object generations.
+	IncludeGenerations bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
 }
 
 // GitConfig defines the optional configuration for a git source.
@@ -86,6 +97,39 @@ type GitConfig struct {
 	// ExcludeGlobs is a list of globs to exclude from the scan.
 	// This differs from the Filter exclusions as ExcludeGlobs is applied at the `git log -p` level
 	ExcludeGlobs []string
+	// IncludeNotes indicates whether to include the git notes refs in the
+	// scan.
+	IncludeNotes,
+	// IncludeStash indicates whether to include the stash entries in the
+	// scan.
+	IncludeStash,
+	// IncludeReflog indicates whether to include the commits that are only
+	// reachable from the reflogs in the scan.
+	IncludeReflog bool
+	// SubmoduleDepth is how many levels of submodules to scan. Zero disables
+	// scanning submodules.
+	SubmoduleDepth int
+	// SubmoduleAllowlist is a list of URL globs of the submodules that may be
+	// cloned. If it's empty, only submodules on the same host are.
+	SubmoduleAllowlist []string
+	// IncludeLFS indicates whether to fetch and scan the LFS objects that LFS
+	// pointers point to.
+	IncludeLFS bool
+	// MaxLFSObjectSize is the size of the largest LFS object to scan.
+	MaxLFSObjectSize int64
+	// StagedOnly limits the scan to the changes staged for commit, e.g. in a
+	// pre-commit hook.
+	StagedOnly bool
+	// SecretAge adds to each finding the commit that first added its secret,
+	// how long it has been exposed, and whether HEAD still has it.
+	SecretAge bool
+	// Owners adds to each finding the owners of its file from the CODEOWNERS
+	// file of the repository at HEAD.
+	Owners bool
+	// PushedRevisions limits the scan to the commits that a push adds, from a
+	// pre-receive hook: those reachable from the revisions but not from any
+	// ref.
+	PushedRevisions []string
 }
 
 // GithubConfig defines the optional configuration for a github source.
@@ -93,13 +137,39 @@ type GithubConfig struct {
 	// Endpoint is the endpoint of the source.
 	Endpoint,
 	// Token is the token to use to authenticate with the source.
-	Token string
+	Token,
+	// AppID is the ID of the GitHub App to authenticate as instead of with a
+	// token.
+	AppID,
+	// AppPrivateKey is the PEM encoded private key of the GitHub App.
+	AppPrivateKey,
+	// AppInstallationID is the installation of the GitHub App to scan. Every
+	// installation is scanned if it's empty.
+	AppInstallationID string
 	// IncludeForks indicates whether to include forks in the scan.
 	IncludeForks,
 	// IncludeMembers indicates whether to include members in the scan.
-	IncludeMembers bool
+	IncludeMembers,
+	// IncludeGists indicates whether to include the gists of members and of
+	// the authenticated user in the scan.
+	IncludeGists,
+	// IncludeWikis indicates whether to include the wikis of repositories in
+	// the scan.
+	IncludeWikis,
+	// IncludeReleases indicates whether to include release notes and assets
+	// in the scan.
+	IncludeReleases,
+	// IncludeActionsLogs indicates whether to include the logs of Actions
+	// workflow runs in the scan.
+	IncludeActionsLogs,
+	// IncludeComments indicates whether to include issues, pull requests,
+	// discussions and their comments in the scan.
+	IncludeComments bool
 	// Concurrency is the number of concurrent workers to use to scan the source.
 	Concurrency int
+	// ActionsLogsWindow limits the scanned workflow run logs to runs created
+	// within the window. All runs are scanned if it's zero.
+	ActionsLogsWindow time.Duration
 	// Repos is the list of repositories to scan.
 	Repos,
 	// Orgs is the list of organizations to scan.
@@ -107,9 +177,17 @@ type GithubConfig struct {
 	// ExcludeRepos is a list of repositories to exclude from the scan.
 	ExcludeRepos,
 	// IncludeRepos is a list of repositories to include in the scan.
-	IncludeRepos []string
+	IncludeRepos,
+	// GistUsers is the list of users whose gists to scan.
+	GistUsers []string
 	// Filter is the filter to use to scan the source.
 	Filter *common.Filter
+	// SubmoduleDepth is how many levels of submodules to scan. Zero disables
+	// scanning submodules.
+	SubmoduleDepth int
+	// SubmoduleAllowlist is a list of URL globs of the submodules that may be
+	// cloned. If it's empty, only submodules on the same host are.
+	SubmoduleAllowlist []string
 }
 
 // GitlabConfig defines the optional configuration for a gitlab source.
@@ -120,8 +198,50 @@ type GitlabConfig struct {
 	Token string
 	// Repos is the list of repositories to scan.
 	Repos []string
+	// IncludeSnippets indicates whether to include the personal snippets of
+	// the authenticated user and the snippets of the repositories in the scan.
+	IncludeSnippets bool
+	// IncludeComments indicates whether to include the descriptions and
+	// discussions of merge requests and issues in the scan.
+	IncludeComments bool
+	// CommentProjects is a list of project path globs to limit the scanned
+	// comments to.
+	CommentProjects []string
+	// IncludeCIVariables indicates whether to include the CI/CD variables of
+	// the repositories and of their groups in the scan.
+	IncludeCIVariables,
+	// IncludeJobLogs indicates whether to include the logs of CI/CD jobs in
+	// the scan.
+	IncludeJobLogs,
+	// IncludeJobArtifacts indicates whether to include the artifacts of CI/CD
+	// jobs in the scan.
+	IncludeJobArtifacts bool
+	// JobLogsWindow limits the scanned job logs and artifacts to jobs created
+	// within the window. Zero means no limit.
+	JobLogsWindow time.Duration
+	// IncludeGroups is a list of group path globs to limit the scanned groups
+	// to.
+	IncludeGroups,
+	// IgnoreGroups is a list of group path globs to leave out of the scan.
+	IgnoreGroups,
+	// IncludeRepos is a list of project path globs to limit the scanned
+	// projects to.
+	IncludeRepos,
+	// IgnoreRepos is a list of project path globs to leave out of the scan.
+	IgnoreRepos []string
+	// SkipArchived indicates whether to leave archived projects out of the
+	// scan.
+	SkipArchived,
+	// SkipForks indicates whether to leave forked projects out of the scan.
+	SkipForks bool
 	// Filter is the filter to use to scan the source.
 	Filter *common.Filter
+	// SubmoduleDepth is how many levels of submodules to scan. Zero disables
+	// scanning submodules.
+	SubmoduleDepth int
+	// SubmoduleAllowlist is a list of URL globs of the submodules that may be
+	// cloned. If it's empty, only submodules on the same host are.
+	SubmoduleAllowlist []string
 }
 
 // FilesystemConfig defines the optional configuration for a filesystem source.
@@ -130,6 +250,18 @@ type FilesystemConfig struct {
 	Paths []string
 	// Filter is the filter to use to scan the source.
 	Filter *common.Filter
+	// RespectGitignore skips the paths ignored by .gitignore files.
+	RespectGitignore bool
+	// Watch keeps scanning files as they're created or modified.
+	Watch bool
+	// FollowSymlinks scans the directories that symlinks point to.
+	FollowSymlinks bool
+	// ScanDevices scans device files.
+	ScanDevices bool
+	// ScanAlternateDataStreams scans NTFS alternate data streams on Windows.
+	ScanAlternateDataStreams bool
+	// MaxFileSize is the number of bytes to read from each file.
+	MaxFileSize int64
 }
 
 // S3Config defines the optional configuration for an S3 source.
@@ -143,6 +275,60 @@ type S3Config struct {
 	Secret string
 	// Buckets is the list of buckets to scan.
 	Buckets []string
+	// Roles is the chain of roles to assume, in order.
+	Roles []string
+	// ExternalID is the external ID to assume the roles with.
+	ExternalID string
+	// BucketRoles maps buckets to a role to assume for them, after Roles.
+	BucketRoles map[string]string
+	// IncludePrefixes and ExcludePrefixes are globs that filter objects by
+	// their key or one of its parent prefixes.
+	IncludePrefixes, ExcludePrefixes []string
+	// IncludeSuffixes and ExcludeSuffixes filter objects by key suffix.
+	IncludeSuffixes, ExcludeSuffixes []string
+	// MaxObjectSize is the size of the largest object to scan.
+	MaxObjectSize int64
+	// IncludeVersions enables scanning non-current object versions.
+	IncludeVersions bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+}
+
+// CircleCIConfig defines the optional configuration for a CircleCI source.
+type CircleCIConfig struct {
+	// Token is the token to use to authenticate with the source.
+	Token string
+	// IncludeArtifacts indicates whether to include the stored artifacts of
+	// builds in the scan.
+	IncludeArtifacts bool
+	// AuditEnvVars indicates whether to log the names of the environment
+	// variables of the projects and of the contexts of their organizations.
+	AuditEnvVars bool
+	// Branches and Workflows limit the scanned builds to those of the given
+	// branches and workflows.
+	Branches, Workflows []string
+	// Since and Until limit the scanned builds to those that started within
+	// the range. Zero times don't limit the range.
+	Since, Until time.Time
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+}
+
+// ContainersConfig defines the optional configuration for a containers source.
+type ContainersConfig struct {
+	// Endpoint is the address of the Docker Engine API.
+	Endpoint string
+	// Containers are the IDs or names of the containers to scan. All of them
+	// are scanned if empty.
+	Containers []string
+	// IncludeStopped enables scanning stopped containers.
+	IncludeStopped bool
+	// SkipVolumes disables scanning the volumes mounted in the containers.
+	SkipVolumes bool
+	// SkipEnv disables scanning the environment variables of the containers.
+	SkipEnv bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
 }
 
 // SyslogConfig defines the optional configuration for a syslog source.
@@ -156,9 +342,16 @@ type SyslogConfig struct {
 	// Format is the format used to connect to the source.
 	Format,
 	// KeyPath is the path to the key to use to connect to the source.
-	KeyPath string
+	KeyPath,
+	// ClientCAPath is the path to the CA certificates that TLS clients must
+	// present a certificate signed by.
+	ClientCAPath string
 	// Concurrency is the number of concurrent workers to use to scan the source.
 	Concurrency int
+	// SenderRateLimit is the number of messages per second accepted from each
+	// sender, with bursts of up to SenderBurst messages. Zero means no limit.
+	SenderRateLimit float64
+	SenderBurst     int
 }
 
 // Progress is used to update job completion progress across sources.
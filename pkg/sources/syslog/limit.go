@@ -0,0 +1,56 @@
+package syslog
+
+import (
+	"net"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// senderLimiter limits the rate of the messages of each sender, so that a
+// single noisy host can't flood the scan.
+type senderLimiter struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter
+}
+
+// newSenderLimiter returns a limiter that accepts perSecond messages per
+// second from each sender, with bursts of up to burst messages, or nil if
+// perSecond isn't positive.
+func newSenderLimiter(perSecond float64, burst int) *senderLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &senderLimiter{
+		limit:    rate.Limit(perSecond),
+		burst:    burst,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// allow reports whether a message of the sender at a remote address is
+// accepted. Senders are told apart by host, since the port of a sender changes
+// with each connection.
+func (l *senderLimiter) allow(remote string) bool {
+	if l == nil {
+		return true
+	}
+	sender, _, err := net.SplitHostPort(remote)
+	if err != nil {
+		sender = remote
+	}
+
+	l.mu.Lock()
+	limiter, ok := l.limiters[sender]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[sender] = limiter
+	}
+	l.mu.Unlock()
+	return limiter.Allow()
+}
@@ -0,0 +1,168 @@
+package syslog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// relpMaxDataLen is the size of the largest RELP frame that is accepted,
+// which is the default of rsyslog.
+const relpMaxDataLen = 128 * 1024
+
+// relpOffers are the offers that the server answers the open command with.
+const relpOffers = "relp_version=0\nrelp_software=trufflehog\ncommands=syslog"
+
+// relpFrame is a RELP command or response:
+// TXNR SP COMMAND SP DATALEN [SP DATA] LF.
+type relpFrame struct {
+	txnr    int
+	command string
+	data    []byte
+}
+
+// readRELPFrame reads a frame from a RELP session.
+func readRELPFrame(r *bufio.Reader) (relpFrame, error) {
+	var frame relpFrame
+	txnr, err := readRELPToken(r, 9)
+	if err != nil {
+		return frame, err
+	}
+	if frame.txnr, err = strconv.Atoi(txnr); err != nil {
+		return frame, fmt.Errorf("invalid RELP transaction number %q", txnr)
+	}
+	if frame.command, err = readRELPToken(r, 32); err != nil {
+		return frame, err
+	}
+
+	// DATALEN is followed by a space if there's data, or by the trailer.
+	var dataLen []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return frame, err
+		}
+		if b == ' ' || b == '\n' {
+			if b == '\n' {
+				if err := r.UnreadByte(); err != nil {
+					return frame, err
+				}
+			}
+			break
+		}
+		if b < '0' || b > '9' || len(dataLen) == 9 {
+			return frame, fmt.Errorf("invalid RELP data length")
+		}
+		dataLen = append(dataLen, b)
+	}
+	n, err := strconv.Atoi(string(dataLen))
+	if err != nil {
+		return frame, fmt.Errorf("invalid RELP data length %q", dataLen)
+	}
+	if n > relpMaxDataLen {
+		return frame, fmt.Errorf("RELP frame of %d bytes is larger than %d bytes", n, relpMaxDataLen)
+	}
+	frame.data = make([]byte, n)
+	if _, err := io.ReadFull(r, frame.data); err != nil {
+		return frame, err
+	}
+
+	trailer, err := r.ReadByte()
+	if err != nil {
+		return frame, err
+	}
+	if trailer != '\n' {
+		return frame, fmt.Errorf("invalid RELP frame trailer %q", trailer)
+	}
+	return frame, nil
+}
+
+// readRELPToken reads a token of the header of a frame, which ends with a
+// space.
+func readRELPToken(r *bufio.Reader, maxLen int) (string, error) {
+	var token []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		if b == ' ' {
+			if len(token) == 0 {
+				return "", fmt.Errorf("empty RELP header field")
+			}
+			return string(token), nil
+		}
+		if len(token) == maxLen {
+			return "", fmt.Errorf("RELP header field is longer than %d bytes", maxLen)
+		}
+		token = append(token, b)
+	}
+}
+
+// writeRELPResponse answers the command of a transaction.
+func writeRELPResponse(w io.Writer, txnr int, data string) error {
+	if data == "" {
+		_, err := fmt.Fprintf(w, "%d rsp 0\n", txnr)
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d rsp %d %s\n", txnr, len(data), data)
+	return err
+}
+
+// serveRELP handles a RELP session. Messages are acknowledged once they're
+// sent to be scanned. Messages over the rate limit of the sender are refused,
+// so the client keeps them and sends them again later.
+func (s *Source) serveRELP(ctx context.Context, conn net.Conn, chunksChan chan *sources.Chunk) {
+	defer common.RecoverWithExit(ctx)
+	defer conn.Close()
+
+	// Reads block until the next frame, so the connection is closed to end
+	// the session when the context is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	remote := conn.RemoteAddr().String()
+	reader := bufio.NewReader(conn)
+	for {
+		frame, err := readRELPFrame(reader)
+		if err != nil {
+			if err != io.EOF && !common.IsDone(ctx) {
+				ctx.Logger().V(2).Info("could not read RELP frame", "client", remote, "error", err)
+			}
+			return
+		}
+
+		switch frame.command {
+		case "open":
+			err = writeRELPResponse(conn, frame.txnr, "200 OK\n"+relpOffers)
+		case "syslog":
+			if s.handleMessage(ctx, frame.data, remote, chunksChan) {
+				err = writeRELPResponse(conn, frame.txnr, "200 OK")
+			} else {
+				err = writeRELPResponse(conn, frame.txnr, "500 rate limit exceeded")
+			}
+		case "close":
+			_ = writeRELPResponse(conn, frame.txnr, "")
+			return
+		default:
+			err = writeRELPResponse(conn, frame.txnr, "500 unknown command "+frame.command)
+		}
+		if err != nil {
+			ctx.Logger().V(2).Info("could not write RELP response", "client", remote, "error", err)
+			return
+		}
+	}
+}
@@ -2,11 +2,13 @@ package syslog
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bill-rich/go-syslog/pkg/syslogparser/rfc3164"
@@ -32,7 +34,8 @@ type Source struct {
 	verify   bool
 	syslog   *Syslog
 	sources.Progress
-	conn *sourcespb.Syslog
+	conn    *sourcespb.Syslog
+	limiter *senderLimiter
 }
 
 type Syslog struct {
@@ -100,6 +103,7 @@ func (s *Source) Init(_ context.Context, name string, jobId, sourceId int64, ver
 	if err != nil {
 		return errors.WrapPrefix(err, "invalid configuration", 0)
 	}
+	s.limiter = newSenderLimiter(conn.SenderRateLimit, int(conn.SenderBurst))
 
 	s.syslog = NewSyslog(s.Type(), s.jobId, s.sourceId, s.name, s.verify, runtime.NumCPU(),
 		func(hostname, appname, procID, timestamp, facility, client string) *source_metadatapb.MetaData {
@@ -129,10 +133,20 @@ func (s *Source) verifyConnectionConfig() error {
 		}
 	}
 
+	switch s.conn.Protocol {
+	case "udp", "tcp", "relp":
+	default:
+		return fmt.Errorf("unknown protocol %q, expected udp, tcp or relp", s.conn.Protocol)
+	}
+
 	if s.conn.Protocol == "udp" && tlsEnabled {
 		return fmt.Errorf("TLS is not supported over UDP")
 	}
 
+	if s.conn.TlsClientCA != nilString && !tlsEnabled {
+		return fmt.Errorf("a TLS client CA requires a TLS cert and key")
+	}
+
 	if s.conn.ListenAddress == nilString {
 		s.conn.ListenAddress = ":5140"
 	}
@@ -145,22 +159,27 @@ func (s *Source) verifyConnectionConfig() error {
 
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) error {
+	// RELP runs over TCP.
+	network := s.conn.Protocol
+	if network == "relp" {
+		network = "tcp"
+	}
+
 	switch {
 	case s.conn.TlsCert != nilString || s.conn.TlsKey != nilString:
-		cert, err := tls.X509KeyPair([]byte(s.conn.TlsCert), []byte(s.conn.TlsKey))
+		cfg, err := s.tlsConfig()
 		if err != nil {
-			return errors.WrapPrefix(err, "could not load key pair", 0)
+			return err
 		}
-		cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
-		lis, err := tls.Listen(s.conn.Protocol, s.conn.ListenAddress, cfg)
+		lis, err := tls.Listen(network, s.conn.ListenAddress, cfg)
 		if err != nil {
 			return errors.WrapPrefix(err, "error creating TLS listener", 0)
 		}
 		defer lis.Close()
 
 		return s.acceptTCPConnections(ctx, lis, chunksChan)
-	case s.conn.Protocol == "tcp":
-		lis, err := net.Listen(s.conn.Protocol, s.conn.ListenAddress)
+	case network == "tcp":
+		lis, err := net.Listen(network, s.conn.ListenAddress)
 		if err != nil {
 			return errors.WrapPrefix(err, "error creating TCP listener", 0)
 		}
@@ -184,26 +203,99 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk) err
 	}
 }
 
-func (s *Source) parseSyslogMetadata(input []byte, remote string) (*source_metadatapb.MetaData, error) {
-	var metadata *source_metadatapb.MetaData
+// tlsConfig returns the TLS config of the listener. Clients must present a
+// certificate signed by the client CA, if there's one.
+func (s *Source) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair([]byte(s.conn.TlsCert), []byte(s.conn.TlsKey))
+	if err != nil {
+		return nil, errors.WrapPrefix(err, "could not load key pair", 0)
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if s.conn.TlsClientCA != nilString {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(s.conn.TlsClientCA)) {
+			return nil, fmt.Errorf("could not load TLS client CA")
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// syslogMessage is a parsed syslog message.
+type syslogMessage struct {
+	metadata *source_metadatapb.MetaData
+	// body is the part of the message that is scanned, which is the whole
+	// message unless its structured data was parsed out of it.
+	body           []byte
+	structuredData []rfc5424.StructuredData
+}
+
+func (s *Source) parseSyslogMessage(input []byte, remote string) (syslogMessage, error) {
+	msg := syslogMessage{body: input}
 	switch s.conn.Format {
 	case "rfc5424":
 		message := &rfc5424.Message{}
 		err := message.UnmarshalBinary(input)
 		if err != nil {
-			return metadata, errors.WrapPrefix(err, "could not parse syslog as rfc5424", 0)
+			return msg, errors.WrapPrefix(err, "could not parse syslog as rfc5424", 0)
 		}
-		metadata = s.syslog.sourceMetadataFunc(message.Hostname, message.AppName, message.ProcessID, message.Timestamp.String(), nilString, remote)
+		msg.metadata = s.syslog.sourceMetadataFunc(message.Hostname, message.AppName, message.ProcessID, message.Timestamp.String(), nilString, remote)
+		msg.body = message.Message
+		msg.structuredData = message.StructuredData
 	case "rfc3164":
 		parser := rfc3164.NewParser(input)
 		err := parser.Parse()
 		if err != nil {
-			return metadata, errors.WrapPrefix(err, "could not parse syslog as rfc3164", 0)
+			return msg, errors.WrapPrefix(err, "could not parse syslog as rfc3164", 0)
 		}
 		data := parser.Dump()
-		metadata = s.syslog.sourceMetadataFunc(data["hostname"].(string), nilString, nilString, data["timestamp"].(time.Time).String(), strconv.Itoa(data["facility"].(int)), remote)
+		msg.metadata = s.syslog.sourceMetadataFunc(data["hostname"].(string), nilString, nilString, data["timestamp"].(time.Time).String(), strconv.Itoa(data["facility"].(int)), remote)
+	}
+	return msg, nil
+}
+
+// handleMessage sends a syslog message to be scanned, unless its sender is
+// over the rate limit. The elements of the structured data of RFC 5424
+// messages are scanned separately, each as a line per parameter.
+func (s *Source) handleMessage(ctx context.Context, input []byte, remote string, chunksChan chan *sources.Chunk) bool {
+	if !s.limiter.allow(remote) {
+		ctx.Logger().V(3).Info("dropping message over the sender rate limit", "client", remote)
+		return false
+	}
+	ctx.Logger().V(5).Info(string(input))
+	msg, err := s.parseSyslogMessage(input, remote)
+	if err != nil {
+		ctx.Logger().V(2).Info("failed to generate metadata", "error", err)
+	}
+
+	if len(msg.body) > 0 {
+		chunksChan <- s.chunk(msg.metadata, msg.body)
+	}
+	for _, element := range msg.structuredData {
+		if len(element.Parameters) == 0 {
+			continue
+		}
+		params := make([]string, 0, len(element.Parameters))
+		for _, param := range element.Parameters {
+			params = append(params, param.Name+"="+param.Value)
+		}
+		metadata := proto.Clone(msg.metadata).(*source_metadatapb.MetaData)
+		metadata.GetSyslog().StructuredDataId = element.ID
+		chunksChan <- s.chunk(metadata, []byte(strings.Join(params, "\n")))
+	}
+	return true
+}
+
+func (s *Source) chunk(metadata *source_metadatapb.MetaData, data []byte) *sources.Chunk {
+	return &sources.Chunk{
+		SourceName:     s.syslog.sourceName,
+		SourceID:       s.syslog.sourceID,
+		SourceType:     s.syslog.sourceType,
+		SourceMetadata: metadata,
+		Data:           data,
+		Verify:         s.verify,
 	}
-	return metadata, nil
 }
 
 func (s *Source) monitorConnection(ctx context.Context, conn net.Conn, chunksChan chan *sources.Chunk) {
@@ -218,26 +310,14 @@ func (s *Source) monitorConnection(ctx context.Context, conn net.Conn, chunksCha
 		}
 		input := make([]byte, 8096)
 		remote := conn.RemoteAddr()
-		_, err = conn.Read(input)
+		n, err := conn.Read(input)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return
 			}
 			continue
 		}
-		ctx.Logger().V(5).Info(string(input))
-		metadata, err := s.parseSyslogMetadata(input, remote.String())
-		if err != nil {
-			ctx.Logger().V(2).Info("failed to generate metadata", "error", err)
-		}
-		chunksChan <- &sources.Chunk{
-			SourceName:     s.syslog.sourceName,
-			SourceID:       s.syslog.sourceID,
-			SourceType:     s.syslog.sourceType,
-			SourceMetadata: metadata,
-			Data:           input,
-			Verify:         s.verify,
-		}
+		s.handleMessage(ctx, input[:n], remote.String(), chunksChan)
 	}
 }
 
@@ -251,6 +331,10 @@ func (s *Source) acceptTCPConnections(ctx context.Context, netListener net.Liste
 			ctx.Logger().V(2).Info("failed to accept TCP connection", "error", err)
 			continue
 		}
+		if s.conn.Protocol == "relp" {
+			go s.serveRELP(ctx, conn, chunksChan)
+			continue
+		}
 		go s.monitorConnection(ctx, conn, chunksChan)
 	}
 }
@@ -261,24 +345,13 @@ func (s *Source) acceptUDPConnections(ctx context.Context, netListener net.Packe
 			return nil
 		}
 		input := make([]byte, 65535)
-		_, remote, err := netListener.ReadFrom(input)
+		n, remote, err := netListener.ReadFrom(input)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return nil
 			}
 			continue
 		}
-		metadata, err := s.parseSyslogMetadata(input, remote.String())
-		if err != nil {
-			ctx.Logger().V(2).Info("failed to parse metadata", "error", err)
-		}
-		chunksChan <- &sources.Chunk{
-			SourceName:     s.syslog.sourceName,
-			SourceID:       s.syslog.sourceID,
-			SourceType:     s.syslog.sourceType,
-			SourceMetadata: metadata,
-			Data:           input,
-			Verify:         s.verify,
-		}
+		s.handleMessage(ctx, input[:n], remote.String(), chunksChan)
 	}
 }
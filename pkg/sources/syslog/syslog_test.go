@@ -0,0 +1,114 @@
+package syslog
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func Test_readRELPFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    relpFrame
+		wantErr bool
+	}{
+		{
+			name:  "command with data",
+			input: "1 syslog 11 hello world\n",
+			want:  relpFrame{txnr: 1, command: "syslog", data: []byte("hello world")},
+		},
+		{
+			name:  "data with newlines",
+			input: "2 open 9 a=1\nb=2\nc\n",
+			want:  relpFrame{txnr: 2, command: "open", data: []byte("a=1\nb=2\nc")},
+		},
+		{
+			name:  "command without data",
+			input: "3 close 0\n",
+			want:  relpFrame{txnr: 3, command: "close", data: []byte{}},
+		},
+		{
+			name:    "data longer than its length",
+			input:   "4 syslog 2 abc\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid transaction number",
+			input:   "x syslog 1 a\n",
+			wantErr: true,
+		},
+		{
+			name:    "frame too large",
+			input:   fmt.Sprintf("5 syslog %d a\n", relpMaxDataLen+1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readRELPFrame(bufio.NewReader(strings.NewReader(tt.input)))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSource_serveRELP(t *testing.T) {
+	s := &Source{
+		conn:    &sourcespb.Syslog{Protocol: "relp"},
+		syslog:  NewSyslog(sourcespb.SourceType_SOURCE_TYPE_SYSLOG, 0, 0, "test", false, 1, nil),
+		limiter: newSenderLimiter(1, 1),
+	}
+	server, client := net.Pipe()
+	chunksCh := make(chan *sources.Chunk, 10)
+	go s.serveRELP(context.Background(), server, chunksCh)
+	defer client.Close()
+
+	reader := bufio.NewReader(client)
+	send := func(frame string) relpFrame {
+		t.Helper()
+		_, err := client.Write([]byte(frame))
+		assert.NoError(t, err)
+		rsp, err := readRELPFrame(reader)
+		assert.NoError(t, err)
+		return rsp
+	}
+
+	rsp := send("1 open 14 relp_version=0\n")
+	assert.Equal(t, relpFrame{txnr: 1, command: "rsp", data: []byte("200 OK\n" + relpOffers)}, rsp)
+
+	rsp = send("2 syslog 11 hello world\n")
+	assert.Equal(t, "200 OK", string(rsp.data))
+	assert.Equal(t, "hello world", string((<-chunksCh).Data))
+
+	// The sender is over its rate limit.
+	rsp = send("3 syslog 5 hello\n")
+	assert.Equal(t, "500 rate limit exceeded", string(rsp.data))
+	assert.Len(t, chunksCh, 0)
+
+	rsp = send("4 close 0\n")
+	assert.Equal(t, relpFrame{txnr: 4, command: "rsp", data: []byte{}}, rsp)
+}
+
+func Test_senderLimiter(t *testing.T) {
+	var unlimited *senderLimiter
+	assert.True(t, unlimited.allow("10.0.0.1:514"))
+
+	limiter := newSenderLimiter(0.001, 2)
+	assert.True(t, limiter.allow("10.0.0.1:514"))
+	assert.True(t, limiter.allow("10.0.0.1:515"))
+	assert.False(t, limiter.allow("10.0.0.1:516"))
+	assert.True(t, limiter.allow("10.0.0.2:514"))
+}
@@ -0,0 +1,138 @@
+package ticketing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// DefaultGitHubEndpoint is the API endpoint of github.com.
+const DefaultGitHubEndpoint = "https://api.github.com"
+
+// GitHubIssues files tickets as issues of GitHub repositories. The
+// fingerprint of a ticket is in the body of its issue, since a label per
+// secret would clutter the labels of the repository.
+type GitHubIssues struct {
+	// Endpoint defaults to DefaultGitHubEndpoint.
+	Endpoint string
+	Token    string
+	// Client defaults to common.SaneHttpClient.
+	Client *http.Client
+
+	mu sync.Mutex
+	// fingerprints are the fingerprints of the issues of each repository,
+	// listed once per repository.
+	fingerprints map[string]map[string]bool
+}
+
+// Ensure GitHubIssues satisfies the interface at compile time.
+var _ Tracker = (*GitHubIssues)(nil)
+
+var fingerprintPat = regexp.MustCompile(`(?m)^` + regexp.QuoteMeta(fingerprintLine("")) + `([0-9a-f]{64})\s*$`)
+
+// Exists implements Tracker. The issues with Label are listed, open and
+// closed, the first time a repository is looked up.
+func (g *GitHubIssues) Exists(ctx context.Context, repo, fingerprint string) (bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.fingerprints == nil {
+		g.fingerprints = map[string]map[string]bool{}
+	}
+	if known, ok := g.fingerprints[repo]; ok {
+		return known[fingerprint], nil
+	}
+
+	known := map[string]bool{}
+	for page := 1; ; page++ {
+		var issues []struct {
+			Body string `json:"body"`
+		}
+		path := fmt.Sprintf("/repos/%s/issues?labels=%s&state=all&per_page=100&page=%d", repo, Label, page)
+		if err := g.do(ctx, http.MethodGet, path, nil, http.StatusOK, &issues); err != nil {
+			return false, err
+		}
+		for _, issue := range issues {
+			for _, match := range fingerprintPat.FindAllStringSubmatch(issue.Body, -1) {
+				known[match[1]] = true
+			}
+		}
+		if len(issues) < 100 {
+			break
+		}
+	}
+	g.fingerprints[repo] = known
+	return known[fingerprint], nil
+}
+
+// Create implements Tracker.
+func (g *GitHubIssues) Create(ctx context.Context, ticket Ticket) (string, error) {
+	issue := map[string]any{
+		"title":  ticket.Title,
+		"body":   ticket.Body,
+		"labels": ticket.Labels,
+	}
+	if ticket.Assignee != "" {
+		issue["assignees"] = []string{strings.TrimPrefix(ticket.Assignee, "@")}
+	}
+	var res struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := g.do(ctx, http.MethodPost, "/repos/"+ticket.Project+"/issues", issue, http.StatusCreated, &res); err != nil {
+		return "", err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if known, ok := g.fingerprints[ticket.Project]; ok {
+		known[ticket.Fingerprint] = true
+	}
+	return res.HTMLURL, nil
+}
+
+func (g *GitHubIssues) do(ctx context.Context, method, path string, body any, wantStatus int, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	endpoint := g.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultGitHubEndpoint
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(endpoint, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if g.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.Token)
+	}
+	client := g.Client
+	if client == nil {
+		client = common.SaneHttpClient()
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != wantStatus {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("github responded to %s %s with status %d: %s", method, path, res.StatusCode, bytes.TrimSpace(msg))
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
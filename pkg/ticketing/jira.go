@@ -0,0 +1,118 @@
+package ticketing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// Jira files tickets as issues of Jira projects. The fingerprint of a ticket
+// is one of its labels.
+type Jira struct {
+	// URL is the URL of the Jira site, e.g. https://example.atlassian.net.
+	URL string
+	// User and Token are the email and API token of a Jira Cloud account.
+	// Without a user, Token is a personal access token of Jira Data Center.
+	User, Token string
+	// IssueType defaults to Task.
+	IssueType string
+	// Client defaults to common.SaneHttpClient.
+	Client *http.Client
+}
+
+// Ensure Jira satisfies the interface at compile time.
+var _ Tracker = (*Jira)(nil)
+
+// Exists implements Tracker.
+func (j *Jira) Exists(ctx context.Context, project, fingerprint string) (bool, error) {
+	jql := fmt.Sprintf("project = %q AND labels = %q", project, fingerprintLabel(fingerprint))
+	query := url.Values{"jql": {jql}, "maxResults": {"0"}}
+	var res struct {
+		Total int `json:"total"`
+	}
+	if err := j.do(ctx, http.MethodGet, "/rest/api/2/search?"+query.Encode(), nil, http.StatusOK, &res); err != nil {
+		return false, err
+	}
+	return res.Total > 0, nil
+}
+
+// Create implements Tracker.
+func (j *Jira) Create(ctx context.Context, ticket Ticket) (string, error) {
+	issueType := j.IssueType
+	if issueType == "" {
+		issueType = "Task"
+	}
+	labels := append([]string{fingerprintLabel(ticket.Fingerprint)}, ticket.Labels...)
+	fields := map[string]any{
+		"project":     map[string]string{"key": ticket.Project},
+		"issuetype":   map[string]string{"name": issueType},
+		"summary":     ticket.Title,
+		"description": ticket.Body,
+		"labels":      labels,
+	}
+	if ticket.Assignee != "" {
+		// Jira Cloud only knows users by their account IDs.
+		if j.User != "" {
+			fields["assignee"] = map[string]string{"accountId": ticket.Assignee}
+		} else {
+			fields["assignee"] = map[string]string{"name": ticket.Assignee}
+		}
+	}
+	var res struct {
+		Key string `json:"key"`
+	}
+	if err := j.do(ctx, http.MethodPost, "/rest/api/2/issue", map[string]any{"fields": fields}, http.StatusCreated, &res); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(j.URL, "/") + "/browse/" + res.Key, nil
+}
+
+func (j *Jira) do(ctx context.Context, method, path string, body any, wantStatus int, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(j.URL, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if j.User != "" {
+		req.SetBasicAuth(j.User, j.Token)
+	} else if j.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+j.Token)
+	}
+	client := j.Client
+	if client == nil {
+		client = common.SaneHttpClient()
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != wantStatus {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("jira responded to %s %s with status %d: %s", method, path, res.StatusCode, bytes.TrimSpace(msg))
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// fingerprintLabel is the label of the Jira issues of a fingerprint.
+func fingerprintLabel(fingerprint string) string {
+	return Label + "-" + fingerprint
+}
@@ -0,0 +1,278 @@
+// Package ticketing files a ticket in Jira or GitHub Issues for each verified
+// secret, so that leaks are tracked until they're remediated.
+package ticketing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/remediation"
+)
+
+// Label is added to every ticket, so the tickets filed for findings can be
+// told apart.
+const Label = "trufflehog"
+
+// Ticket is a ticket to create.
+type Ticket struct {
+	// Fingerprint identifies the secret of the ticket, so that a secret
+	// found again doesn't get another ticket.
+	Fingerprint string
+	// Project is the key of the Jira project or the owner/name of the GitHub
+	// repository.
+	Project  string
+	Title    string
+	Body     string
+	Labels   []string
+	Assignee string
+}
+
+// Tracker creates tickets in an issue tracker.
+type Tracker interface {
+	// Exists reports whether the project has a ticket, open or closed, with
+	// the fingerprint.
+	Exists(ctx context.Context, project, fingerprint string) (bool, error)
+	// Create creates a ticket and returns its URL.
+	Create(ctx context.Context, ticket Ticket) (string, error)
+}
+
+// Templates are the text/template templates of the fields of tickets. They
+// are executed with the Finding of each ticket, e.g. {{.DetectorName}} or
+// {{index .ExtraData "owners"}}.
+type Templates struct {
+	// Project is the key of the Jira project or the owner/name of the GitHub
+	// repository.
+	Project string
+	// Labels that are empty once executed are left out.
+	Labels []string
+	// Assignee is a Jira user or a GitHub login. Tickets are unassigned if
+	// it's empty once executed.
+	Assignee string
+}
+
+// Finding is the data the templates are executed with.
+type Finding struct {
+	DetectorName string
+	// Secret is the redacted secret, or the secret with all but its first
+	// characters masked.
+	Secret     string
+	SourceName string
+	// Metadata has the fields of the source metadata, e.g. file, line,
+	// commit and repository for git.
+	Metadata  map[string]string
+	ExtraData map[string]string
+}
+
+// Ticketer files a ticket for each verified secret that doesn't have one yet.
+type Ticketer struct {
+	tracker  Tracker
+	project  *template.Template
+	labels   []*template.Template
+	assignee *template.Template
+
+	mu sync.Mutex
+	// filed are the fingerprints known to have a ticket, so that a secret
+	// found in many places is only looked up once.
+	filed map[string]bool
+}
+
+// New returns a ticketer that files tickets with a tracker.
+func New(tracker Tracker, templates Templates) (*Ticketer, error) {
+	if templates.Project == "" {
+		return nil, errors.New("the project of the tickets is not set")
+	}
+	t := &Ticketer{tracker: tracker, filed: map[string]bool{}}
+	var err error
+	if t.project, err = template.New("project").Parse(templates.Project); err != nil {
+		return nil, fmt.Errorf("invalid project template: %w", err)
+	}
+	for i, text := range templates.Labels {
+		label, err := template.New(fmt.Sprintf("label %d", i+1)).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label template: %w", err)
+		}
+		t.labels = append(t.labels, label)
+	}
+	if t.assignee, err = template.New("assignee").Parse(templates.Assignee); err != nil {
+		return nil, fmt.Errorf("invalid assignee template: %w", err)
+	}
+	return t, nil
+}
+
+// File files a ticket for a result, unless it isn't verified or its secret
+// already has one. It returns the URL of the new ticket, if one was created.
+func (t *Ticketer) File(ctx context.Context, r *detectors.ResultWithMetadata) (string, error) {
+	if !r.Verified {
+		return "", nil
+	}
+	fingerprint := Fingerprint(r.Result)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.filed[fingerprint] {
+		return "", nil
+	}
+
+	finding := findingOf(r)
+	ticket := Ticket{
+		Fingerprint: fingerprint,
+		Title:       titleOf(finding),
+		Body:        bodyOf(finding, r, fingerprint),
+		Labels:      []string{Label},
+	}
+	var err error
+	if ticket.Project, err = execute(t.project, finding); err != nil {
+		return "", err
+	}
+	if ticket.Project == "" {
+		return "", errors.New("the project of the ticket is empty")
+	}
+	for _, tmpl := range t.labels {
+		label, err := execute(tmpl, finding)
+		if err != nil {
+			return "", err
+		}
+		if label != "" {
+			ticket.Labels = append(ticket.Labels, label)
+		}
+	}
+	if ticket.Assignee, err = execute(t.assignee, finding); err != nil {
+		return "", err
+	}
+
+	exists, err := t.tracker.Exists(ctx, ticket.Project, fingerprint)
+	if err != nil {
+		return "", fmt.Errorf("could not look for the ticket of the secret: %w", err)
+	}
+	if exists {
+		t.filed[fingerprint] = true
+		return "", nil
+	}
+	url, err := t.tracker.Create(ctx, ticket)
+	if err != nil {
+		return "", fmt.Errorf("could not create the ticket: %w", err)
+	}
+	t.filed[fingerprint] = true
+	return url, nil
+}
+
+// Fingerprint identifies the secret of a result. It doesn't depend on where
+// the secret was found, so each secret gets a single ticket.
+func Fingerprint(r detectors.Result) string {
+	h := sha256.New()
+	for _, part := range [][]byte{[]byte(r.DetectorType.String()), r.Raw, r.RawV2} {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func execute(tmpl *template.Template, finding Finding) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, finding); err != nil {
+		return "", fmt.Errorf("could not execute the %s template: %w", tmpl.Name(), err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func findingOf(r *detectors.ResultWithMetadata) Finding {
+	f := Finding{
+		DetectorName: r.DetectorName,
+		Secret:       r.Redacted,
+		SourceName:   r.SourceName,
+		Metadata:     metadataOf(r.SourceMetadata),
+		ExtraData:    r.ExtraData,
+	}
+	if f.DetectorName == "" {
+		f.DetectorName = r.DetectorType.String()
+	}
+	if f.Secret == "" {
+		f.Secret = mask(string(r.Raw))
+	}
+	if f.ExtraData == nil {
+		f.ExtraData = map[string]string{}
+	}
+	return f
+}
+
+// metadataOf flattens the source metadata of a result into its fields.
+func metadataOf(md *source_metadatapb.MetaData) map[string]string {
+	fields := map[string]string{}
+	data, err := json.Marshal(md.GetData())
+	if err != nil {
+		return fields
+	}
+	var sources map[string]map[string]any
+	if err := json.Unmarshal(data, &sources); err != nil {
+		return fields
+	}
+	for _, source := range sources {
+		for k, v := range source {
+			fields[k] = fmt.Sprint(v)
+		}
+	}
+	return fields
+}
+
+// mask hides all but the first characters of a secret.
+func mask(secret string) string {
+	runes := []rune(secret)
+	if len(runes) <= 8 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:4]) + strings.Repeat("*", len(runes)-4)
+}
+
+func titleOf(f Finding) string {
+	where := f.SourceName
+	for _, key := range []string{"file", "link", "repository"} {
+		if f.Metadata[key] != "" {
+			where = f.Metadata[key]
+			break
+		}
+	}
+	if where == "" {
+		return fmt.Sprintf("Leaked %s secret", f.DetectorName)
+	}
+	return fmt.Sprintf("Leaked %s secret in %s", f.DetectorName, where)
+}
+
+func bodyOf(f Finding, r *detectors.ResultWithMetadata, fingerprint string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "A verified %s secret was found.\n\n", f.DetectorName)
+	fmt.Fprintf(&b, "Secret: %s\n", f.Secret)
+	if f.SourceName != "" {
+		fmt.Fprintf(&b, "Source: %s\n", f.SourceName)
+	}
+	for _, fields := range []map[string]string{f.Metadata, f.ExtraData} {
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s: %s\n", k, fields[k])
+		}
+	}
+	if g := remediation.For(r.DetectorType); g != nil {
+		fmt.Fprintf(&b, "\nRemediation:\n%s", g)
+	}
+	fmt.Fprintf(&b, "\n%s\n", fingerprintLine(fingerprint))
+	return b.String()
+}
+
+// fingerprintLine is the line of the body of tickets with their fingerprint.
+func fingerprintLine(fingerprint string) string {
+	return "trufflehog-fingerprint: " + fingerprint
+}
@@ -0,0 +1,190 @@
+package ticketing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+type fakeTracker struct {
+	existing map[string]bool
+	created  []Ticket
+}
+
+func (f *fakeTracker) Exists(_ context.Context, project, fingerprint string) (bool, error) {
+	return f.existing[project+"/"+fingerprint], nil
+}
+
+func (f *fakeTracker) Create(_ context.Context, ticket Ticket) (string, error) {
+	f.created = append(f.created, ticket)
+	return fmt.Sprintf("https://tracker.example.com/%d", len(f.created)), nil
+}
+
+func gitResult(secret, file string, verified bool) *detectors.ResultWithMetadata {
+	return &detectors.ResultWithMetadata{
+		SourceName: "trufflehog - git",
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Git{Git: &source_metadatapb.Git{File: file, Line: 4}},
+		},
+		Result: detectors.Result{
+			DetectorType: detectorspb.DetectorType_Github,
+			Verified:     verified,
+			Raw:          []byte(secret),
+			ExtraData:    map[string]string{"owners": "@octocat"},
+		},
+	}
+}
+
+func TestTicketerFile(t *testing.T) {
+	ctx := context.Background()
+	tracker := &fakeTracker{existing: map[string]bool{}}
+	ticketer, err := New(tracker, Templates{
+		Project:  "SEC",
+		Labels:   []string{"{{.DetectorName}}", `{{index .ExtraData "missing"}}`},
+		Assignee: `{{index .ExtraData "owners"}}`,
+	})
+	assert.NoError(t, err)
+
+	url, err := ticketer.File(ctx, gitResult("ghp_0123456789abcdef", "config.yml", true))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://tracker.example.com/1", url)
+	if assert.Len(t, tracker.created, 1) {
+		ticket := tracker.created[0]
+		assert.Equal(t, "SEC", ticket.Project)
+		assert.Equal(t, "Leaked Github secret in config.yml", ticket.Title)
+		assert.Equal(t, []string{Label, "Github"}, ticket.Labels)
+		assert.Equal(t, "@octocat", ticket.Assignee)
+		assert.Contains(t, ticket.Body, "Secret: ghp_****************\n")
+		assert.Contains(t, ticket.Body, "line: 4\n")
+		assert.Contains(t, ticket.Body, fingerprintLine(ticket.Fingerprint))
+		assert.NotContains(t, ticket.Body, "ghp_0123456789abcdef")
+	}
+
+	// The same secret elsewhere, and unverified secrets, don't get tickets.
+	url, err = ticketer.File(ctx, gitResult("ghp_0123456789abcdef", "other.yml", true))
+	assert.NoError(t, err)
+	assert.Empty(t, url)
+	url, err = ticketer.File(ctx, gitResult("ghp_unverified000000", "config.yml", false))
+	assert.NoError(t, err)
+	assert.Empty(t, url)
+
+	// Nor do secrets that have a ticket from an earlier scan.
+	known := gitResult("ghp_reported00000000", "config.yml", true)
+	tracker.existing["SEC/"+Fingerprint(known.Result)] = true
+	url, err = ticketer.File(ctx, known)
+	assert.NoError(t, err)
+	assert.Empty(t, url)
+	assert.Len(t, tracker.created, 1)
+
+	_, err = New(tracker, Templates{})
+	assert.Error(t, err)
+	_, err = New(tracker, Templates{Project: "{{.Nope"})
+	assert.Error(t, err)
+}
+
+func TestJira(t *testing.T) {
+	var created map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "me@example.com:token", user+":"+pass)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/2/search":
+			total := 0
+			if strings.Contains(r.URL.Query().Get("jql"), `labels = "trufflehog-known"`) {
+				total = 1
+			}
+			_ = json.NewEncoder(w).Encode(map[string]int{"total": total})
+		case "POST /rest/api/2/issue":
+			var body struct {
+				Fields map[string]any `json:"fields"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			created = body.Fields
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"SEC-7"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	jira := &Jira{URL: server.URL + "/", User: "me@example.com", Token: "token"}
+	exists, err := jira.Exists(ctx, "SEC", "known")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = jira.Exists(ctx, "SEC", "new")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	url, err := jira.Create(ctx, Ticket{Fingerprint: "new", Project: "SEC", Title: "title", Labels: []string{Label}, Assignee: "5b10a2844c20165700ede21g"})
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/browse/SEC-7", url)
+	assert.Equal(t, map[string]any{"key": "SEC"}, created["project"])
+	assert.Equal(t, map[string]any{"name": "Task"}, created["issuetype"])
+	assert.Equal(t, []any{"trufflehog-new", Label}, created["labels"])
+	assert.Equal(t, map[string]any{"accountId": "5b10a2844c20165700ede21g"}, created["assignee"])
+}
+
+func TestGitHubIssues(t *testing.T) {
+	known := strings.Repeat("a", 64)
+	listed := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/org/repo/issues":
+			listed++
+			assert.Equal(t, Label, r.URL.Query().Get("labels"))
+			assert.Equal(t, "all", r.URL.Query().Get("state"))
+			var issues []map[string]string
+			if r.URL.Query().Get("page") == "1" {
+				for i := 0; i < 100; i++ {
+					issues = append(issues, map[string]string{"body": "unrelated"})
+				}
+			} else {
+				issues = append(issues, map[string]string{"body": "Secret: ghp_****\n\n" + fingerprintLine(known) + "\n"})
+			}
+			_ = json.NewEncoder(w).Encode(issues)
+		case "POST /repos/org/repo/issues":
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []any{"octocat"}, body["assignees"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"html_url":"https://github.com/org/repo/issues/1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	issues := &GitHubIssues{Endpoint: server.URL, Token: "token"}
+	exists, err := issues.Exists(ctx, "org/repo", known)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	fresh := strings.Repeat("b", 64)
+	exists, err = issues.Exists(ctx, "org/repo", fresh)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	url, err := issues.Create(ctx, Ticket{Fingerprint: fresh, Project: "org/repo", Title: "title", Assignee: "@octocat"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/org/repo/issues/1", url)
+	exists, err = issues.Exists(ctx, "org/repo", fresh)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, 2, listed)
+
+	_, err = issues.Exists(ctx, "org/missing", known)
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// Package tracing records OpenTelemetry spans of scans: the enumeration of
+// each source, the decoding of each chunk, each detector run on a chunk, and
+// the requests detectors send to verify secrets. Spans are recorded with the
+// global tracer provider, so programs embedding TruffleHog can export them
+// with their own provider.
+package tracing
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const tracerName = "github.com/trufflesecurity/trufflehog/v3"
+
+// Start starts a span, and returns a context that carries it.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+	return ctx.SetParent(spanCtx), span
+}
+
+// StartSource starts the span of the enumeration of a source.
+func StartSource(ctx context.Context, sourceType string) (context.Context, trace.Span) {
+	return Start(ctx, "source", attribute.String("source_type", sourceType))
+}
+
+// SpanContext returns the span context carried by ctx, so that work that
+// continues in another goroutine can be attributed to the span with
+// WithSpanContext.
+func SpanContext(ctx context.Context) trace.SpanContext {
+	return trace.SpanContextFromContext(ctx)
+}
+
+// WithSpanContext returns a context whose spans are children of the span of
+// sc.
+func WithSpanContext(ctx context.Context, sc trace.SpanContext) context.Context {
+	if !sc.IsValid() {
+		return ctx
+	}
+	return ctx.SetParent(trace.ContextWithSpanContext(ctx, sc))
+}
+
+// Setup exports the spans of the process to the OTLP gRPC endpoint, e.g.
+// localhost:4317, or http://localhost:4317 without TLS. The standard
+// OTEL_EXPORTER_OTLP_* and OTEL_TRACES_SAMPLER environment variables
+// configure the exporter and the sampling further. The returned function
+// flushes the remaining spans, and must be called before the process exits.
+func Setup(ctx context.Context, endpoint, version string) (func(context.Context) error, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://"))}
+	if strings.HasPrefix(endpoint, "http://") {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName("trufflehog"),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error { return provider.Shutdown(ctx) }, nil
+}
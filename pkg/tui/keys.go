@@ -0,0 +1,80 @@
+package tui
+
+import "unicode/utf8"
+
+// parseKeys returns the keys pressed in input read from a terminal in raw
+// mode. Printable characters are returned as themselves, and other keys by
+// their name.
+func parseKeys(input []byte) []string {
+	var keys []string
+	for len(input) > 0 {
+		key, n := parseKey(input)
+		if key != "" {
+			keys = append(keys, key)
+		}
+		input = input[n:]
+	}
+	return keys
+}
+
+// parseKey returns the first key in input and its length. Unknown sequences
+// are returned as an empty key.
+func parseKey(input []byte) (string, int) {
+	switch c := input[0]; {
+	case c == 0x03:
+		return keyCtrlC, 1
+	case c == '\r' || c == '\n':
+		return keyEnter, 1
+	case c == 0x7f || c == 0x08:
+		return keyBack, 1
+	case c == 0x1b:
+		if len(input) < 3 || (input[1] != '[' && input[1] != 'O') {
+			return keyEscape, 1
+		}
+		return parseEscape(input)
+	case c < 0x20:
+		return "", 1
+	}
+	r, n := utf8.DecodeRune(input)
+	if r == utf8.RuneError {
+		return "", n
+	}
+	return string(r), n
+}
+
+// parseEscape parses an escape sequence, like ESC [ A for the up arrow or
+// ESC [ 5 ~ for page up.
+func parseEscape(input []byte) (string, int) {
+	switch input[2] {
+	case 'A':
+		return keyUp, 3
+	case 'B':
+		return keyDown, 3
+	case 'H':
+		return keyHome, 3
+	case 'F':
+		return keyEnd, 3
+	}
+	// Skip the parameters to the final byte of the sequence.
+	end := 2
+	for end < len(input) && (input[end] >= '0' && input[end] <= '9' || input[end] == ';') {
+		end++
+	}
+	if end == len(input) {
+		return "", end
+	}
+	if input[end] != '~' {
+		return "", end + 1
+	}
+	switch string(input[2:end]) {
+	case "1", "7":
+		return keyHome, end + 1
+	case "4", "8":
+		return keyEnd, end + 1
+	case "5":
+		return keyPageUp, end + 1
+	case "6":
+		return keyPageDown, end + 1
+	}
+	return "", end + 1
+}
@@ -0,0 +1,28 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "characters", input: "q/ä", want: []string{"q", "/", "ä"}},
+		{name: "arrows", input: "\x1b[A\x1b[B\x1bOA", want: []string{keyUp, keyDown, keyUp}},
+		{name: "pages", input: "\x1b[5~\x1b[6~", want: []string{keyPageUp, keyPageDown}},
+		{name: "home and end", input: "\x1b[H\x1b[4~", want: []string{keyHome, keyEnd}},
+		{name: "escape", input: "\x1b", want: []string{keyEscape}},
+		{name: "control keys", input: "\x03\r\x7f\x01", want: []string{keyCtrlC, keyEnter, keyBack}},
+		{name: "unknown sequence", input: "\x1b[1;5Cx", want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseKeys([]byte(tt.input)))
+		})
+	}
+}
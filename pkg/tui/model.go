@@ -0,0 +1,313 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// Keys that aren't printable characters.
+const (
+	keyUp       = "up"
+	keyDown     = "down"
+	keyPageUp   = "pgup"
+	keyPageDown = "pgdown"
+	keyHome     = "home"
+	keyEnd      = "end"
+	keyEnter    = "enter"
+	keyEscape   = "esc"
+	keyBack     = "backspace"
+	keyCtrlC    = "ctrl+c"
+)
+
+// headerLines is the number of lines above the findings table.
+const headerLines = 6
+
+// finding is a result shown in the findings table.
+type finding struct {
+	result   detectors.ResultWithMetadata
+	location string
+	// text is what the filter matches, in lower case.
+	text     string
+	revealed bool
+}
+
+// model is the state of the interface. It's only used by the goroutine that
+// draws the screen.
+type model struct {
+	onlyVerified bool
+	started      time.Time
+
+	progress Progress
+	// rate is the number of chunks scanned per second, measured between
+	// progress updates.
+	rate        float64
+	rateChunks  uint64
+	rateUpdated time.Time
+	done        bool
+
+	findings []*finding
+	// visible are the findings that match the filter.
+	visible []*finding
+	filter  string
+	editing bool
+	cursor  int
+	offset  int
+}
+
+func newModel(onlyVerified bool, now time.Time) *model {
+	return &model{onlyVerified: onlyVerified, started: now, rateUpdated: now}
+}
+
+// add adds a result to the findings.
+func (m *model) add(r detectors.ResultWithMetadata) {
+	if m.onlyVerified && !r.Verified {
+		return
+	}
+	f := &finding{result: r, location: location(r)}
+	f.text = strings.ToLower(strings.Join([]string{r.DetectorType.String(), r.SourceName, f.location, verifiedStatus(r)}, " "))
+	m.findings = append(m.findings, f)
+	if m.matches(f) {
+		m.visible = append(m.visible, f)
+	}
+}
+
+// update records the progress of the scan.
+func (m *model) update(p Progress, now time.Time) {
+	if elapsed := now.Sub(m.rateUpdated).Seconds(); elapsed >= 1 {
+		m.rate = float64(p.ChunksScanned-m.rateChunks) / elapsed
+		m.rateChunks = p.ChunksScanned
+		m.rateUpdated = now
+	}
+	m.progress = p
+}
+
+// verified returns the number of verified findings.
+func (m *model) verified() int {
+	n := 0
+	for _, f := range m.findings {
+		if f.result.Verified {
+			n++
+		}
+	}
+	return n
+}
+
+func (m *model) matches(f *finding) bool {
+	return strings.Contains(f.text, strings.ToLower(m.filter))
+}
+
+func (m *model) applyFilter() {
+	m.visible = m.visible[:0]
+	for _, f := range m.findings {
+		if m.matches(f) {
+			m.visible = append(m.visible, f)
+		}
+	}
+	m.cursor, m.offset = 0, 0
+}
+
+// handleKey updates the model for a key press, and reports whether the
+// interface should quit. rows is the number of rows of the findings table.
+func (m *model) handleKey(key string, rows int) bool {
+	if m.editing {
+		switch key {
+		case keyEnter:
+			m.editing = false
+		case keyEscape:
+			m.editing = false
+			m.filter = ""
+		case keyBack:
+			if runes := []rune(m.filter); len(runes) > 0 {
+				m.filter = string(runes[:len(runes)-1])
+			}
+		case keyCtrlC:
+			return true
+		default:
+			if utf8.RuneCountInString(key) == 1 {
+				m.filter += key
+			}
+		}
+		m.applyFilter()
+		return false
+	}
+
+	switch key {
+	case "q", keyCtrlC:
+		return true
+	case keyUp, "k":
+		m.cursor--
+	case keyDown, "j":
+		m.cursor++
+	case keyPageUp:
+		m.cursor -= rows
+	case keyPageDown:
+		m.cursor += rows
+	case keyHome, "g":
+		m.cursor = 0
+	case keyEnd, "G":
+		m.cursor = len(m.visible) - 1
+	case "/":
+		m.editing = true
+	case keyEscape:
+		m.filter = ""
+		m.applyFilter()
+	case "r", keyEnter:
+		if f := m.selected(); f != nil {
+			f.revealed = !f.revealed
+		}
+	}
+	m.scroll(rows)
+	return false
+}
+
+// scroll keeps the cursor on a finding, and the table scrolled to it.
+func (m *model) scroll(rows int) {
+	if m.cursor >= len(m.visible) {
+		m.cursor = len(m.visible) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if rows > 0 && m.cursor >= m.offset+rows {
+		m.offset = m.cursor - rows + 1
+	}
+}
+
+func (m *model) selected() *finding {
+	if m.cursor < 0 || m.cursor >= len(m.visible) {
+		return nil
+	}
+	return m.visible[m.cursor]
+}
+
+// tableRows returns the number of rows of the findings table on a screen of
+// the given height.
+func tableRows(height int) int {
+	return height - headerLines - 1
+}
+
+// render draws the interface on a screen of the given size.
+func (m *model) render(w io.Writer, width, height int, now time.Time) {
+	var b strings.Builder
+	b.WriteString("\x1b[H\x1b[2J")
+	line := func(format string, args ...any) {
+		s := fmt.Sprintf(format, args...)
+		if len([]rune(s)) > width {
+			s = string([]rune(s)[:width])
+		}
+		// Attributes are reset in case the line was cut short.
+		b.WriteString(s)
+		b.WriteString("\x1b[0m\x1b[K\r\n")
+	}
+
+	status := "scanning"
+	if m.done {
+		status = "finished"
+	}
+	line("\x1b[1mTruffleHog\x1b[0m  %s for %s", status, now.Sub(m.started).Round(time.Second))
+	line("Chunks: %d (%.0f/s)  Bytes: %d  Verification backlog: %d",
+		m.progress.ChunksScanned, m.rate, m.progress.BytesScanned, m.progress.VerificationBacklog)
+	line("Sources: %s", m.sources())
+	line("Findings: %d (%d verified)", len(m.findings), m.verified())
+	if m.editing {
+		line("Filter: %s\x1b[7m \x1b[0m", m.filter)
+	} else if m.filter != "" {
+		line("Filter: %s (%d shown)", m.filter, len(m.visible))
+	} else {
+		line("")
+	}
+	line("\x1b[4m  %-10s %-24s %-24s %s", "STATUS", "DETECTOR", "SECRET", "LOCATION")
+
+	rows := tableRows(height)
+	m.scroll(rows)
+	for i := m.offset; i < len(m.visible) && i < m.offset+rows; i++ {
+		f := m.visible[i]
+		secret := redact(string(f.result.Raw))
+		if f.revealed {
+			secret = string(f.result.Raw)
+		}
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "\x1b[7m> "
+		}
+		line("%s%-10s %-24s %-24s %s", cursor, verifiedStatus(f.result), f.result.DetectorType.String(), secret, f.location)
+	}
+	for i := len(m.visible) - m.offset; i < rows; i++ {
+		line("")
+	}
+	b.WriteString("\x1b[2m↑/↓ move  / filter  r reveal  q quit\x1b[0m\x1b[K")
+	_, _ = io.WriteString(w, b.String())
+}
+
+// sources describes the sources that chunks were scanned from.
+func (m *model) sources() string {
+	if len(m.progress.SourceChunks) == 0 {
+		return "none yet"
+	}
+	names := make([]string, 0, len(m.progress.SourceChunks))
+	for name := range m.progress.SourceChunks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s (%d chunks)", name, m.progress.SourceChunks[name])
+	}
+	return strings.Join(names, ", ")
+}
+
+func verifiedStatus(r detectors.ResultWithMetadata) string {
+	if r.Canary {
+		return "canary"
+	}
+	if r.Verified {
+		return "verified"
+	}
+	return "unverified"
+}
+
+// redact hides all but the first characters of a secret.
+func redact(secret string) string {
+	runes := []rune(secret)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:4]) + strings.Repeat("*", 8)
+}
+
+// location returns where a result was found, from its source metadata.
+func location(r detectors.ResultWithMetadata) string {
+	if r.SourceMetadata == nil {
+		return ""
+	}
+	data, err := json.Marshal(r.SourceMetadata.Data)
+	if err != nil {
+		return ""
+	}
+	var meta map[string]map[string]any
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return ""
+	}
+	for _, fields := range meta {
+		for _, key := range []string{"file", "link", "path", "key", "object", "url", "repository"} {
+			value, ok := fields[key].(string)
+			if !ok || value == "" {
+				continue
+			}
+			if line, ok := fields["line"].(float64); ok && line > 0 && key == "file" {
+				return fmt.Sprintf("%s:%d", value, int64(line))
+			}
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+func testResult(detectorType detectorspb.DetectorType, verified bool, file string) detectors.ResultWithMetadata {
+	return detectors.ResultWithMetadata{
+		SourceName: "trufflehog - filesystem",
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{File: file},
+			},
+		},
+		Result: detectors.Result{
+			DetectorType: detectorType,
+			Verified:     verified,
+			Raw:          []byte("secret-" + file),
+		},
+	}
+}
+
+func Test_model(t *testing.T) {
+	start := time.Now()
+	m := newModel(false, start)
+	m.add(testResult(detectorspb.DetectorType_AWS, true, "a.txt"))
+	m.add(testResult(detectorspb.DetectorType_Github, false, "b.txt"))
+	m.add(testResult(detectorspb.DetectorType_AWS, false, "c.txt"))
+	assert.Len(t, m.visible, 3)
+	assert.Equal(t, "a.txt", m.visible[0].location)
+
+	// Moving past the end stops on the last finding.
+	for i := 0; i < 5; i++ {
+		m.handleKey(keyDown, 2)
+	}
+	assert.Equal(t, 2, m.cursor)
+	assert.Equal(t, 1, m.offset)
+
+	// Filtering matches the detector, location and status.
+	for _, key := range []string{"/", "a", "w", "s", keyEnter} {
+		assert.False(t, m.handleKey(key, 2))
+	}
+	assert.Equal(t, "aws", m.filter)
+	assert.Len(t, m.visible, 2)
+	assert.Equal(t, 0, m.cursor)
+	m.handleKey("/", 2)
+	for _, key := range []string{keyBack, keyBack, keyBack, "u", "n", keyEnter} {
+		m.handleKey(key, 2)
+	}
+	assert.Len(t, m.visible, 2)
+	m.handleKey(keyEscape, 2)
+	assert.Len(t, m.visible, 3)
+
+	// Secrets are only shown once revealed.
+	var out bytes.Buffer
+	m.render(&out, 120, 10, start)
+	assert.NotContains(t, out.String(), "secret-a.txt")
+	assert.Contains(t, out.String(), "secr********")
+	m.handleKey("r", 2)
+	out.Reset()
+	m.render(&out, 120, 10, start)
+	assert.Contains(t, out.String(), "secret-a.txt")
+
+	m.update(Progress{ChunksScanned: 100}, start.Add(2*time.Second))
+	assert.Equal(t, float64(50), m.rate)
+
+	assert.True(t, m.handleKey("q", 2))
+}
+
+func Test_model_onlyVerified(t *testing.T) {
+	m := newModel(true, time.Now())
+	m.add(testResult(detectorspb.DetectorType_AWS, true, "a.txt"))
+	m.add(testResult(detectorspb.DetectorType_AWS, false, "b.txt"))
+	assert.Len(t, m.findings, 1)
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"io"
+	"os"
+)
+
+// terminal is a terminal in raw mode, showing the interface on its alternate
+// screen.
+type terminal struct {
+	in  *os.File
+	out io.Writer
+	// reset restores the state of the terminal.
+	reset func()
+	// getSize returns the size of the terminal.
+	getSize func() (int, int, error)
+}
+
+func (t *terminal) size() (int, int) {
+	width, height, err := t.getSize()
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// readKeys sends the keys pressed to keys, until the terminal is closed.
+func (t *terminal) readKeys(keys chan<- string) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := t.in.Read(buf)
+		for _, key := range parseKeys(buf[:n]) {
+			keys <- key
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// restore leaves the alternate screen and restores the terminal.
+func (t *terminal) restore() {
+	_, _ = io.WriteString(t.out, "\x1b[?25h\x1b[?1049l")
+	t.reset()
+}
@@ -0,0 +1,9 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+
+package tui
+
+import "os"
+
+func openTerminal(in, out *os.File) (*terminal, error) {
+	return nil, errUnsupported
+}
@@ -0,0 +1,48 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package tui
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// openTerminal puts the terminal in raw mode, and switches to its alternate
+// screen.
+func openTerminal(in, out *os.File) (*terminal, error) {
+	fd := int(in.Fd())
+	state, err := unix.IoctlGetTermios(fd, ioctlGetTermios)
+	if err != nil {
+		return nil, errors.New("standard input isn't a terminal")
+	}
+	raw := *state
+	raw.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	raw.Oflag &^= unix.OPOST
+	raw.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	raw.Cflag &^= unix.CSIZE | unix.PARENB
+	raw.Cflag |= unix.CS8
+	raw.Cc[unix.VMIN] = 1
+	raw.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, ioctlSetTermios, &raw); err != nil {
+		return nil, err
+	}
+	_, _ = io.WriteString(out, "\x1b[?1049h\x1b[?25l")
+
+	return &terminal{
+		in:  in,
+		out: out,
+		reset: func() {
+			_ = unix.IoctlSetTermios(fd, ioctlSetTermios, state)
+		},
+		getSize: func() (int, int, error) {
+			ws, err := unix.IoctlGetWinsize(int(out.Fd()), unix.TIOCGWINSZ)
+			if err != nil {
+				return 0, 0, err
+			}
+			return int(ws.Col), int(ws.Row), nil
+		},
+	}, nil
+}
@@ -0,0 +1,82 @@
+// Package tui shows the progress and findings of a scan in an interactive
+// terminal interface.
+package tui
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// refreshInterval is how often the screen is redrawn.
+const refreshInterval = 250 * time.Millisecond
+
+// errUnsupported is returned on platforms without terminal support.
+var errUnsupported = errors.New("the terminal interface isn't supported on this platform")
+
+// Progress is the progress of a scan.
+type Progress struct {
+	ChunksScanned uint64
+	BytesScanned  uint64
+	// VerificationBacklog is the number of chunks waiting for or being
+	// verified.
+	VerificationBacklog int64
+	// SourceChunks are the chunks scanned from each source, by name.
+	SourceChunks map[string]uint64
+}
+
+// Config configures the interface.
+type Config struct {
+	// Results are the results of the scan, until the channel is closed.
+	Results <-chan detectors.ResultWithMetadata
+	// Progress returns the progress of the scan.
+	Progress func() Progress
+	// OnlyVerified hides unverified results.
+	OnlyVerified bool
+}
+
+// Run shows the interface on the terminal until the user quits, and returns
+// the number of findings and how many of them are verified.
+func Run(ctx context.Context, cfg Config) (found, verified int, err error) {
+	term, err := openTerminal(os.Stdin, os.Stdout)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer term.restore()
+
+	keys := make(chan string)
+	go term.readKeys(keys)
+
+	m := newModel(cfg.OnlyVerified, time.Now())
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	results := cfg.Results
+	for {
+		width, height := term.size()
+		select {
+		case <-ctx.Done():
+			return len(m.findings), m.verified(), ctx.Err()
+		case r, ok := <-results:
+			if !ok {
+				// The scan finished, but the findings stay on screen until
+				// the user quits.
+				results = nil
+				m.done = true
+				m.update(cfg.Progress(), time.Now())
+				break
+			}
+			m.add(r)
+			continue
+		case key, ok := <-keys:
+			if !ok || m.handleKey(key, tableRows(height)) {
+				return len(m.findings), m.verified(), nil
+			}
+		case now := <-ticker.C:
+			m.update(cfg.Progress(), now)
+		}
+		m.render(term.out, width, height, time.Now())
+	}
+}